@@ -22,6 +22,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ToyAttribute int32
+
+const (
+	ToyAttribute_TOY_ATTRIBUTE_UNSPECIFIED ToyAttribute = 0
+	ToyAttribute_TOY_ATTRIBUTE_TAGS        ToyAttribute = 1
+	ToyAttribute_TOY_ATTRIBUTE_ATTACHMENTS ToyAttribute = 2
+)
+
+// Enum value maps for ToyAttribute.
+var (
+	ToyAttribute_name = map[int32]string{
+		0: "TOY_ATTRIBUTE_UNSPECIFIED",
+		1: "TOY_ATTRIBUTE_TAGS",
+		2: "TOY_ATTRIBUTE_ATTACHMENTS",
+	}
+	ToyAttribute_value = map[string]int32{
+		"TOY_ATTRIBUTE_UNSPECIFIED": 0,
+		"TOY_ATTRIBUTE_TAGS":        1,
+		"TOY_ATTRIBUTE_ATTACHMENTS": 2,
+	}
+)
+
+func (x ToyAttribute) Enum() *ToyAttribute {
+	p := new(ToyAttribute)
+	*p = x
+	return p
+}
+
+func (x ToyAttribute) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToyAttribute) Descriptor() protoreflect.EnumDescriptor {
+	return file_toys_categories_proto_enumTypes[0].Descriptor()
+}
+
+func (ToyAttribute) Type() protoreflect.EnumType {
+	return &file_toys_categories_proto_enumTypes[0]
+}
+
+func (x ToyAttribute) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToyAttribute.Descriptor instead.
+func (ToyAttribute) EnumDescriptor() ([]byte, []int) {
+	return file_toys_categories_proto_rawDescGZIP(), []int{0}
+}
+
 type GetCategoryIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -187,6 +236,148 @@ func (x *GetCategoriesOut) GetCategories() []*GetCategoryOut {
 	return nil
 }
 
+type GetCategoryRulesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryID uint32 `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+}
+
+func (x *GetCategoryRulesIn) Reset() {
+	*x = GetCategoryRulesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_categories_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCategoryRulesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRulesIn) ProtoMessage() {}
+
+func (x *GetCategoryRulesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_categories_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRulesIn.ProtoReflect.Descriptor instead.
+func (*GetCategoryRulesIn) Descriptor() ([]byte, []int) {
+	return file_toys_categories_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCategoryRulesIn) GetCategoryID() uint32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+type GetCategoryRulesOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryID           uint32         `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	PriceFloor           float32        `protobuf:"fixed32,2,opt,name=priceFloor,proto3" json:"priceFloor,omitempty"`                          // min price
+	PriceCeil            float32        `protobuf:"fixed32,3,opt,name=priceCeil,proto3" json:"priceCeil,omitempty"`                            // max price
+	QuantityFloor        uint32         `protobuf:"varint,4,opt,name=quantityFloor,proto3" json:"quantityFloor,omitempty"`                     // min quantity
+	QuantityCeil         uint32         `protobuf:"varint,5,opt,name=quantityCeil,proto3" json:"quantityCeil,omitempty"`                       // max quantity
+	DescriptionMinLength *uint32        `protobuf:"varint,6,opt,name=descriptionMinLength,proto3,oneof" json:"descriptionMinLength,omitempty"` // not set, if description length is not limited by Category
+	RequiredAttributes   []ToyAttribute `protobuf:"varint,7,rep,packed,name=requiredAttributes,proto3,enum=categories.ToyAttribute" json:"requiredAttributes,omitempty"`
+}
+
+func (x *GetCategoryRulesOut) Reset() {
+	*x = GetCategoryRulesOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_categories_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCategoryRulesOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRulesOut) ProtoMessage() {}
+
+func (x *GetCategoryRulesOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_categories_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRulesOut.ProtoReflect.Descriptor instead.
+func (*GetCategoryRulesOut) Descriptor() ([]byte, []int) {
+	return file_toys_categories_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryRulesOut) GetCategoryID() uint32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *GetCategoryRulesOut) GetPriceFloor() float32 {
+	if x != nil {
+		return x.PriceFloor
+	}
+	return 0
+}
+
+func (x *GetCategoryRulesOut) GetPriceCeil() float32 {
+	if x != nil {
+		return x.PriceCeil
+	}
+	return 0
+}
+
+func (x *GetCategoryRulesOut) GetQuantityFloor() uint32 {
+	if x != nil {
+		return x.QuantityFloor
+	}
+	return 0
+}
+
+func (x *GetCategoryRulesOut) GetQuantityCeil() uint32 {
+	if x != nil {
+		return x.QuantityCeil
+	}
+	return 0
+}
+
+func (x *GetCategoryRulesOut) GetDescriptionMinLength() uint32 {
+	if x != nil && x.DescriptionMinLength != nil {
+		return *x.DescriptionMinLength
+	}
+	return 0
+}
+
+func (x *GetCategoryRulesOut) GetRequiredAttributes() []ToyAttribute {
+	if x != nil {
+		return x.RequiredAttributes
+	}
+	return nil
+}
+
 var File_toys_categories_proto protoreflect.FileDescriptor
 
 var file_toys_categories_proto_rawDesc = []byte{
@@ -213,22 +404,59 @@ var file_toys_categories_proto_rawDesc = []byte{
 	0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
 	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4f, 0x75,
-	0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x32, 0xa4, 0x01,
-	0x0a, 0x11, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x1a, 0x1a, 0x2e,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f,
-	0x75, 0x74, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d,
-	0x2d, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x49, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x22, 0xd9, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x65, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x65, 0x69, 0x6c, 0x12, 0x37, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x12,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x2a,
+	0x64, 0x0a, 0x0c, 0x54, 0x6f, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x59, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x54, 0x4f, 0x59, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f,
+	0x54, 0x41, 0x47, 0x53, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x59, 0x5f, 0x41, 0x54,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45,
+	0x4e, 0x54, 0x53, 0x10, 0x02, 0x32, 0xfb, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x1e, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x49, 0x6e,
+	0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d, 0x2d,
+	0x74, 0x6f, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -243,27 +471,34 @@ func file_toys_categories_proto_rawDescGZIP() []byte {
 	return file_toys_categories_proto_rawDescData
 }
 
-var file_toys_categories_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_toys_categories_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_toys_categories_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_toys_categories_proto_goTypes = []interface{}{
-	(*GetCategoryIn)(nil),         // 0: categories.GetCategoryIn
-	(*GetCategoryOut)(nil),        // 1: categories.GetCategoryOut
-	(*GetCategoriesOut)(nil),      // 2: categories.GetCategoriesOut
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 4: google.protobuf.Empty
+	(ToyAttribute)(0),             // 0: categories.ToyAttribute
+	(*GetCategoryIn)(nil),         // 1: categories.GetCategoryIn
+	(*GetCategoryOut)(nil),        // 2: categories.GetCategoryOut
+	(*GetCategoriesOut)(nil),      // 3: categories.GetCategoriesOut
+	(*GetCategoryRulesIn)(nil),    // 4: categories.GetCategoryRulesIn
+	(*GetCategoryRulesOut)(nil),   // 5: categories.GetCategoryRulesOut
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
 }
 var file_toys_categories_proto_depIdxs = []int32{
-	3, // 0: categories.GetCategoryOut.createdAt:type_name -> google.protobuf.Timestamp
-	3, // 1: categories.GetCategoryOut.updatedAt:type_name -> google.protobuf.Timestamp
-	1, // 2: categories.GetCategoriesOut.categories:type_name -> categories.GetCategoryOut
-	0, // 3: categories.CategoriesService.GetCategory:input_type -> categories.GetCategoryIn
-	4, // 4: categories.CategoriesService.GetCategories:input_type -> google.protobuf.Empty
-	1, // 5: categories.CategoriesService.GetCategory:output_type -> categories.GetCategoryOut
-	2, // 6: categories.CategoriesService.GetCategories:output_type -> categories.GetCategoriesOut
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6, // 0: categories.GetCategoryOut.createdAt:type_name -> google.protobuf.Timestamp
+	6, // 1: categories.GetCategoryOut.updatedAt:type_name -> google.protobuf.Timestamp
+	2, // 2: categories.GetCategoriesOut.categories:type_name -> categories.GetCategoryOut
+	0, // 3: categories.GetCategoryRulesOut.requiredAttributes:type_name -> categories.ToyAttribute
+	1, // 4: categories.CategoriesService.GetCategory:input_type -> categories.GetCategoryIn
+	7, // 5: categories.CategoriesService.GetCategories:input_type -> google.protobuf.Empty
+	4, // 6: categories.CategoriesService.GetCategoryRules:input_type -> categories.GetCategoryRulesIn
+	2, // 7: categories.CategoriesService.GetCategory:output_type -> categories.GetCategoryOut
+	3, // 8: categories.CategoriesService.GetCategories:output_type -> categories.GetCategoriesOut
+	5, // 9: categories.CategoriesService.GetCategoryRules:output_type -> categories.GetCategoryRulesOut
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_toys_categories_proto_init() }
@@ -308,19 +543,45 @@ func file_toys_categories_proto_init() {
 				return nil
 			}
 		}
+		file_toys_categories_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCategoryRulesIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_categories_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCategoryRulesOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_toys_categories_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_toys_categories_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_toys_categories_proto_goTypes,
 		DependencyIndexes: file_toys_categories_proto_depIdxs,
+		EnumInfos:         file_toys_categories_proto_enumTypes,
 		MessageInfos:      file_toys_categories_proto_msgTypes,
 	}.Build()
 	File_toys_categories_proto = out.File
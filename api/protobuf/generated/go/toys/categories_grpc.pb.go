@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 type CategoriesServiceClient interface {
 	GetCategory(ctx context.Context, in *GetCategoryIn, opts ...grpc.CallOption) (*GetCategoryOut, error)
 	GetCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCategoriesOut, error)
+	GetCategoryRules(ctx context.Context, in *GetCategoryRulesIn, opts ...grpc.CallOption) (*GetCategoryRulesOut, error)
 }
 
 type categoriesServiceClient struct {
@@ -49,12 +50,22 @@ func (c *categoriesServiceClient) GetCategories(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *categoriesServiceClient) GetCategoryRules(ctx context.Context, in *GetCategoryRulesIn, opts ...grpc.CallOption) (*GetCategoryRulesOut, error) {
+	out := new(GetCategoryRulesOut)
+	err := c.cc.Invoke(ctx, "/categories.CategoriesService/GetCategoryRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoriesServiceServer is the server API for CategoriesService service.
 // All implementations must embed UnimplementedCategoriesServiceServer
 // for forward compatibility
 type CategoriesServiceServer interface {
 	GetCategory(context.Context, *GetCategoryIn) (*GetCategoryOut, error)
 	GetCategories(context.Context, *emptypb.Empty) (*GetCategoriesOut, error)
+	GetCategoryRules(context.Context, *GetCategoryRulesIn) (*GetCategoryRulesOut, error)
 	mustEmbedUnimplementedCategoriesServiceServer()
 }
 
@@ -68,6 +79,9 @@ func (UnimplementedCategoriesServiceServer) GetCategory(context.Context, *GetCat
 func (UnimplementedCategoriesServiceServer) GetCategories(context.Context, *emptypb.Empty) (*GetCategoriesOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
 }
+func (UnimplementedCategoriesServiceServer) GetCategoryRules(context.Context, *GetCategoryRulesIn) (*GetCategoryRulesOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryRules not implemented")
+}
 func (UnimplementedCategoriesServiceServer) mustEmbedUnimplementedCategoriesServiceServer() {}
 
 // UnsafeCategoriesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -117,6 +131,24 @@ func _CategoriesService_GetCategories_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoriesService_GetCategoryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRulesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoriesServiceServer).GetCategoryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/categories.CategoriesService/GetCategoryRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoriesServiceServer).GetCategoryRules(ctx, req.(*GetCategoryRulesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CategoriesService_ServiceDesc is the grpc.ServiceDesc for CategoriesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -132,6 +164,10 @@ var CategoriesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCategories",
 			Handler:    _CategoriesService_GetCategories_Handler,
 		},
+		{
+			MethodName: "GetCategoryRules",
+			Handler:    _CategoriesService_GetCategoryRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "toys/categories.proto",
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MasterStatus int32
+
+const (
+	MasterStatus_MASTER_STATUS_UNSPECIFIED MasterStatus = 0
+	MasterStatus_MASTER_STATUS_PENDING     MasterStatus = 1
+	MasterStatus_MASTER_STATUS_VERIFIED    MasterStatus = 2
+	MasterStatus_MASTER_STATUS_SUSPENDED   MasterStatus = 3 // can't add and update Toys
+	MasterStatus_MASTER_STATUS_BANNED      MasterStatus = 4 // can't add and update Toys, Toys are hidden
+)
+
+// Enum value maps for MasterStatus.
+var (
+	MasterStatus_name = map[int32]string{
+		0: "MASTER_STATUS_UNSPECIFIED",
+		1: "MASTER_STATUS_PENDING",
+		2: "MASTER_STATUS_VERIFIED",
+		3: "MASTER_STATUS_SUSPENDED",
+		4: "MASTER_STATUS_BANNED",
+	}
+	MasterStatus_value = map[string]int32{
+		"MASTER_STATUS_UNSPECIFIED": 0,
+		"MASTER_STATUS_PENDING":     1,
+		"MASTER_STATUS_VERIFIED":    2,
+		"MASTER_STATUS_SUSPENDED":   3,
+		"MASTER_STATUS_BANNED":      4,
+	}
+)
+
+func (x MasterStatus) Enum() *MasterStatus {
+	p := new(MasterStatus)
+	*p = x
+	return p
+}
+
+func (x MasterStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MasterStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_toys_masters_proto_enumTypes[0].Descriptor()
+}
+
+func (MasterStatus) Type() protoreflect.EnumType {
+	return &file_toys_masters_proto_enumTypes[0]
+}
+
+func (x MasterStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MasterStatus.Descriptor instead.
+func (MasterStatus) EnumDescriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{0}
+}
+
 type RegisterMasterIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -129,7 +185,8 @@ type GetMasterIn struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID      uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Include *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=include,proto3" json:"include,omitempty"` // supported paths: "user"
 }
 
 func (x *GetMasterIn) Reset() {
@@ -171,16 +228,39 @@ func (x *GetMasterIn) GetID() uint64 {
 	return 0
 }
 
+func (x *GetMasterIn) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
 type GetMasterOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	UserID    uint64                 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Info      *string                `protobuf:"bytes,3,opt,name=info,proto3,oneof" json:"info,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ID              uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserID          uint64                 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Info            *string                `protobuf:"bytes,3,opt,name=info,proto3,oneof" json:"info,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	AverageRating   float32                `protobuf:"fixed32,7,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	ReviewsCount    uint64                 `protobuf:"varint,8,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`
+	FollowersCount  uint64                 `protobuf:"varint,9,opt,name=followersCount,proto3" json:"followersCount,omitempty"`
+	ShopName        *string                `protobuf:"bytes,10,opt,name=shopName,proto3,oneof" json:"shopName,omitempty"`
+	Slug            *string                `protobuf:"bytes,11,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Avatar          *string                `protobuf:"bytes,12,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Banner          *string                `protobuf:"bytes,13,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	City            *string                `protobuf:"bytes,14,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Region          *string                `protobuf:"bytes,15,opt,name=region,proto3,oneof" json:"region,omitempty"`
+	Policies        *string                `protobuf:"bytes,16,opt,name=policies,proto3,oneof" json:"policies,omitempty"`
+	SocialLinks     []string               `protobuf:"bytes,17,rep,name=socialLinks,proto3" json:"socialLinks,omitempty"`
+	ShipsTo         []string               `protobuf:"bytes,18,rep,name=shipsTo,proto3" json:"shipsTo,omitempty"`
+	VacationUntil   *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=vacationUntil,proto3" json:"vacationUntil,omitempty"` // not set, if Master is not on vacation
+	VacationMessage *string                `protobuf:"bytes,20,opt,name=vacationMessage,proto3,oneof" json:"vacationMessage,omitempty"`
+	Status          MasterStatus           `protobuf:"varint,21,opt,name=status,proto3,enum=masters.MasterStatus" json:"status,omitempty"`
+	User            *User                  `protobuf:"bytes,22,opt,name=user,proto3,oneof" json:"user,omitempty"` // set, if "user" is included and SSO is available
 }
 
 func (x *GetMasterOut) Reset() {
@@ -250,6 +330,190 @@ func (x *GetMasterOut) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetMasterOut) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GetMasterOut) GetReviewsCount() uint64 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *GetMasterOut) GetFollowersCount() uint64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+func (x *GetMasterOut) GetShopName() string {
+	if x != nil && x.ShopName != nil {
+		return *x.ShopName
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetBanner() string {
+	if x != nil && x.Banner != nil {
+		return *x.Banner
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetPolicies() string {
+	if x != nil && x.Policies != nil {
+		return *x.Policies
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetSocialLinks() []string {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+func (x *GetMasterOut) GetShipsTo() []string {
+	if x != nil {
+		return x.ShipsTo
+	}
+	return nil
+}
+
+func (x *GetMasterOut) GetVacationUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VacationUntil
+	}
+	return nil
+}
+
+func (x *GetMasterOut) GetVacationMessage() string {
+	if x != nil && x.VacationMessage != nil {
+		return *x.VacationMessage
+	}
+	return ""
+}
+
+func (x *GetMasterOut) GetStatus() MasterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MasterStatus_MASTER_STATUS_UNSPECIFIED
+}
+
+func (x *GetMasterOut) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// User is public profile of Master's User from SSO.
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Avatar      *string                `protobuf:"bytes,3,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *User) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type Pagination struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -262,7 +526,7 @@ type Pagination struct {
 func (x *Pagination) Reset() {
 	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[4]
+		mi := &file_toys_masters_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -275,7 +539,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[4]
+	mi := &file_toys_masters_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +552,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{4}
+	return file_toys_masters_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Pagination) GetLimit() uint64 {
@@ -310,14 +574,15 @@ type GetMastersIn struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination     `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	Filters    *MastersFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Pagination *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Filters    *MastersFilters        `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Include    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=include,proto3" json:"include,omitempty"` // supported paths: "user"
 }
 
 func (x *GetMastersIn) Reset() {
 	*x = GetMastersIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[5]
+		mi := &file_toys_masters_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +595,7 @@ func (x *GetMastersIn) String() string {
 func (*GetMastersIn) ProtoMessage() {}
 
 func (x *GetMastersIn) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[5]
+	mi := &file_toys_masters_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +608,7 @@ func (x *GetMastersIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMastersIn.ProtoReflect.Descriptor instead.
 func (*GetMastersIn) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{5}
+	return file_toys_masters_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetMastersIn) GetPagination() *Pagination {
@@ -360,6 +625,13 @@ func (x *GetMastersIn) GetFilters() *MastersFilters {
 	return nil
 }
 
+func (x *GetMastersIn) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
 type GetMastersOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -371,7 +643,7 @@ type GetMastersOut struct {
 func (x *GetMastersOut) Reset() {
 	*x = GetMastersOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[6]
+		mi := &file_toys_masters_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +656,7 @@ func (x *GetMastersOut) String() string {
 func (*GetMastersOut) ProtoMessage() {}
 
 func (x *GetMastersOut) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[6]
+	mi := &file_toys_masters_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +669,7 @@ func (x *GetMastersOut) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMastersOut.ProtoReflect.Descriptor instead.
 func (*GetMastersOut) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{6}
+	return file_toys_masters_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetMastersOut) GetMasters() []*GetMasterOut {
@@ -412,13 +684,14 @@ type GetMasterByUserIn struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UserID  uint64                 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Include *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=include,proto3" json:"include,omitempty"` // supported paths: "user"
 }
 
 func (x *GetMasterByUserIn) Reset() {
 	*x = GetMasterByUserIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[7]
+		mi := &file_toys_masters_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +704,7 @@ func (x *GetMasterByUserIn) String() string {
 func (*GetMasterByUserIn) ProtoMessage() {}
 
 func (x *GetMasterByUserIn) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[7]
+	mi := &file_toys_masters_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +717,7 @@ func (x *GetMasterByUserIn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMasterByUserIn.ProtoReflect.Descriptor instead.
 func (*GetMasterByUserIn) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{7}
+	return file_toys_masters_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetMasterByUserIn) GetUserID() uint64 {
@@ -454,32 +727,39 @@ func (x *GetMasterByUserIn) GetUserID() uint64 {
 	return 0
 }
 
-type UpdateMasterIn struct {
+func (x *GetMasterByUserIn) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type GetMasterBySlugIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID   uint64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Info *string `protobuf:"bytes,2,opt,name=info,proto3,oneof" json:"info,omitempty"`
+	Slug    string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Include *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=include,proto3" json:"include,omitempty"` // supported paths: "user"
 }
 
-func (x *UpdateMasterIn) Reset() {
-	*x = UpdateMasterIn{}
+func (x *GetMasterBySlugIn) Reset() {
+	*x = GetMasterBySlugIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[8]
+		mi := &file_toys_masters_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateMasterIn) String() string {
+func (x *GetMasterBySlugIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMasterIn) ProtoMessage() {}
+func (*GetMasterBySlugIn) ProtoMessage() {}
 
-func (x *UpdateMasterIn) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[8]
+func (x *GetMasterBySlugIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,50 +770,63 @@ func (x *UpdateMasterIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMasterIn.ProtoReflect.Descriptor instead.
-func (*UpdateMasterIn) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetMasterBySlugIn.ProtoReflect.Descriptor instead.
+func (*GetMasterBySlugIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateMasterIn) GetID() uint64 {
+func (x *GetMasterBySlugIn) GetSlug() string {
 	if x != nil {
-		return x.ID
+		return x.Slug
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateMasterIn) GetInfo() string {
-	if x != nil && x.Info != nil {
-		return *x.Info
+func (x *GetMasterBySlugIn) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
 	}
-	return ""
+	return nil
 }
 
-type CountMastersIn struct {
+type UpdateMasterIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters *MastersFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ID          uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Info        *string  `protobuf:"bytes,2,opt,name=info,proto3,oneof" json:"info,omitempty"`
+	ShopName    *string  `protobuf:"bytes,3,opt,name=shopName,proto3,oneof" json:"shopName,omitempty"`
+	Slug        *string  `protobuf:"bytes,4,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Avatar      *string  `protobuf:"bytes,5,opt,name=avatar,proto3,oneof" json:"avatar,omitempty"`
+	Banner      *string  `protobuf:"bytes,6,opt,name=banner,proto3,oneof" json:"banner,omitempty"`
+	City        *string  `protobuf:"bytes,7,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Region      *string  `protobuf:"bytes,8,opt,name=region,proto3,oneof" json:"region,omitempty"`
+	Policies    *string  `protobuf:"bytes,9,opt,name=policies,proto3,oneof" json:"policies,omitempty"`
+	SocialLinks []string `protobuf:"bytes,10,rep,name=socialLinks,proto3" json:"socialLinks,omitempty"`
+	ShipsTo     []string `protobuf:"bytes,11,rep,name=shipsTo,proto3" json:"shipsTo,omitempty"`
+	// Paths of fields to change, not provided fields from mask are cleared. Without mask only provided fields are
+	// changed, and empty lists are ignored.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,12,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
-func (x *CountMastersIn) Reset() {
-	*x = CountMastersIn{}
+func (x *UpdateMasterIn) Reset() {
+	*x = UpdateMasterIn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[9]
+		mi := &file_toys_masters_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountMastersIn) String() string {
+func (x *UpdateMasterIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountMastersIn) ProtoMessage() {}
+func (*UpdateMasterIn) ProtoMessage() {}
 
-func (x *CountMastersIn) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[9]
+func (x *UpdateMasterIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,44 +837,1317 @@ func (x *CountMastersIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountMastersIn.ProtoReflect.Descriptor instead.
-func (*CountMastersIn) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateMasterIn.ProtoReflect.Descriptor instead.
+func (*UpdateMasterIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CountMastersIn) GetFilters() *MastersFilters {
+func (x *UpdateMasterIn) GetID() uint64 {
 	if x != nil {
-		return x.Filters
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-type MastersFilters struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Search              *string `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
-	CreatedAtOrderByAsc *bool   `protobuf:"varint,7,opt,name=createdAtOrderByAsc,proto3,oneof" json:"createdAtOrderByAsc,omitempty"`
+func (x *UpdateMasterIn) GetInfo() string {
+	if x != nil && x.Info != nil {
+		return *x.Info
+	}
+	return ""
 }
 
-func (x *MastersFilters) Reset() {
-	*x = MastersFilters{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateMasterIn) GetShopName() string {
+	if x != nil && x.ShopName != nil {
+		return *x.ShopName
 	}
+	return ""
 }
 
-func (x *MastersFilters) String() string {
+func (x *UpdateMasterIn) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateMasterIn) GetAvatar() string {
+	if x != nil && x.Avatar != nil {
+		return *x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateMasterIn) GetBanner() string {
+	if x != nil && x.Banner != nil {
+		return *x.Banner
+	}
+	return ""
+}
+
+func (x *UpdateMasterIn) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *UpdateMasterIn) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *UpdateMasterIn) GetPolicies() string {
+	if x != nil && x.Policies != nil {
+		return *x.Policies
+	}
+	return ""
+}
+
+func (x *UpdateMasterIn) GetSocialLinks() []string {
+	if x != nil {
+		return x.SocialLinks
+	}
+	return nil
+}
+
+func (x *UpdateMasterIn) GetShipsTo() []string {
+	if x != nil {
+		return x.ShipsTo
+	}
+	return nil
+}
+
+func (x *UpdateMasterIn) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CountMastersIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters *MastersFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountMastersIn) Reset() {
+	*x = CountMastersIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountMastersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountMastersIn) ProtoMessage() {}
+
+func (x *CountMastersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountMastersIn.ProtoReflect.Descriptor instead.
+func (*CountMastersIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CountMastersIn) GetFilters() *MastersFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type MastersFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search              *string        `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	CreatedAtOrderByAsc *bool          `protobuf:"varint,7,opt,name=createdAtOrderByAsc,proto3,oneof" json:"createdAtOrderByAsc,omitempty"`
+	Name                *string        `protobuf:"bytes,8,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	City                *string        `protobuf:"bytes,9,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Statuses            []MasterStatus `protobuf:"varint,10,rep,packed,name=statuses,proto3,enum=masters.MasterStatus" json:"statuses,omitempty"`
+}
+
+func (x *MastersFilters) Reset() {
+	*x = MastersFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MastersFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MastersFilters) ProtoMessage() {}
+
+func (x *MastersFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MastersFilters.ProtoReflect.Descriptor instead.
+func (*MastersFilters) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MastersFilters) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *MastersFilters) GetCreatedAtOrderByAsc() bool {
+	if x != nil && x.CreatedAtOrderByAsc != nil {
+		return *x.CreatedAtOrderByAsc
+	}
+	return false
+}
+
+func (x *MastersFilters) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *MastersFilters) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *MastersFilters) GetStatuses() []MasterStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type CountOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountOut) Reset() {
+	*x = CountOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountOut) ProtoMessage() {}
+
+func (x *CountOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountOut.ProtoReflect.Descriptor instead.
+func (*CountOut) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CountOut) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FollowMasterIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MasterID uint64 `protobuf:"varint,2,opt,name=masterID,proto3" json:"masterID,omitempty"`
+}
+
+func (x *FollowMasterIn) Reset() {
+	*x = FollowMasterIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowMasterIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowMasterIn) ProtoMessage() {}
+
+func (x *FollowMasterIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowMasterIn.ProtoReflect.Descriptor instead.
+func (*FollowMasterIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FollowMasterIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *FollowMasterIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+type FollowMasterOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowID uint64 `protobuf:"varint,1,opt,name=followID,proto3" json:"followID,omitempty"`
+}
+
+func (x *FollowMasterOut) Reset() {
+	*x = FollowMasterOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowMasterOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowMasterOut) ProtoMessage() {}
+
+func (x *FollowMasterOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowMasterOut.ProtoReflect.Descriptor instead.
+func (*FollowMasterOut) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FollowMasterOut) GetFollowID() uint64 {
+	if x != nil {
+		return x.FollowID
+	}
+	return 0
+}
+
+type UnfollowMasterIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MasterID uint64 `protobuf:"varint,2,opt,name=masterID,proto3" json:"masterID,omitempty"`
+}
+
+func (x *UnfollowMasterIn) Reset() {
+	*x = UnfollowMasterIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowMasterIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowMasterIn) ProtoMessage() {}
+
+func (x *UnfollowMasterIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowMasterIn.ProtoReflect.Descriptor instead.
+func (*UnfollowMasterIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnfollowMasterIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *UnfollowMasterIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+type GetFollowedMastersIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     uint64                 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Pagination *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Include    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=include,proto3" json:"include,omitempty"` // supported paths: "user"
+}
+
+func (x *GetFollowedMastersIn) Reset() {
+	*x = GetFollowedMastersIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowedMastersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowedMastersIn) ProtoMessage() {}
+
+func (x *GetFollowedMastersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowedMastersIn.ProtoReflect.Descriptor instead.
+func (*GetFollowedMastersIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetFollowedMastersIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetFollowedMastersIn) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFollowedMastersIn) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type CountFollowedMastersIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CountFollowedMastersIn) Reset() {
+	*x = CountFollowedMastersIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountFollowedMastersIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountFollowedMastersIn) ProtoMessage() {}
+
+func (x *CountFollowedMastersIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountFollowedMastersIn.ProtoReflect.Descriptor instead.
+func (*CountFollowedMastersIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CountFollowedMastersIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type StatsPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *StatsPeriod) Reset() {
+	*x = StatsPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsPeriod) ProtoMessage() {}
+
+func (x *StatsPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsPeriod.ProtoReflect.Descriptor instead.
+func (*StatsPeriod) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StatsPeriod) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StatsPeriod) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetMasterStatsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID uint64       `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	Period   *StatsPeriod `protobuf:"bytes,2,opt,name=period,proto3,oneof" json:"period,omitempty"`
+}
+
+func (x *GetMasterStatsIn) Reset() {
+	*x = GetMasterStatsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMasterStatsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterStatsIn) ProtoMessage() {}
+
+func (x *GetMasterStatsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterStatsIn.ProtoReflect.Descriptor instead.
+func (*GetMasterStatsIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMasterStatsIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+func (x *GetMasterStatsIn) GetPeriod() *StatsPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+type DailyToysCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Count uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *DailyToysCount) Reset() {
+	*x = DailyToysCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyToysCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyToysCount) ProtoMessage() {}
+
+func (x *DailyToysCount) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyToysCount.ProtoReflect.Descriptor instead.
+func (*DailyToysCount) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DailyToysCount) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DailyToysCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StatsToy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID              uint64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name            string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity        uint32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FavouritesCount uint64  `protobuf:"varint,5,opt,name=favouritesCount,proto3" json:"favouritesCount,omitempty"`
+	AverageRating   float32 `protobuf:"fixed32,6,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	ReviewsCount    uint64  `protobuf:"varint,7,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`
+}
+
+func (x *StatsToy) Reset() {
+	*x = StatsToy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsToy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsToy) ProtoMessage() {}
+
+func (x *StatsToy) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsToy.ProtoReflect.Descriptor instead.
+func (*StatsToy) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StatsToy) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *StatsToy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsToy) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *StatsToy) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StatsToy) GetFavouritesCount() uint64 {
+	if x != nil {
+		return x.FavouritesCount
+	}
+	return 0
+}
+
+func (x *StatsToy) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *StatsToy) GetReviewsCount() uint64 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+type PriceBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Floor float32 `protobuf:"fixed32,1,opt,name=floor,proto3" json:"floor,omitempty"`
+	Ceil  float32 `protobuf:"fixed32,2,opt,name=ceil,proto3" json:"ceil,omitempty"`
+	Count uint64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PriceBucket) Reset() {
+	*x = PriceBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBucket) ProtoMessage() {}
+
+func (x *PriceBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBucket.ProtoReflect.Descriptor instead.
+func (*PriceBucket) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PriceBucket) GetFloor() float32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *PriceBucket) GetCeil() float32 {
+	if x != nil {
+		return x.Ceil
+	}
+	return 0
+}
+
+func (x *PriceBucket) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetMasterStatsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID            uint64            `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	ToysCount           uint64            `protobuf:"varint,2,opt,name=toysCount,proto3" json:"toysCount,omitempty"`
+	InStockToysCount    uint64            `protobuf:"varint,3,opt,name=inStockToysCount,proto3" json:"inStockToysCount,omitempty"`
+	OutOfStockToysCount uint64            `protobuf:"varint,4,opt,name=outOfStockToysCount,proto3" json:"outOfStockToysCount,omitempty"`
+	TotalStock          uint64            `protobuf:"varint,5,opt,name=totalStock,proto3" json:"totalStock,omitempty"`
+	StockValue          float64           `protobuf:"fixed64,6,opt,name=stockValue,proto3" json:"stockValue,omitempty"`
+	NewToysPerDay       []*DailyToysCount `protobuf:"bytes,7,rep,name=newToysPerDay,proto3" json:"newToysPerDay,omitempty"`
+	MostFavouritedToys  []*StatsToy       `protobuf:"bytes,8,rep,name=mostFavouritedToys,proto3" json:"mostFavouritedToys,omitempty"`
+	HighestRatedToys    []*StatsToy       `protobuf:"bytes,9,rep,name=highestRatedToys,proto3" json:"highestRatedToys,omitempty"`
+	PriceDistribution   []*PriceBucket    `protobuf:"bytes,10,rep,name=priceDistribution,proto3" json:"priceDistribution,omitempty"`
+}
+
+func (x *GetMasterStatsOut) Reset() {
+	*x = GetMasterStatsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMasterStatsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterStatsOut) ProtoMessage() {}
+
+func (x *GetMasterStatsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterStatsOut.ProtoReflect.Descriptor instead.
+func (*GetMasterStatsOut) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMasterStatsOut) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+func (x *GetMasterStatsOut) GetToysCount() uint64 {
+	if x != nil {
+		return x.ToysCount
+	}
+	return 0
+}
+
+func (x *GetMasterStatsOut) GetInStockToysCount() uint64 {
+	if x != nil {
+		return x.InStockToysCount
+	}
+	return 0
+}
+
+func (x *GetMasterStatsOut) GetOutOfStockToysCount() uint64 {
+	if x != nil {
+		return x.OutOfStockToysCount
+	}
+	return 0
+}
+
+func (x *GetMasterStatsOut) GetTotalStock() uint64 {
+	if x != nil {
+		return x.TotalStock
+	}
+	return 0
+}
+
+func (x *GetMasterStatsOut) GetStockValue() float64 {
+	if x != nil {
+		return x.StockValue
+	}
+	return 0
+}
+
+func (x *GetMasterStatsOut) GetNewToysPerDay() []*DailyToysCount {
+	if x != nil {
+		return x.NewToysPerDay
+	}
+	return nil
+}
+
+func (x *GetMasterStatsOut) GetMostFavouritedToys() []*StatsToy {
+	if x != nil {
+		return x.MostFavouritedToys
+	}
+	return nil
+}
+
+func (x *GetMasterStatsOut) GetHighestRatedToys() []*StatsToy {
+	if x != nil {
+		return x.HighestRatedToys
+	}
+	return nil
+}
+
+func (x *GetMasterStatsOut) GetPriceDistribution() []*PriceBucket {
+	if x != nil {
+		return x.PriceDistribution
+	}
+	return nil
+}
+
+type SetVacationModeIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID uint64                 `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	Until    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"` // vacation mode is disabled, if not set
+	Message  *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+}
+
+func (x *SetVacationModeIn) Reset() {
+	*x = SetVacationModeIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVacationModeIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVacationModeIn) ProtoMessage() {}
+
+func (x *SetVacationModeIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVacationModeIn.ProtoReflect.Descriptor instead.
+func (*SetVacationModeIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetVacationModeIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+func (x *SetVacationModeIn) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SetVacationModeIn) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type ChangeMasterStatusIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID uint64       `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	Status   MasterStatus `protobuf:"varint,2,opt,name=status,proto3,enum=masters.MasterStatus" json:"status,omitempty"`
+	Reason   string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ChangeMasterStatusIn) Reset() {
+	*x = ChangeMasterStatusIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeMasterStatusIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMasterStatusIn) ProtoMessage() {}
+
+func (x *ChangeMasterStatusIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMasterStatusIn.ProtoReflect.Descriptor instead.
+func (*ChangeMasterStatusIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeMasterStatusIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+func (x *ChangeMasterStatusIn) GetStatus() MasterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MasterStatus_MASTER_STATUS_UNSPECIFIED
+}
+
+func (x *ChangeMasterStatusIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetMasterStatusHistoryIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID uint64 `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+}
+
+func (x *GetMasterStatusHistoryIn) Reset() {
+	*x = GetMasterStatusHistoryIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMasterStatusHistoryIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterStatusHistoryIn) ProtoMessage() {}
+
+func (x *GetMasterStatusHistoryIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterStatusHistoryIn.ProtoReflect.Descriptor instead.
+func (*GetMasterStatusHistoryIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMasterStatusHistoryIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+type MasterStatusChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	MasterID       uint64                 `protobuf:"varint,2,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	PreviousStatus MasterStatus           `protobuf:"varint,3,opt,name=previousStatus,proto3,enum=masters.MasterStatus" json:"previousStatus,omitempty"`
+	Status         MasterStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=masters.MasterStatus" json:"status,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *MasterStatusChange) Reset() {
+	*x = MasterStatusChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MasterStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasterStatusChange) ProtoMessage() {}
+
+func (x *MasterStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasterStatusChange.ProtoReflect.Descriptor instead.
+func (*MasterStatusChange) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MasterStatusChange) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *MasterStatusChange) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+func (x *MasterStatusChange) GetPreviousStatus() MasterStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return MasterStatus_MASTER_STATUS_UNSPECIFIED
+}
+
+func (x *MasterStatusChange) GetStatus() MasterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MasterStatus_MASTER_STATUS_UNSPECIFIED
+}
+
+func (x *MasterStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MasterStatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MasterStatusChange) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetMasterStatusHistoryOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*MasterStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *GetMasterStatusHistoryOut) Reset() {
+	*x = GetMasterStatusHistoryOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMasterStatusHistoryOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterStatusHistoryOut) ProtoMessage() {}
+
+func (x *GetMasterStatusHistoryOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterStatusHistoryOut.ProtoReflect.Descriptor instead.
+func (*GetMasterStatusHistoryOut) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMasterStatusHistoryOut) GetChanges() []*MasterStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ExportUserDataIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ExportUserDataIn) Reset() {
+	*x = ExportUserDataIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_masters_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MastersFilters) ProtoMessage() {}
+func (*ExportUserDataIn) ProtoMessage() {}
 
-func (x *MastersFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[10]
+func (x *ExportUserDataIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,50 +2158,44 @@ func (x *MastersFilters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MastersFilters.ProtoReflect.Descriptor instead.
-func (*MastersFilters) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *MastersFilters) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
-	}
-	return ""
+// Deprecated: Use ExportUserDataIn.ProtoReflect.Descriptor instead.
+func (*ExportUserDataIn) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MastersFilters) GetCreatedAtOrderByAsc() bool {
-	if x != nil && x.CreatedAtOrderByAsc != nil {
-		return *x.CreatedAtOrderByAsc
+func (x *ExportUserDataIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
 	}
-	return false
+	return 0
 }
 
-type CountOut struct {
+// Chunks of versioned JSON archive, which should be concatenated in received order.
+type ExportUserDataOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *CountOut) Reset() {
-	*x = CountOut{}
+func (x *ExportUserDataOut) Reset() {
+	*x = ExportUserDataOut{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_toys_masters_proto_msgTypes[11]
+		mi := &file_toys_masters_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountOut) String() string {
+func (x *ExportUserDataOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountOut) ProtoMessage() {}
+func (*ExportUserDataOut) ProtoMessage() {}
 
-func (x *CountOut) ProtoReflect() protoreflect.Message {
-	mi := &file_toys_masters_proto_msgTypes[11]
+func (x *ExportUserDataOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_masters_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,16 +2206,16 @@ func (x *CountOut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountOut.ProtoReflect.Descriptor instead.
-func (*CountOut) Descriptor() ([]byte, []int) {
-	return file_toys_masters_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ExportUserDataOut.ProtoReflect.Descriptor instead.
+func (*ExportUserDataOut) Descriptor() ([]byte, []int) {
+	return file_toys_masters_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CountOut) GetCount() uint64 {
+func (x *ExportUserDataOut) GetChunk() []byte {
 	if x != nil {
-		return x.Count
+		return x.Chunk
 	}
-	return 0
+	return nil
 }
 
 var File_toys_masters_proto protoreflect.FileDescriptor
@@ -666,36 +2226,94 @@ var file_toys_masters_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x10, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x2f, 0x0a, 0x11, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x59, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a,
+	0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x2f, 0x0a, 0x11, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x22, 0xf7, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x68, 0x6f,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x73,
+	0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x69, 0x70, 0x73, 0x54,
+	0x6f, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x69, 0x70, 0x73, 0x54, 0x6f,
+	0x12, 0x40, 0x0a, 0x0d, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x2d, 0x0a, 0x0f, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0f, 0x76,
+	0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x09, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a, 0x04,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x59, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
 	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
 	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x04, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08,
 	0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x73, 0x65, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
 	0x72, 0x73, 0x49, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
 	0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
@@ -703,66 +2321,314 @@ var file_toys_masters_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
 	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x4f,
-	0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x42, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x4d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x13, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73,
-	0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88, 0x01,
-	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x16, 0x0a, 0x14,
-	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x79, 0x41, 0x73, 0x63, 0x22, 0x20, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x9f, 0x03, 0x0a, 0x0e, 0x4d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f,
-	0x75, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x79, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x1a,
-	0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74,
+	0x52, 0x07, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x61, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x5d, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x49,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0xd2, 0x03, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x17,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x73, 0x68, 0x6f,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x69, 0x70, 0x73, 0x54, 0x6f, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x69, 0x70, 0x73, 0x54, 0x6f, 0x12, 0x3a,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x68, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x49, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x31, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x16, 0x0a,
+	0x14, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x41, 0x73, 0x63, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x22, 0x20, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x0e, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x2d, 0x0a, 0x0f, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x44, 0x22, 0x46,
+	0x0a, 0x10, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0xad, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x34, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x07,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x74, 0x6f, 0x22, 0x6c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x22, 0x56, 0x0a, 0x0e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x79, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x08, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x54, 0x6f, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f,
+	0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x4d, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x04, 0x63, 0x65, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xf0, 0x03, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x79, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x79, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x6f, 0x79, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x6e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x54, 0x6f, 0x79, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x6f,
+	0x75, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x54, 0x6f, 0x79, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x4f, 0x66, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x54, 0x6f, 0x79, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a,
+	0x0d, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x79, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x44,
+	0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x79, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x6e,
+	0x65, 0x77, 0x54, 0x6f, 0x79, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x41, 0x0a, 0x12,
+	0x6d, 0x6f, 0x73, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x64, 0x54, 0x6f,
+	0x79, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x54, 0x6f, 0x79, 0x52, 0x12, 0x6d, 0x6f, 0x73,
+	0x74, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x79, 0x73, 0x12,
+	0x3d, 0x0a, 0x10, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x6f, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x54, 0x6f, 0x79, 0x52, 0x10, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x42,
+	0x0a, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x79, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x52, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x35,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2a, 0x9b, 0x01, 0x0a,
+	0x0c, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x19, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x32, 0xa3, 0x09, 0x0a, 0x0e, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a,
+	0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x12,
+	0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x42, 0x79, 0x53, 0x6c, 0x75, 0x67, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x18, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x55, 0x6e, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x56,
+	0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x1a, 0x22, 0x2e,
 	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x49, 0x6e,
-	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f,
-	0x68, 0x6d, 0x74, 0x6d, 0x2d, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x49, 0x6e,
+	0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44,
+	0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d, 0x2d, 0x74, 0x6f, 0x79,
+	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -777,47 +2643,117 @@ func file_toys_masters_proto_rawDescGZIP() []byte {
 	return file_toys_masters_proto_rawDescData
 }
 
-var file_toys_masters_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_toys_masters_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_toys_masters_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_toys_masters_proto_goTypes = []interface{}{
-	(*RegisterMasterIn)(nil),      // 0: masters.RegisterMasterIn
-	(*RegisterMasterOut)(nil),     // 1: masters.RegisterMasterOut
-	(*GetMasterIn)(nil),           // 2: masters.GetMasterIn
-	(*GetMasterOut)(nil),          // 3: masters.GetMasterOut
-	(*Pagination)(nil),            // 4: masters.Pagination
-	(*GetMastersIn)(nil),          // 5: masters.GetMastersIn
-	(*GetMastersOut)(nil),         // 6: masters.GetMastersOut
-	(*GetMasterByUserIn)(nil),     // 7: masters.GetMasterByUserIn
-	(*UpdateMasterIn)(nil),        // 8: masters.UpdateMasterIn
-	(*CountMastersIn)(nil),        // 9: masters.CountMastersIn
-	(*MastersFilters)(nil),        // 10: masters.MastersFilters
-	(*CountOut)(nil),              // 11: masters.CountOut
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+	(MasterStatus)(0),                 // 0: masters.MasterStatus
+	(*RegisterMasterIn)(nil),          // 1: masters.RegisterMasterIn
+	(*RegisterMasterOut)(nil),         // 2: masters.RegisterMasterOut
+	(*GetMasterIn)(nil),               // 3: masters.GetMasterIn
+	(*GetMasterOut)(nil),              // 4: masters.GetMasterOut
+	(*User)(nil),                      // 5: masters.User
+	(*Pagination)(nil),                // 6: masters.Pagination
+	(*GetMastersIn)(nil),              // 7: masters.GetMastersIn
+	(*GetMastersOut)(nil),             // 8: masters.GetMastersOut
+	(*GetMasterByUserIn)(nil),         // 9: masters.GetMasterByUserIn
+	(*GetMasterBySlugIn)(nil),         // 10: masters.GetMasterBySlugIn
+	(*UpdateMasterIn)(nil),            // 11: masters.UpdateMasterIn
+	(*CountMastersIn)(nil),            // 12: masters.CountMastersIn
+	(*MastersFilters)(nil),            // 13: masters.MastersFilters
+	(*CountOut)(nil),                  // 14: masters.CountOut
+	(*FollowMasterIn)(nil),            // 15: masters.FollowMasterIn
+	(*FollowMasterOut)(nil),           // 16: masters.FollowMasterOut
+	(*UnfollowMasterIn)(nil),          // 17: masters.UnfollowMasterIn
+	(*GetFollowedMastersIn)(nil),      // 18: masters.GetFollowedMastersIn
+	(*CountFollowedMastersIn)(nil),    // 19: masters.CountFollowedMastersIn
+	(*StatsPeriod)(nil),               // 20: masters.StatsPeriod
+	(*GetMasterStatsIn)(nil),          // 21: masters.GetMasterStatsIn
+	(*DailyToysCount)(nil),            // 22: masters.DailyToysCount
+	(*StatsToy)(nil),                  // 23: masters.StatsToy
+	(*PriceBucket)(nil),               // 24: masters.PriceBucket
+	(*GetMasterStatsOut)(nil),         // 25: masters.GetMasterStatsOut
+	(*SetVacationModeIn)(nil),         // 26: masters.SetVacationModeIn
+	(*ChangeMasterStatusIn)(nil),      // 27: masters.ChangeMasterStatusIn
+	(*GetMasterStatusHistoryIn)(nil),  // 28: masters.GetMasterStatusHistoryIn
+	(*MasterStatusChange)(nil),        // 29: masters.MasterStatusChange
+	(*GetMasterStatusHistoryOut)(nil), // 30: masters.GetMasterStatusHistoryOut
+	(*ExportUserDataIn)(nil),          // 31: masters.ExportUserDataIn
+	(*ExportUserDataOut)(nil),         // 32: masters.ExportUserDataOut
+	(*fieldmaskpb.FieldMask)(nil),     // 33: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),     // 34: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 35: google.protobuf.Empty
 }
 var file_toys_masters_proto_depIdxs = []int32{
-	12, // 0: masters.GetMasterOut.createdAt:type_name -> google.protobuf.Timestamp
-	12, // 1: masters.GetMasterOut.updatedAt:type_name -> google.protobuf.Timestamp
-	4,  // 2: masters.GetMastersIn.pagination:type_name -> masters.Pagination
-	10, // 3: masters.GetMastersIn.filters:type_name -> masters.MastersFilters
-	3,  // 4: masters.GetMastersOut.masters:type_name -> masters.GetMasterOut
-	10, // 5: masters.CountMastersIn.filters:type_name -> masters.MastersFilters
-	0,  // 6: masters.MastersService.RegisterMaster:input_type -> masters.RegisterMasterIn
-	2,  // 7: masters.MastersService.GetMaster:input_type -> masters.GetMasterIn
-	7,  // 8: masters.MastersService.GetMasterByUser:input_type -> masters.GetMasterByUserIn
-	5,  // 9: masters.MastersService.GetMasters:input_type -> masters.GetMastersIn
-	9,  // 10: masters.MastersService.CountMasters:input_type -> masters.CountMastersIn
-	8,  // 11: masters.MastersService.UpdateMaster:input_type -> masters.UpdateMasterIn
-	1,  // 12: masters.MastersService.RegisterMaster:output_type -> masters.RegisterMasterOut
-	3,  // 13: masters.MastersService.GetMaster:output_type -> masters.GetMasterOut
-	3,  // 14: masters.MastersService.GetMasterByUser:output_type -> masters.GetMasterOut
-	6,  // 15: masters.MastersService.GetMasters:output_type -> masters.GetMastersOut
-	11, // 16: masters.MastersService.CountMasters:output_type -> masters.CountOut
-	13, // 17: masters.MastersService.UpdateMaster:output_type -> google.protobuf.Empty
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	33, // 0: masters.GetMasterIn.include:type_name -> google.protobuf.FieldMask
+	34, // 1: masters.GetMasterOut.createdAt:type_name -> google.protobuf.Timestamp
+	34, // 2: masters.GetMasterOut.updatedAt:type_name -> google.protobuf.Timestamp
+	34, // 3: masters.GetMasterOut.vacationUntil:type_name -> google.protobuf.Timestamp
+	0,  // 4: masters.GetMasterOut.status:type_name -> masters.MasterStatus
+	5,  // 5: masters.GetMasterOut.user:type_name -> masters.User
+	34, // 6: masters.User.createdAt:type_name -> google.protobuf.Timestamp
+	6,  // 7: masters.GetMastersIn.pagination:type_name -> masters.Pagination
+	13, // 8: masters.GetMastersIn.filters:type_name -> masters.MastersFilters
+	33, // 9: masters.GetMastersIn.include:type_name -> google.protobuf.FieldMask
+	4,  // 10: masters.GetMastersOut.masters:type_name -> masters.GetMasterOut
+	33, // 11: masters.GetMasterByUserIn.include:type_name -> google.protobuf.FieldMask
+	33, // 12: masters.GetMasterBySlugIn.include:type_name -> google.protobuf.FieldMask
+	33, // 13: masters.UpdateMasterIn.updateMask:type_name -> google.protobuf.FieldMask
+	13, // 14: masters.CountMastersIn.filters:type_name -> masters.MastersFilters
+	0,  // 15: masters.MastersFilters.statuses:type_name -> masters.MasterStatus
+	6,  // 16: masters.GetFollowedMastersIn.pagination:type_name -> masters.Pagination
+	33, // 17: masters.GetFollowedMastersIn.include:type_name -> google.protobuf.FieldMask
+	34, // 18: masters.StatsPeriod.from:type_name -> google.protobuf.Timestamp
+	34, // 19: masters.StatsPeriod.to:type_name -> google.protobuf.Timestamp
+	20, // 20: masters.GetMasterStatsIn.period:type_name -> masters.StatsPeriod
+	34, // 21: masters.DailyToysCount.date:type_name -> google.protobuf.Timestamp
+	22, // 22: masters.GetMasterStatsOut.newToysPerDay:type_name -> masters.DailyToysCount
+	23, // 23: masters.GetMasterStatsOut.mostFavouritedToys:type_name -> masters.StatsToy
+	23, // 24: masters.GetMasterStatsOut.highestRatedToys:type_name -> masters.StatsToy
+	24, // 25: masters.GetMasterStatsOut.priceDistribution:type_name -> masters.PriceBucket
+	34, // 26: masters.SetVacationModeIn.until:type_name -> google.protobuf.Timestamp
+	0,  // 27: masters.ChangeMasterStatusIn.status:type_name -> masters.MasterStatus
+	0,  // 28: masters.MasterStatusChange.previousStatus:type_name -> masters.MasterStatus
+	0,  // 29: masters.MasterStatusChange.status:type_name -> masters.MasterStatus
+	34, // 30: masters.MasterStatusChange.createdAt:type_name -> google.protobuf.Timestamp
+	34, // 31: masters.MasterStatusChange.updatedAt:type_name -> google.protobuf.Timestamp
+	29, // 32: masters.GetMasterStatusHistoryOut.changes:type_name -> masters.MasterStatusChange
+	1,  // 33: masters.MastersService.RegisterMaster:input_type -> masters.RegisterMasterIn
+	3,  // 34: masters.MastersService.GetMaster:input_type -> masters.GetMasterIn
+	9,  // 35: masters.MastersService.GetMasterByUser:input_type -> masters.GetMasterByUserIn
+	10, // 36: masters.MastersService.GetMasterBySlug:input_type -> masters.GetMasterBySlugIn
+	7,  // 37: masters.MastersService.GetMasters:input_type -> masters.GetMastersIn
+	12, // 38: masters.MastersService.CountMasters:input_type -> masters.CountMastersIn
+	11, // 39: masters.MastersService.UpdateMaster:input_type -> masters.UpdateMasterIn
+	15, // 40: masters.MastersService.FollowMaster:input_type -> masters.FollowMasterIn
+	17, // 41: masters.MastersService.UnfollowMaster:input_type -> masters.UnfollowMasterIn
+	18, // 42: masters.MastersService.GetFollowedMasters:input_type -> masters.GetFollowedMastersIn
+	19, // 43: masters.MastersService.CountFollowedMasters:input_type -> masters.CountFollowedMastersIn
+	21, // 44: masters.MastersService.GetMasterStats:input_type -> masters.GetMasterStatsIn
+	26, // 45: masters.MastersService.SetVacationMode:input_type -> masters.SetVacationModeIn
+	27, // 46: masters.MastersService.ChangeMasterStatus:input_type -> masters.ChangeMasterStatusIn
+	28, // 47: masters.MastersService.GetMasterStatusHistory:input_type -> masters.GetMasterStatusHistoryIn
+	31, // 48: masters.MastersService.ExportUserData:input_type -> masters.ExportUserDataIn
+	2,  // 49: masters.MastersService.RegisterMaster:output_type -> masters.RegisterMasterOut
+	4,  // 50: masters.MastersService.GetMaster:output_type -> masters.GetMasterOut
+	4,  // 51: masters.MastersService.GetMasterByUser:output_type -> masters.GetMasterOut
+	4,  // 52: masters.MastersService.GetMasterBySlug:output_type -> masters.GetMasterOut
+	8,  // 53: masters.MastersService.GetMasters:output_type -> masters.GetMastersOut
+	14, // 54: masters.MastersService.CountMasters:output_type -> masters.CountOut
+	35, // 55: masters.MastersService.UpdateMaster:output_type -> google.protobuf.Empty
+	16, // 56: masters.MastersService.FollowMaster:output_type -> masters.FollowMasterOut
+	35, // 57: masters.MastersService.UnfollowMaster:output_type -> google.protobuf.Empty
+	8,  // 58: masters.MastersService.GetFollowedMasters:output_type -> masters.GetMastersOut
+	14, // 59: masters.MastersService.CountFollowedMasters:output_type -> masters.CountOut
+	25, // 60: masters.MastersService.GetMasterStats:output_type -> masters.GetMasterStatsOut
+	35, // 61: masters.MastersService.SetVacationMode:output_type -> google.protobuf.Empty
+	35, // 62: masters.MastersService.ChangeMasterStatus:output_type -> google.protobuf.Empty
+	30, // 63: masters.MastersService.GetMasterStatusHistory:output_type -> masters.GetMasterStatusHistoryOut
+	32, // 64: masters.MastersService.ExportUserData:output_type -> masters.ExportUserDataOut
+	49, // [49:65] is the sub-list for method output_type
+	33, // [33:49] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_toys_masters_proto_init() }
@@ -875,7 +2811,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -887,7 +2823,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMastersIn); i {
+			switch v := v.(*Pagination); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -899,7 +2835,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMastersOut); i {
+			switch v := v.(*GetMastersIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -911,7 +2847,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMasterByUserIn); i {
+			switch v := v.(*GetMastersOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -923,7 +2859,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMasterIn); i {
+			switch v := v.(*GetMasterByUserIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -935,7 +2871,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountMastersIn); i {
+			switch v := v.(*GetMasterBySlugIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -947,7 +2883,7 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MastersFilters); i {
+			switch v := v.(*UpdateMasterIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -959,6 +2895,30 @@ func file_toys_masters_proto_init() {
 			}
 		}
 		file_toys_masters_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountMastersIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MastersFilters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountOut); i {
 			case 0:
 				return &v.state
@@ -970,26 +2930,247 @@ func file_toys_masters_proto_init() {
 				return nil
 			}
 		}
+		file_toys_masters_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowMasterIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowMasterOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowMasterIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowedMastersIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountFollowedMastersIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMasterStatsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyToysCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsToy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMasterStatsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVacationModeIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeMasterStatusIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMasterStatusHistoryIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MasterStatusChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMasterStatusHistoryOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_masters_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_toys_masters_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_toys_masters_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_toys_masters_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_toys_masters_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_toys_masters_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_toys_masters_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_toys_masters_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	file_toys_masters_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_toys_masters_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_toys_masters_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_toys_masters_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_toys_masters_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_toys_masters_proto_msgTypes[25].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_toys_masters_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      1,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_toys_masters_proto_goTypes,
 		DependencyIndexes: file_toys_masters_proto_depIdxs,
+		EnumInfos:         file_toys_masters_proto_enumTypes,
 		MessageInfos:      file_toys_masters_proto_msgTypes,
 	}.Build()
 	File_toys_masters_proto = out.File
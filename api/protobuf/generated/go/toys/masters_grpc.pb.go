@@ -22,9 +22,19 @@ type MastersServiceClient interface {
 	RegisterMaster(ctx context.Context, in *RegisterMasterIn, opts ...grpc.CallOption) (*RegisterMasterOut, error)
 	GetMaster(ctx context.Context, in *GetMasterIn, opts ...grpc.CallOption) (*GetMasterOut, error)
 	GetMasterByUser(ctx context.Context, in *GetMasterByUserIn, opts ...grpc.CallOption) (*GetMasterOut, error)
+	GetMasterBySlug(ctx context.Context, in *GetMasterBySlugIn, opts ...grpc.CallOption) (*GetMasterOut, error)
 	GetMasters(ctx context.Context, in *GetMastersIn, opts ...grpc.CallOption) (*GetMastersOut, error)
 	CountMasters(ctx context.Context, in *CountMastersIn, opts ...grpc.CallOption) (*CountOut, error)
 	UpdateMaster(ctx context.Context, in *UpdateMasterIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FollowMaster(ctx context.Context, in *FollowMasterIn, opts ...grpc.CallOption) (*FollowMasterOut, error)
+	UnfollowMaster(ctx context.Context, in *UnfollowMasterIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFollowedMasters(ctx context.Context, in *GetFollowedMastersIn, opts ...grpc.CallOption) (*GetMastersOut, error)
+	CountFollowedMasters(ctx context.Context, in *CountFollowedMastersIn, opts ...grpc.CallOption) (*CountOut, error)
+	GetMasterStats(ctx context.Context, in *GetMasterStatsIn, opts ...grpc.CallOption) (*GetMasterStatsOut, error)
+	SetVacationMode(ctx context.Context, in *SetVacationModeIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ChangeMasterStatus(ctx context.Context, in *ChangeMasterStatusIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMasterStatusHistory(ctx context.Context, in *GetMasterStatusHistoryIn, opts ...grpc.CallOption) (*GetMasterStatusHistoryOut, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataIn, opts ...grpc.CallOption) (MastersService_ExportUserDataClient, error)
 }
 
 type mastersServiceClient struct {
@@ -62,6 +72,15 @@ func (c *mastersServiceClient) GetMasterByUser(ctx context.Context, in *GetMaste
 	return out, nil
 }
 
+func (c *mastersServiceClient) GetMasterBySlug(ctx context.Context, in *GetMasterBySlugIn, opts ...grpc.CallOption) (*GetMasterOut, error) {
+	out := new(GetMasterOut)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/GetMasterBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mastersServiceClient) GetMasters(ctx context.Context, in *GetMastersIn, opts ...grpc.CallOption) (*GetMastersOut, error) {
 	out := new(GetMastersOut)
 	err := c.cc.Invoke(ctx, "/masters.MastersService/GetMasters", in, out, opts...)
@@ -89,6 +108,110 @@ func (c *mastersServiceClient) UpdateMaster(ctx context.Context, in *UpdateMaste
 	return out, nil
 }
 
+func (c *mastersServiceClient) FollowMaster(ctx context.Context, in *FollowMasterIn, opts ...grpc.CallOption) (*FollowMasterOut, error) {
+	out := new(FollowMasterOut)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/FollowMaster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) UnfollowMaster(ctx context.Context, in *UnfollowMasterIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/UnfollowMaster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) GetFollowedMasters(ctx context.Context, in *GetFollowedMastersIn, opts ...grpc.CallOption) (*GetMastersOut, error) {
+	out := new(GetMastersOut)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/GetFollowedMasters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) CountFollowedMasters(ctx context.Context, in *CountFollowedMastersIn, opts ...grpc.CallOption) (*CountOut, error) {
+	out := new(CountOut)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/CountFollowedMasters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) GetMasterStats(ctx context.Context, in *GetMasterStatsIn, opts ...grpc.CallOption) (*GetMasterStatsOut, error) {
+	out := new(GetMasterStatsOut)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/GetMasterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) SetVacationMode(ctx context.Context, in *SetVacationModeIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/SetVacationMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) ChangeMasterStatus(ctx context.Context, in *ChangeMasterStatusIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/ChangeMasterStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) GetMasterStatusHistory(ctx context.Context, in *GetMasterStatusHistoryIn, opts ...grpc.CallOption) (*GetMasterStatusHistoryOut, error) {
+	out := new(GetMasterStatusHistoryOut)
+	err := c.cc.Invoke(ctx, "/masters.MastersService/GetMasterStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mastersServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataIn, opts ...grpc.CallOption) (MastersService_ExportUserDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MastersService_ServiceDesc.Streams[0], "/masters.MastersService/ExportUserData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mastersServiceExportUserDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MastersService_ExportUserDataClient interface {
+	Recv() (*ExportUserDataOut, error)
+	grpc.ClientStream
+}
+
+type mastersServiceExportUserDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *mastersServiceExportUserDataClient) Recv() (*ExportUserDataOut, error) {
+	m := new(ExportUserDataOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MastersServiceServer is the server API for MastersService service.
 // All implementations must embed UnimplementedMastersServiceServer
 // for forward compatibility
@@ -96,9 +219,19 @@ type MastersServiceServer interface {
 	RegisterMaster(context.Context, *RegisterMasterIn) (*RegisterMasterOut, error)
 	GetMaster(context.Context, *GetMasterIn) (*GetMasterOut, error)
 	GetMasterByUser(context.Context, *GetMasterByUserIn) (*GetMasterOut, error)
+	GetMasterBySlug(context.Context, *GetMasterBySlugIn) (*GetMasterOut, error)
 	GetMasters(context.Context, *GetMastersIn) (*GetMastersOut, error)
 	CountMasters(context.Context, *CountMastersIn) (*CountOut, error)
 	UpdateMaster(context.Context, *UpdateMasterIn) (*emptypb.Empty, error)
+	FollowMaster(context.Context, *FollowMasterIn) (*FollowMasterOut, error)
+	UnfollowMaster(context.Context, *UnfollowMasterIn) (*emptypb.Empty, error)
+	GetFollowedMasters(context.Context, *GetFollowedMastersIn) (*GetMastersOut, error)
+	CountFollowedMasters(context.Context, *CountFollowedMastersIn) (*CountOut, error)
+	GetMasterStats(context.Context, *GetMasterStatsIn) (*GetMasterStatsOut, error)
+	SetVacationMode(context.Context, *SetVacationModeIn) (*emptypb.Empty, error)
+	ChangeMasterStatus(context.Context, *ChangeMasterStatusIn) (*emptypb.Empty, error)
+	GetMasterStatusHistory(context.Context, *GetMasterStatusHistoryIn) (*GetMasterStatusHistoryOut, error)
+	ExportUserData(*ExportUserDataIn, MastersService_ExportUserDataServer) error
 	mustEmbedUnimplementedMastersServiceServer()
 }
 
@@ -115,6 +248,9 @@ func (UnimplementedMastersServiceServer) GetMaster(context.Context, *GetMasterIn
 func (UnimplementedMastersServiceServer) GetMasterByUser(context.Context, *GetMasterByUserIn) (*GetMasterOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMasterByUser not implemented")
 }
+func (UnimplementedMastersServiceServer) GetMasterBySlug(context.Context, *GetMasterBySlugIn) (*GetMasterOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterBySlug not implemented")
+}
 func (UnimplementedMastersServiceServer) GetMasters(context.Context, *GetMastersIn) (*GetMastersOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMasters not implemented")
 }
@@ -124,6 +260,33 @@ func (UnimplementedMastersServiceServer) CountMasters(context.Context, *CountMas
 func (UnimplementedMastersServiceServer) UpdateMaster(context.Context, *UpdateMasterIn) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaster not implemented")
 }
+func (UnimplementedMastersServiceServer) FollowMaster(context.Context, *FollowMasterIn) (*FollowMasterOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowMaster not implemented")
+}
+func (UnimplementedMastersServiceServer) UnfollowMaster(context.Context, *UnfollowMasterIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowMaster not implemented")
+}
+func (UnimplementedMastersServiceServer) GetFollowedMasters(context.Context, *GetFollowedMastersIn) (*GetMastersOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowedMasters not implemented")
+}
+func (UnimplementedMastersServiceServer) CountFollowedMasters(context.Context, *CountFollowedMastersIn) (*CountOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountFollowedMasters not implemented")
+}
+func (UnimplementedMastersServiceServer) GetMasterStats(context.Context, *GetMasterStatsIn) (*GetMasterStatsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterStats not implemented")
+}
+func (UnimplementedMastersServiceServer) SetVacationMode(context.Context, *SetVacationModeIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVacationMode not implemented")
+}
+func (UnimplementedMastersServiceServer) ChangeMasterStatus(context.Context, *ChangeMasterStatusIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMasterStatus not implemented")
+}
+func (UnimplementedMastersServiceServer) GetMasterStatusHistory(context.Context, *GetMasterStatusHistoryIn) (*GetMasterStatusHistoryOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterStatusHistory not implemented")
+}
+func (UnimplementedMastersServiceServer) ExportUserData(*ExportUserDataIn, MastersService_ExportUserDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedMastersServiceServer) mustEmbedUnimplementedMastersServiceServer() {}
 
 // UnsafeMastersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +354,24 @@ func _MastersService_GetMasterByUser_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MastersService_GetMasterBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterBySlugIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).GetMasterBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/GetMasterBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).GetMasterBySlug(ctx, req.(*GetMasterBySlugIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MastersService_GetMasters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMastersIn)
 	if err := dec(in); err != nil {
@@ -245,6 +426,171 @@ func _MastersService_UpdateMaster_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MastersService_FollowMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowMasterIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).FollowMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/FollowMaster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).FollowMaster(ctx, req.(*FollowMasterIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_UnfollowMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowMasterIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).UnfollowMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/UnfollowMaster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).UnfollowMaster(ctx, req.(*UnfollowMasterIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_GetFollowedMasters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowedMastersIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).GetFollowedMasters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/GetFollowedMasters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).GetFollowedMasters(ctx, req.(*GetFollowedMastersIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_CountFollowedMasters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFollowedMastersIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).CountFollowedMasters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/CountFollowedMasters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).CountFollowedMasters(ctx, req.(*CountFollowedMastersIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_GetMasterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterStatsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).GetMasterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/GetMasterStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).GetMasterStats(ctx, req.(*GetMasterStatsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_SetVacationMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVacationModeIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).SetVacationMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/SetVacationMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).SetVacationMode(ctx, req.(*SetVacationModeIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_ChangeMasterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMasterStatusIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).ChangeMasterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/ChangeMasterStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).ChangeMasterStatus(ctx, req.(*ChangeMasterStatusIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_GetMasterStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterStatusHistoryIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MastersServiceServer).GetMasterStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/masters.MastersService/GetMasterStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MastersServiceServer).GetMasterStatusHistory(ctx, req.(*GetMasterStatusHistoryIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MastersService_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MastersServiceServer).ExportUserData(m, &mastersServiceExportUserDataServer{stream})
+}
+
+type MastersService_ExportUserDataServer interface {
+	Send(*ExportUserDataOut) error
+	grpc.ServerStream
+}
+
+type mastersServiceExportUserDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *mastersServiceExportUserDataServer) Send(m *ExportUserDataOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // MastersService_ServiceDesc is the grpc.ServiceDesc for MastersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -264,6 +610,10 @@ var MastersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMasterByUser",
 			Handler:    _MastersService_GetMasterByUser_Handler,
 		},
+		{
+			MethodName: "GetMasterBySlug",
+			Handler:    _MastersService_GetMasterBySlug_Handler,
+		},
 		{
 			MethodName: "GetMasters",
 			Handler:    _MastersService_GetMasters_Handler,
@@ -276,7 +626,45 @@ var MastersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMaster",
 			Handler:    _MastersService_UpdateMaster_Handler,
 		},
+		{
+			MethodName: "FollowMaster",
+			Handler:    _MastersService_FollowMaster_Handler,
+		},
+		{
+			MethodName: "UnfollowMaster",
+			Handler:    _MastersService_UnfollowMaster_Handler,
+		},
+		{
+			MethodName: "GetFollowedMasters",
+			Handler:    _MastersService_GetFollowedMasters_Handler,
+		},
+		{
+			MethodName: "CountFollowedMasters",
+			Handler:    _MastersService_CountFollowedMasters_Handler,
+		},
+		{
+			MethodName: "GetMasterStats",
+			Handler:    _MastersService_GetMasterStats_Handler,
+		},
+		{
+			MethodName: "SetVacationMode",
+			Handler:    _MastersService_SetVacationMode_Handler,
+		},
+		{
+			MethodName: "ChangeMasterStatus",
+			Handler:    _MastersService_ChangeMasterStatus_Handler,
+		},
+		{
+			MethodName: "GetMasterStatusHistory",
+			Handler:    _MastersService_GetMasterStatusHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _MastersService_ExportUserData_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "toys/masters.proto",
 }
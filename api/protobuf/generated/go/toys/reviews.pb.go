@@ -0,0 +1,982 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0-devel
+// 	protoc        v3.14.0
+// source: toys/reviews.proto
+
+package toys
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddReviewIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64   `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ToyID  uint64   `protobuf:"varint,2,opt,name=toyID,proto3" json:"toyID,omitempty"`
+	Rating uint32   `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text   *string  `protobuf:"bytes,4,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	Photos []string `protobuf:"bytes,5,rep,name=photos,proto3" json:"photos,omitempty"`
+}
+
+func (x *AddReviewIn) Reset() {
+	*x = AddReviewIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddReviewIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReviewIn) ProtoMessage() {}
+
+func (x *AddReviewIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReviewIn.ProtoReflect.Descriptor instead.
+func (*AddReviewIn) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddReviewIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddReviewIn) GetToyID() uint64 {
+	if x != nil {
+		return x.ToyID
+	}
+	return 0
+}
+
+func (x *AddReviewIn) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *AddReviewIn) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *AddReviewIn) GetPhotos() []string {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+type AddReviewOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewID uint64 `protobuf:"varint,1,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+}
+
+func (x *AddReviewOut) Reset() {
+	*x = AddReviewOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddReviewOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReviewOut) ProtoMessage() {}
+
+func (x *AddReviewOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReviewOut.ProtoReflect.Descriptor instead.
+func (*AddReviewOut) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddReviewOut) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+type GetReviewIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *GetReviewIn) Reset() {
+	*x = GetReviewIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReviewIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewIn) ProtoMessage() {}
+
+func (x *GetReviewIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewIn.ProtoReflect.Descriptor instead.
+func (*GetReviewIn) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetReviewIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type ReviewPhoto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ReviewID  uint64                 `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	Link      string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *ReviewPhoto) Reset() {
+	*x = ReviewPhoto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewPhoto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPhoto) ProtoMessage() {}
+
+func (x *ReviewPhoto) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPhoto.ProtoReflect.Descriptor instead.
+func (*ReviewPhoto) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReviewPhoto) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ReviewPhoto) GetReviewID() uint64 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *ReviewPhoto) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ReviewPhoto) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReviewPhoto) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetReviewOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ToyID     uint64                 `protobuf:"varint,2,opt,name=toyID,proto3" json:"toyID,omitempty"`
+	UserID    uint64                 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Rating    uint32                 `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text      *string                `protobuf:"bytes,5,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	Photos    []*ReviewPhoto         `protobuf:"bytes,6,rep,name=photos,proto3" json:"photos,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *GetReviewOut) Reset() {
+	*x = GetReviewOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReviewOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewOut) ProtoMessage() {}
+
+func (x *GetReviewOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewOut.ProtoReflect.Descriptor instead.
+func (*GetReviewOut) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetReviewOut) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *GetReviewOut) GetToyID() uint64 {
+	if x != nil {
+		return x.ToyID
+	}
+	return 0
+}
+
+func (x *GetReviewOut) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetReviewOut) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *GetReviewOut) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *GetReviewOut) GetPhotos() []*ReviewPhoto {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *GetReviewOut) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetReviewOut) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetToyReviewsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToyID      uint64      `protobuf:"varint,1,opt,name=toyID,proto3" json:"toyID,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+}
+
+func (x *GetToyReviewsIn) Reset() {
+	*x = GetToyReviewsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetToyReviewsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToyReviewsIn) ProtoMessage() {}
+
+func (x *GetToyReviewsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToyReviewsIn.ProtoReflect.Descriptor instead.
+func (*GetToyReviewsIn) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetToyReviewsIn) GetToyID() uint64 {
+	if x != nil {
+		return x.ToyID
+	}
+	return 0
+}
+
+func (x *GetToyReviewsIn) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetReviewsOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviews []*GetReviewOut `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+}
+
+func (x *GetReviewsOut) Reset() {
+	*x = GetReviewsOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReviewsOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsOut) ProtoMessage() {}
+
+func (x *GetReviewsOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsOut.ProtoReflect.Descriptor instead.
+func (*GetReviewsOut) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReviewsOut) GetReviews() []*GetReviewOut {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type CountToyReviewsIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToyID uint64 `protobuf:"varint,1,opt,name=toyID,proto3" json:"toyID,omitempty"`
+}
+
+func (x *CountToyReviewsIn) Reset() {
+	*x = CountToyReviewsIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountToyReviewsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountToyReviewsIn) ProtoMessage() {}
+
+func (x *CountToyReviewsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountToyReviewsIn.ProtoReflect.Descriptor instead.
+func (*CountToyReviewsIn) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CountToyReviewsIn) GetToyID() uint64 {
+	if x != nil {
+		return x.ToyID
+	}
+	return 0
+}
+
+type UpdateReviewIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Rating *uint32  `protobuf:"varint,2,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	Text   *string  `protobuf:"bytes,3,opt,name=text,proto3,oneof" json:"text,omitempty"`
+	Photos []string `protobuf:"bytes,4,rep,name=photos,proto3" json:"photos,omitempty"`
+	// Paths of fields to change. Without mask only provided fields are changed, and empty lists are ignored.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+}
+
+func (x *UpdateReviewIn) Reset() {
+	*x = UpdateReviewIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReviewIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewIn) ProtoMessage() {}
+
+func (x *UpdateReviewIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewIn.ProtoReflect.Descriptor instead.
+func (*UpdateReviewIn) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateReviewIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *UpdateReviewIn) GetRating() uint32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateReviewIn) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *UpdateReviewIn) GetPhotos() []string {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *UpdateReviewIn) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteReviewIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DeleteReviewIn) Reset() {
+	*x = DeleteReviewIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_reviews_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReviewIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewIn) ProtoMessage() {}
+
+func (x *DeleteReviewIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_reviews_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewIn.ProtoReflect.Descriptor instead.
+func (*DeleteReviewIn) Descriptor() ([]byte, []int) {
+	return file_toys_reviews_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteReviewIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+var File_toys_reviews_proto protoreflect.FileDescriptor
+
+var file_toys_reviews_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x74,
+	0x6f, 0x79, 0x73, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x2a, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x75,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x22, 0x1d, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xc1, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xa8, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x75,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x22, 0x70, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
+	0x6f, 0x79, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x2f,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22,
+	0x29, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x49, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1b, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x22, 0x20, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x32, 0x97, 0x03,
+	0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x14, 0x2e, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x1a,
+	0x15, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x1a, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x6f, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x17, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68,
+	0x6d, 0x74, 0x6d, 0x2d, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_toys_reviews_proto_rawDescOnce sync.Once
+	file_toys_reviews_proto_rawDescData = file_toys_reviews_proto_rawDesc
+)
+
+func file_toys_reviews_proto_rawDescGZIP() []byte {
+	file_toys_reviews_proto_rawDescOnce.Do(func() {
+		file_toys_reviews_proto_rawDescData = protoimpl.X.CompressGZIP(file_toys_reviews_proto_rawDescData)
+	})
+	return file_toys_reviews_proto_rawDescData
+}
+
+var file_toys_reviews_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_toys_reviews_proto_goTypes = []interface{}{
+	(*AddReviewIn)(nil),           // 0: reviews.AddReviewIn
+	(*AddReviewOut)(nil),          // 1: reviews.AddReviewOut
+	(*GetReviewIn)(nil),           // 2: reviews.GetReviewIn
+	(*ReviewPhoto)(nil),           // 3: reviews.ReviewPhoto
+	(*GetReviewOut)(nil),          // 4: reviews.GetReviewOut
+	(*GetToyReviewsIn)(nil),       // 5: reviews.GetToyReviewsIn
+	(*GetReviewsOut)(nil),         // 6: reviews.GetReviewsOut
+	(*CountToyReviewsIn)(nil),     // 7: reviews.CountToyReviewsIn
+	(*UpdateReviewIn)(nil),        // 8: reviews.UpdateReviewIn
+	(*DeleteReviewIn)(nil),        // 9: reviews.DeleteReviewIn
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*Pagination)(nil),            // 11: masters.Pagination
+	(*fieldmaskpb.FieldMask)(nil), // 12: google.protobuf.FieldMask
+	(*CountOut)(nil),              // 13: masters.CountOut
+	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+}
+var file_toys_reviews_proto_depIdxs = []int32{
+	10, // 0: reviews.ReviewPhoto.createdAt:type_name -> google.protobuf.Timestamp
+	10, // 1: reviews.ReviewPhoto.updatedAt:type_name -> google.protobuf.Timestamp
+	3,  // 2: reviews.GetReviewOut.photos:type_name -> reviews.ReviewPhoto
+	10, // 3: reviews.GetReviewOut.createdAt:type_name -> google.protobuf.Timestamp
+	10, // 4: reviews.GetReviewOut.updatedAt:type_name -> google.protobuf.Timestamp
+	11, // 5: reviews.GetToyReviewsIn.pagination:type_name -> masters.Pagination
+	4,  // 6: reviews.GetReviewsOut.reviews:type_name -> reviews.GetReviewOut
+	12, // 7: reviews.UpdateReviewIn.updateMask:type_name -> google.protobuf.FieldMask
+	0,  // 8: reviews.ReviewsService.AddReview:input_type -> reviews.AddReviewIn
+	2,  // 9: reviews.ReviewsService.GetReview:input_type -> reviews.GetReviewIn
+	5,  // 10: reviews.ReviewsService.GetToyReviews:input_type -> reviews.GetToyReviewsIn
+	7,  // 11: reviews.ReviewsService.CountToyReviews:input_type -> reviews.CountToyReviewsIn
+	8,  // 12: reviews.ReviewsService.UpdateReview:input_type -> reviews.UpdateReviewIn
+	9,  // 13: reviews.ReviewsService.DeleteReview:input_type -> reviews.DeleteReviewIn
+	1,  // 14: reviews.ReviewsService.AddReview:output_type -> reviews.AddReviewOut
+	4,  // 15: reviews.ReviewsService.GetReview:output_type -> reviews.GetReviewOut
+	6,  // 16: reviews.ReviewsService.GetToyReviews:output_type -> reviews.GetReviewsOut
+	13, // 17: reviews.ReviewsService.CountToyReviews:output_type -> masters.CountOut
+	14, // 18: reviews.ReviewsService.UpdateReview:output_type -> google.protobuf.Empty
+	14, // 19: reviews.ReviewsService.DeleteReview:output_type -> google.protobuf.Empty
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_toys_reviews_proto_init() }
+func file_toys_reviews_proto_init() {
+	if File_toys_reviews_proto != nil {
+		return
+	}
+	file_toys_masters_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_toys_reviews_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReviewIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReviewOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReviewIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewPhoto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReviewOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToyReviewsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReviewsOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountToyReviewsIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReviewIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_reviews_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReviewIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_toys_reviews_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_toys_reviews_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_toys_reviews_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_toys_reviews_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_toys_reviews_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_toys_reviews_proto_goTypes,
+		DependencyIndexes: file_toys_reviews_proto_depIdxs,
+		MessageInfos:      file_toys_reviews_proto_msgTypes,
+	}.Build()
+	File_toys_reviews_proto = out.File
+	file_toys_reviews_proto_rawDesc = nil
+	file_toys_reviews_proto_goTypes = nil
+	file_toys_reviews_proto_depIdxs = nil
+}
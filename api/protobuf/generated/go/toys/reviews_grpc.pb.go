@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package toys
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ReviewsServiceClient is the client API for ReviewsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewsServiceClient interface {
+	AddReview(ctx context.Context, in *AddReviewIn, opts ...grpc.CallOption) (*AddReviewOut, error)
+	GetReview(ctx context.Context, in *GetReviewIn, opts ...grpc.CallOption) (*GetReviewOut, error)
+	GetToyReviews(ctx context.Context, in *GetToyReviewsIn, opts ...grpc.CallOption) (*GetReviewsOut, error)
+	CountToyReviews(ctx context.Context, in *CountToyReviewsIn, opts ...grpc.CallOption) (*CountOut, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type reviewsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewsServiceClient(cc grpc.ClientConnInterface) ReviewsServiceClient {
+	return &reviewsServiceClient{cc}
+}
+
+func (c *reviewsServiceClient) AddReview(ctx context.Context, in *AddReviewIn, opts ...grpc.CallOption) (*AddReviewOut, error) {
+	out := new(AddReviewOut)
+	err := c.cc.Invoke(ctx, "/reviews.ReviewsService/AddReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) GetReview(ctx context.Context, in *GetReviewIn, opts ...grpc.CallOption) (*GetReviewOut, error) {
+	out := new(GetReviewOut)
+	err := c.cc.Invoke(ctx, "/reviews.ReviewsService/GetReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) GetToyReviews(ctx context.Context, in *GetToyReviewsIn, opts ...grpc.CallOption) (*GetReviewsOut, error) {
+	out := new(GetReviewsOut)
+	err := c.cc.Invoke(ctx, "/reviews.ReviewsService/GetToyReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) CountToyReviews(ctx context.Context, in *CountToyReviewsIn, opts ...grpc.CallOption) (*CountOut, error) {
+	out := new(CountOut)
+	err := c.cc.Invoke(ctx, "/reviews.ReviewsService/CountToyReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/reviews.ReviewsService/UpdateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewsServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/reviews.ReviewsService/DeleteReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewsServiceServer is the server API for ReviewsService service.
+// All implementations must embed UnimplementedReviewsServiceServer
+// for forward compatibility
+type ReviewsServiceServer interface {
+	AddReview(context.Context, *AddReviewIn) (*AddReviewOut, error)
+	GetReview(context.Context, *GetReviewIn) (*GetReviewOut, error)
+	GetToyReviews(context.Context, *GetToyReviewsIn) (*GetReviewsOut, error)
+	CountToyReviews(context.Context, *CountToyReviewsIn) (*CountOut, error)
+	UpdateReview(context.Context, *UpdateReviewIn) (*emptypb.Empty, error)
+	DeleteReview(context.Context, *DeleteReviewIn) (*emptypb.Empty, error)
+	mustEmbedUnimplementedReviewsServiceServer()
+}
+
+// UnimplementedReviewsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReviewsServiceServer struct {
+}
+
+func (UnimplementedReviewsServiceServer) AddReview(context.Context, *AddReviewIn) (*AddReviewOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) GetReview(context.Context, *GetReviewIn) (*GetReviewOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) GetToyReviews(context.Context, *GetToyReviewsIn) (*GetReviewsOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToyReviews not implemented")
+}
+func (UnimplementedReviewsServiceServer) CountToyReviews(context.Context, *CountToyReviewsIn) (*CountOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountToyReviews not implemented")
+}
+func (UnimplementedReviewsServiceServer) UpdateReview(context.Context, *UpdateReviewIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) DeleteReview(context.Context, *DeleteReviewIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedReviewsServiceServer) mustEmbedUnimplementedReviewsServiceServer() {}
+
+// UnsafeReviewsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewsServiceServer will
+// result in compilation errors.
+type UnsafeReviewsServiceServer interface {
+	mustEmbedUnimplementedReviewsServiceServer()
+}
+
+func RegisterReviewsServiceServer(s grpc.ServiceRegistrar, srv ReviewsServiceServer) {
+	s.RegisterService(&ReviewsService_ServiceDesc, srv)
+}
+
+func _ReviewsService_AddReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReviewIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).AddReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reviews.ReviewsService/AddReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).AddReview(ctx, req.(*AddReviewIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reviews.ReviewsService/GetReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).GetReview(ctx, req.(*GetReviewIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_GetToyReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToyReviewsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).GetToyReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reviews.ReviewsService/GetToyReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).GetToyReviews(ctx, req.(*GetToyReviewsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_CountToyReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountToyReviewsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).CountToyReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reviews.ReviewsService/CountToyReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).CountToyReviews(ctx, req.(*CountToyReviewsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reviews.ReviewsService/UpdateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).UpdateReview(ctx, req.(*UpdateReviewIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewsService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewsServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reviews.ReviewsService/DeleteReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewsServiceServer).DeleteReview(ctx, req.(*DeleteReviewIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewsService_ServiceDesc is the grpc.ServiceDesc for ReviewsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reviews.ReviewsService",
+	HandlerType: (*ReviewsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddReview",
+			Handler:    _ReviewsService_AddReview_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _ReviewsService_GetReview_Handler,
+		},
+		{
+			MethodName: "GetToyReviews",
+			Handler:    _ReviewsService_GetToyReviews_Handler,
+		},
+		{
+			MethodName: "CountToyReviews",
+			Handler:    _ReviewsService_CountToyReviews_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ReviewsService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ReviewsService_DeleteReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "toys/reviews.proto",
+}
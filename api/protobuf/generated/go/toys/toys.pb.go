@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,159 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ToyModerationStatus int32
+
+const (
+	ToyModerationStatus_TOY_MODERATION_STATUS_UNSPECIFIED ToyModerationStatus = 0
+	ToyModerationStatus_TOY_MODERATION_STATUS_APPROVED    ToyModerationStatus = 1
+	ToyModerationStatus_TOY_MODERATION_STATUS_PENDING     ToyModerationStatus = 2 // waiting for moderators decision, hidden
+	ToyModerationStatus_TOY_MODERATION_STATUS_REJECTED    ToyModerationStatus = 3 // rejected by moderators, can be resubmitted by Master
+)
+
+// Enum value maps for ToyModerationStatus.
+var (
+	ToyModerationStatus_name = map[int32]string{
+		0: "TOY_MODERATION_STATUS_UNSPECIFIED",
+		1: "TOY_MODERATION_STATUS_APPROVED",
+		2: "TOY_MODERATION_STATUS_PENDING",
+		3: "TOY_MODERATION_STATUS_REJECTED",
+	}
+	ToyModerationStatus_value = map[string]int32{
+		"TOY_MODERATION_STATUS_UNSPECIFIED": 0,
+		"TOY_MODERATION_STATUS_APPROVED":    1,
+		"TOY_MODERATION_STATUS_PENDING":     2,
+		"TOY_MODERATION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x ToyModerationStatus) Enum() *ToyModerationStatus {
+	p := new(ToyModerationStatus)
+	*p = x
+	return p
+}
+
+func (x ToyModerationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToyModerationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_toys_toys_proto_enumTypes[0].Descriptor()
+}
+
+func (ToyModerationStatus) Type() protoreflect.EnumType {
+	return &file_toys_toys_proto_enumTypes[0]
+}
+
+func (x ToyModerationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToyModerationStatus.Descriptor instead.
+func (ToyModerationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_CSV         ImportFormat = 1 // header with name, description, price, quantity, category, tags and attachments columns
+	ImportFormat_IMPORT_FORMAT_JSONL       ImportFormat = 2 // JSON object with the same fields on every line
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_JSONL",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_JSONL":       2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_toys_toys_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_toys_toys_proto_enumTypes[1]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1 // the same columns as in import with id and masterID columns
+	ExportFormat_EXPORT_FORMAT_JSONL       ExportFormat = 2 // JSON object with the same fields on every line
+	ExportFormat_EXPORT_FORMAT_YML         ExportFormat = 3 // marketplace feed in Yandex Market Language
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
+		3: "EXPORT_FORMAT_YML",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSONL":       2,
+		"EXPORT_FORMAT_YML":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_toys_toys_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_toys_toys_proto_enumTypes[2]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{2}
+}
+
 type AddToyIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -177,7 +331,10 @@ type GetToyIn struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID                 uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Include            *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=include,proto3" json:"include,omitempty"`                                                             // supported paths: "user"
+	ReadMask           *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=readMask,proto3" json:"readMask,omitempty"`                                                           // paths of GetToyOut fields to return, all fields by default
+	ModerationStatuses []ToyModerationStatus  `protobuf:"varint,4,rep,packed,name=moderationStatuses,proto3,enum=toys.ToyModerationStatus" json:"moderationStatuses,omitempty"` // only approved Toy, if not provided. For owner and moderators
 }
 
 func (x *GetToyIn) Reset() {
@@ -219,6 +376,27 @@ func (x *GetToyIn) GetID() uint64 {
 	return 0
 }
 
+func (x *GetToyIn) GetInclude() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GetToyIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *GetToyIn) GetModerationStatuses() []ToyModerationStatus {
+	if x != nil {
+		return x.ModerationStatuses
+	}
+	return nil
+}
+
 type Attachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -303,17 +481,26 @@ type GetToyOut struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	MasterID    uint64                 `protobuf:"varint,2,opt,name=masterID,proto3" json:"masterID,omitempty"`
-	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price       float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity    uint32                 `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	CategoryID  uint32                 `protobuf:"varint,7,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
-	Tags        []*GetTagOut           `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
-	Attachments []*Attachment          `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ID               uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	MasterID         uint64                 `protobuf:"varint,2,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price            float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity         uint32                 `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CategoryID       uint32                 `protobuf:"varint,7,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	Tags             []*GetTagOut           `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attachments      []*Attachment          `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	FavouritesCount  uint64                 `protobuf:"varint,12,opt,name=favouritesCount,proto3" json:"favouritesCount,omitempty"`
+	AverageRating    float32                `protobuf:"fixed32,13,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	ReviewsCount     uint64                 `protobuf:"varint,14,opt,name=reviewsCount,proto3" json:"reviewsCount,omitempty"`
+	Available        bool                   `protobuf:"varint,15,opt,name=available,proto3" json:"available,omitempty"` // false, while Master is on vacation
+	ModerationStatus ToyModerationStatus    `protobuf:"varint,16,opt,name=moderationStatus,proto3,enum=toys.ToyModerationStatus" json:"moderationStatus,omitempty"`
+	ModerationFlags  *string                `protobuf:"bytes,17,opt,name=moderationFlags,proto3,oneof" json:"moderationFlags,omitempty"` // triggered moderation heuristics
+	RejectionReason  *string                `protobuf:"bytes,18,opt,name=rejectionReason,proto3,oneof" json:"rejectionReason,omitempty"`
+	Appeal           *string                `protobuf:"bytes,19,opt,name=appeal,proto3,oneof" json:"appeal,omitempty"`
+	User             *User                  `protobuf:"bytes,20,opt,name=user,proto3,oneof" json:"user,omitempty"` // User of Master; set, if "user" is included and SSO is available
 }
 
 func (x *GetToyOut) Reset() {
@@ -425,13 +612,77 @@ func (x *GetToyOut) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetToyOut) GetFavouritesCount() uint64 {
+	if x != nil {
+		return x.FavouritesCount
+	}
+	return 0
+}
+
+func (x *GetToyOut) GetAverageRating() float32 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *GetToyOut) GetReviewsCount() uint64 {
+	if x != nil {
+		return x.ReviewsCount
+	}
+	return 0
+}
+
+func (x *GetToyOut) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *GetToyOut) GetModerationStatus() ToyModerationStatus {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ToyModerationStatus_TOY_MODERATION_STATUS_UNSPECIFIED
+}
+
+func (x *GetToyOut) GetModerationFlags() string {
+	if x != nil && x.ModerationFlags != nil {
+		return *x.ModerationFlags
+	}
+	return ""
+}
+
+func (x *GetToyOut) GetRejectionReason() string {
+	if x != nil && x.RejectionReason != nil {
+		return *x.RejectionReason
+	}
+	return ""
+}
+
+func (x *GetToyOut) GetAppeal() string {
+	if x != nil && x.Appeal != nil {
+		return *x.Appeal
+	}
+	return ""
+}
+
+func (x *GetToyOut) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 type GetToysIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination  `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	Filters    *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Pagination *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Filters    *ToysFilters           `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ReadMask   *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=readMask,proto3" json:"readMask,omitempty"` // paths of GetToyOut fields to return, all fields by default
 }
 
 func (x *GetToysIn) Reset() {
@@ -480,6 +731,13 @@ func (x *GetToysIn) GetFilters() *ToysFilters {
 	return nil
 }
 
+func (x *GetToysIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 type GetToysOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -532,9 +790,10 @@ type GetMasterToysIn struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MasterID   uint64       `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
-	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	Filters    *ToysFilters `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	MasterID   uint64                 `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	Pagination *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Filters    *ToysFilters           `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ReadMask   *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=readMask,proto3" json:"readMask,omitempty"` // paths of GetToyOut fields to return, all fields by default
 }
 
 func (x *GetMasterToysIn) Reset() {
@@ -590,14 +849,22 @@ func (x *GetMasterToysIn) GetFilters() *ToysFilters {
 	return nil
 }
 
+func (x *GetMasterToysIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 type GetUserToysIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     uint64       `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	Filters    *ToysFilters `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	UserID     uint64                 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Pagination *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Filters    *ToysFilters           `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ReadMask   *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=readMask,proto3" json:"readMask,omitempty"` // paths of GetToyOut fields to return, all fields by default
 }
 
 func (x *GetUserToysIn) Reset() {
@@ -653,6 +920,13 @@ func (x *GetUserToysIn) GetFilters() *ToysFilters {
 	return nil
 }
 
+func (x *GetUserToysIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 type DeleteToyIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -713,6 +987,8 @@ type UpdateToyIn struct {
 	CategoryID  *uint32  `protobuf:"varint,6,opt,name=categoryID,proto3,oneof" json:"categoryID,omitempty"`
 	TagIDs      []uint32 `protobuf:"varint,7,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
 	Attachments []string `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Paths of fields to change. Without mask only provided fields are changed, and empty lists are ignored.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 }
 
 func (x *UpdateToyIn) Reset() {
@@ -803,16 +1079,25 @@ func (x *UpdateToyIn) GetAttachments() []string {
 	return nil
 }
 
-type CountToysIn struct {
+func (x *UpdateToyIn) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type BatchGetToysIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters *ToysFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	IDs                []uint64               `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`                                                             // max 100 IDs
+	ReadMask           *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=readMask,proto3" json:"readMask,omitempty"`                                                           // paths of GetToyOut fields to return, all fields by default
+	ModerationStatuses []ToyModerationStatus  `protobuf:"varint,3,rep,packed,name=moderationStatuses,proto3,enum=toys.ToyModerationStatus" json:"moderationStatuses,omitempty"` // only approved Toys, if not provided. For owner and moderators
 }
 
-func (x *CountToysIn) Reset() {
-	*x = CountToysIn{}
+func (x *BatchGetToysIn) Reset() {
+	*x = BatchGetToysIn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_toys_toys_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -820,13 +1105,13 @@ func (x *CountToysIn) Reset() {
 	}
 }
 
-func (x *CountToysIn) String() string {
+func (x *BatchGetToysIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountToysIn) ProtoMessage() {}
+func (*BatchGetToysIn) ProtoMessage() {}
 
-func (x *CountToysIn) ProtoReflect() protoreflect.Message {
+func (x *BatchGetToysIn) ProtoReflect() protoreflect.Message {
 	mi := &file_toys_toys_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,29 +1123,43 @@ func (x *CountToysIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountToysIn.ProtoReflect.Descriptor instead.
-func (*CountToysIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetToysIn.ProtoReflect.Descriptor instead.
+func (*BatchGetToysIn) Descriptor() ([]byte, []int) {
 	return file_toys_toys_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CountToysIn) GetFilters() *ToysFilters {
+func (x *BatchGetToysIn) GetIDs() []uint64 {
 	if x != nil {
-		return x.Filters
+		return x.IDs
 	}
 	return nil
 }
 
-type CountMasterToysIn struct {
+func (x *BatchGetToysIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *BatchGetToysIn) GetModerationStatuses() []ToyModerationStatus {
+	if x != nil {
+		return x.ModerationStatuses
+	}
+	return nil
+}
+
+type BatchGetToysOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MasterID uint64       `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
-	Filters  *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	Toys       []*GetToyOut `protobuf:"bytes,1,rep,name=toys,proto3" json:"toys,omitempty"` // in order of requested IDs
+	MissingIDs []uint64     `protobuf:"varint,2,rep,packed,name=missingIDs,proto3" json:"missingIDs,omitempty"`
 }
 
-func (x *CountMasterToysIn) Reset() {
-	*x = CountMasterToysIn{}
+func (x *BatchGetToysOut) Reset() {
+	*x = BatchGetToysOut{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_toys_toys_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -868,13 +1167,13 @@ func (x *CountMasterToysIn) Reset() {
 	}
 }
 
-func (x *CountMasterToysIn) String() string {
+func (x *BatchGetToysOut) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountMasterToysIn) ProtoMessage() {}
+func (*BatchGetToysOut) ProtoMessage() {}
 
-func (x *CountMasterToysIn) ProtoReflect() protoreflect.Message {
+func (x *BatchGetToysOut) ProtoReflect() protoreflect.Message {
 	mi := &file_toys_toys_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -886,36 +1185,37 @@ func (x *CountMasterToysIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountMasterToysIn.ProtoReflect.Descriptor instead.
-func (*CountMasterToysIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetToysOut.ProtoReflect.Descriptor instead.
+func (*BatchGetToysOut) Descriptor() ([]byte, []int) {
 	return file_toys_toys_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CountMasterToysIn) GetMasterID() uint64 {
+func (x *BatchGetToysOut) GetToys() []*GetToyOut {
 	if x != nil {
-		return x.MasterID
+		return x.Toys
 	}
-	return 0
+	return nil
 }
 
-func (x *CountMasterToysIn) GetFilters() *ToysFilters {
+func (x *BatchGetToysOut) GetMissingIDs() []uint64 {
 	if x != nil {
-		return x.Filters
+		return x.MissingIDs
 	}
 	return nil
 }
 
-type CountUserToysIn struct {
+type BatchUpdateToyItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID  uint64       `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Filters *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ID       uint64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Price    *float32 `protobuf:"fixed32,2,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Quantity *uint32  `protobuf:"varint,3,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
 }
 
-func (x *CountUserToysIn) Reset() {
-	*x = CountUserToysIn{}
+func (x *BatchUpdateToyItem) Reset() {
+	*x = BatchUpdateToyItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_toys_toys_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,13 +1223,13 @@ func (x *CountUserToysIn) Reset() {
 	}
 }
 
-func (x *CountUserToysIn) String() string {
+func (x *BatchUpdateToyItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountUserToysIn) ProtoMessage() {}
+func (*BatchUpdateToyItem) ProtoMessage() {}
 
-func (x *CountUserToysIn) ProtoReflect() protoreflect.Message {
+func (x *BatchUpdateToyItem) ProtoReflect() protoreflect.Message {
 	mi := &file_toys_toys_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -941,41 +1241,43 @@ func (x *CountUserToysIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountUserToysIn.ProtoReflect.Descriptor instead.
-func (*CountUserToysIn) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchUpdateToyItem.ProtoReflect.Descriptor instead.
+func (*BatchUpdateToyItem) Descriptor() ([]byte, []int) {
 	return file_toys_toys_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CountUserToysIn) GetUserID() uint64 {
+func (x *BatchUpdateToyItem) GetID() uint64 {
 	if x != nil {
-		return x.UserID
+		return x.ID
 	}
 	return 0
 }
 
-func (x *CountUserToysIn) GetFilters() *ToysFilters {
-	if x != nil {
-		return x.Filters
+func (x *BatchUpdateToyItem) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
 	}
-	return nil
+	return 0
 }
 
-type ToysFilters struct {
+func (x *BatchUpdateToyItem) GetQuantity() uint32 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+type BatchUpdateToysIn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Search              *string  `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
-	PriceCeil           *float32 `protobuf:"fixed32,2,opt,name=priceCeil,proto3,oneof" json:"priceCeil,omitempty"`        // max price
-	PriceFloor          *float32 `protobuf:"fixed32,3,opt,name=priceFloor,proto3,oneof" json:"priceFloor,omitempty"`      // min price
-	QuantityFloor       *uint32  `protobuf:"varint,4,opt,name=quantityFloor,proto3,oneof" json:"quantityFloor,omitempty"` // min quantity
-	CategoryIDs         []uint32 `protobuf:"varint,5,rep,packed,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
-	TagIDs              []uint32 `protobuf:"varint,6,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
-	CreatedAtOrderByAsc *bool    `protobuf:"varint,7,opt,name=createdAtOrderByAsc,proto3,oneof" json:"createdAtOrderByAsc,omitempty"`
+	UserID uint64                `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"` // all Toys should belong to Master of User
+	Items  []*BatchUpdateToyItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`    // max 100 items
 }
 
-func (x *ToysFilters) Reset() {
-	*x = ToysFilters{}
+func (x *BatchUpdateToysIn) Reset() {
+	*x = BatchUpdateToysIn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_toys_toys_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -983,13 +1285,13 @@ func (x *ToysFilters) Reset() {
 	}
 }
 
-func (x *ToysFilters) String() string {
+func (x *BatchUpdateToysIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToysFilters) ProtoMessage() {}
+func (*BatchUpdateToysIn) ProtoMessage() {}
 
-func (x *ToysFilters) ProtoReflect() protoreflect.Message {
+func (x *BatchUpdateToysIn) ProtoReflect() protoreflect.Message {
 	mi := &file_toys_toys_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1001,58 +1303,1482 @@ func (x *ToysFilters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToysFilters.ProtoReflect.Descriptor instead.
-func (*ToysFilters) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchUpdateToysIn.ProtoReflect.Descriptor instead.
+func (*BatchUpdateToysIn) Descriptor() ([]byte, []int) {
 	return file_toys_toys_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ToysFilters) GetSearch() string {
-	if x != nil && x.Search != nil {
-		return *x.Search
-	}
-	return ""
-}
-
-func (x *ToysFilters) GetPriceCeil() float32 {
-	if x != nil && x.PriceCeil != nil {
-		return *x.PriceCeil
+func (x *BatchUpdateToysIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
 	}
 	return 0
 }
 
-func (x *ToysFilters) GetPriceFloor() float32 {
-	if x != nil && x.PriceFloor != nil {
-		return *x.PriceFloor
+func (x *BatchUpdateToysIn) GetItems() []*BatchUpdateToyItem {
+	if x != nil {
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *ToysFilters) GetQuantityFloor() uint32 {
-	if x != nil && x.QuantityFloor != nil {
-		return *x.QuantityFloor
-	}
-	return 0
+type BatchUpdateToyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         uint64             `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Violations []*ImportViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
 }
 
-func (x *ToysFilters) GetCategoryIDs() []uint32 {
-	if x != nil {
-		return x.CategoryIDs
+func (x *BatchUpdateToyResult) Reset() {
+	*x = BatchUpdateToyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ToysFilters) GetTagIDs() []uint32 {
-	if x != nil {
-		return x.TagIDs
-	}
-	return nil
+func (x *BatchUpdateToyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ToysFilters) GetCreatedAtOrderByAsc() bool {
-	if x != nil && x.CreatedAtOrderByAsc != nil {
-		return *x.CreatedAtOrderByAsc
+func (*BatchUpdateToyResult) ProtoMessage() {}
+
+func (x *BatchUpdateToyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateToyResult.ProtoReflect.Descriptor instead.
+func (*BatchUpdateToyResult) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchUpdateToyResult) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *BatchUpdateToyResult) GetViolations() []*ImportViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// Toys are updated only if all items are valid, otherwise results contain violations of invalid items.
+type BatchUpdateToysOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applied bool                    `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Results []*BatchUpdateToyResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchUpdateToysOut) Reset() {
+	*x = BatchUpdateToysOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateToysOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateToysOut) ProtoMessage() {}
+
+func (x *BatchUpdateToysOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateToysOut.ProtoReflect.Descriptor instead.
+func (*BatchUpdateToysOut) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchUpdateToysOut) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *BatchUpdateToysOut) GetResults() []*BatchUpdateToyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CountToysIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters *ToysFilters `protobuf:"bytes,1,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountToysIn) Reset() {
+	*x = CountToysIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountToysIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountToysIn) ProtoMessage() {}
+
+func (x *CountToysIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountToysIn.ProtoReflect.Descriptor instead.
+func (*CountToysIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CountToysIn) GetFilters() *ToysFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type CountMasterToysIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID uint64       `protobuf:"varint,1,opt,name=masterID,proto3" json:"masterID,omitempty"`
+	Filters  *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountMasterToysIn) Reset() {
+	*x = CountMasterToysIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountMasterToysIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountMasterToysIn) ProtoMessage() {}
+
+func (x *CountMasterToysIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountMasterToysIn.ProtoReflect.Descriptor instead.
+func (*CountMasterToysIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CountMasterToysIn) GetMasterID() uint64 {
+	if x != nil {
+		return x.MasterID
+	}
+	return 0
+}
+
+func (x *CountMasterToysIn) GetFilters() *ToysFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type CountUserToysIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  uint64       `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Filters *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountUserToysIn) Reset() {
+	*x = CountUserToysIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUserToysIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUserToysIn) ProtoMessage() {}
+
+func (x *CountUserToysIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUserToysIn.ProtoReflect.Descriptor instead.
+func (*CountUserToysIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CountUserToysIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *CountUserToysIn) GetFilters() *ToysFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type ToysFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Search              *string               `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
+	PriceCeil           *float32              `protobuf:"fixed32,2,opt,name=priceCeil,proto3,oneof" json:"priceCeil,omitempty"`        // max price
+	PriceFloor          *float32              `protobuf:"fixed32,3,opt,name=priceFloor,proto3,oneof" json:"priceFloor,omitempty"`      // min price
+	QuantityFloor       *uint32               `protobuf:"varint,4,opt,name=quantityFloor,proto3,oneof" json:"quantityFloor,omitempty"` // min quantity
+	CategoryIDs         []uint32              `protobuf:"varint,5,rep,packed,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
+	TagIDs              []uint32              `protobuf:"varint,6,rep,packed,name=tagIDs,proto3" json:"tagIDs,omitempty"`
+	CreatedAtOrderByAsc *bool                 `protobuf:"varint,7,opt,name=createdAtOrderByAsc,proto3,oneof" json:"createdAtOrderByAsc,omitempty"`
+	MinRating           *float32              `protobuf:"fixed32,8,opt,name=minRating,proto3,oneof" json:"minRating,omitempty"`                                                  // min average rating
+	RatingOrderByAsc    *bool                 `protobuf:"varint,9,opt,name=ratingOrderByAsc,proto3,oneof" json:"ratingOrderByAsc,omitempty"`                                     // sort by average rating, if provided
+	OnlyAvailable       *bool                 `protobuf:"varint,10,opt,name=onlyAvailable,proto3,oneof" json:"onlyAvailable,omitempty"`                                          // exclude Toys of Masters on vacation
+	ModerationStatuses  []ToyModerationStatus `protobuf:"varint,11,rep,packed,name=moderationStatuses,proto3,enum=toys.ToyModerationStatus" json:"moderationStatuses,omitempty"` // only approved Toys, if not provided
+}
+
+func (x *ToysFilters) Reset() {
+	*x = ToysFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToysFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToysFilters) ProtoMessage() {}
+
+func (x *ToysFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToysFilters.ProtoReflect.Descriptor instead.
+func (*ToysFilters) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ToysFilters) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *ToysFilters) GetPriceCeil() float32 {
+	if x != nil && x.PriceCeil != nil {
+		return *x.PriceCeil
+	}
+	return 0
+}
+
+func (x *ToysFilters) GetPriceFloor() float32 {
+	if x != nil && x.PriceFloor != nil {
+		return *x.PriceFloor
+	}
+	return 0
+}
+
+func (x *ToysFilters) GetQuantityFloor() uint32 {
+	if x != nil && x.QuantityFloor != nil {
+		return *x.QuantityFloor
+	}
+	return 0
+}
+
+func (x *ToysFilters) GetCategoryIDs() []uint32 {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
+func (x *ToysFilters) GetTagIDs() []uint32 {
+	if x != nil {
+		return x.TagIDs
+	}
+	return nil
+}
+
+func (x *ToysFilters) GetCreatedAtOrderByAsc() bool {
+	if x != nil && x.CreatedAtOrderByAsc != nil {
+		return *x.CreatedAtOrderByAsc
+	}
+	return false
+}
+
+func (x *ToysFilters) GetMinRating() float32 {
+	if x != nil && x.MinRating != nil {
+		return *x.MinRating
+	}
+	return 0
+}
+
+func (x *ToysFilters) GetRatingOrderByAsc() bool {
+	if x != nil && x.RatingOrderByAsc != nil {
+		return *x.RatingOrderByAsc
+	}
+	return false
+}
+
+func (x *ToysFilters) GetOnlyAvailable() bool {
+	if x != nil && x.OnlyAvailable != nil {
+		return *x.OnlyAvailable
+	}
+	return false
+}
+
+func (x *ToysFilters) GetModerationStatuses() []ToyModerationStatus {
+	if x != nil {
+		return x.ModerationStatuses
+	}
+	return nil
+}
+
+type AddFavouriteIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ToyID  uint64 `protobuf:"varint,2,opt,name=toyID,proto3" json:"toyID,omitempty"`
+}
+
+func (x *AddFavouriteIn) Reset() {
+	*x = AddFavouriteIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFavouriteIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteIn) ProtoMessage() {}
+
+func (x *AddFavouriteIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteIn.ProtoReflect.Descriptor instead.
+func (*AddFavouriteIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddFavouriteIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *AddFavouriteIn) GetToyID() uint64 {
+	if x != nil {
+		return x.ToyID
+	}
+	return 0
+}
+
+type AddFavouriteOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavouriteID uint64 `protobuf:"varint,1,opt,name=favouriteID,proto3" json:"favouriteID,omitempty"`
+}
+
+func (x *AddFavouriteOut) Reset() {
+	*x = AddFavouriteOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddFavouriteOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavouriteOut) ProtoMessage() {}
+
+func (x *AddFavouriteOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavouriteOut.ProtoReflect.Descriptor instead.
+func (*AddFavouriteOut) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddFavouriteOut) GetFavouriteID() uint64 {
+	if x != nil {
+		return x.FavouriteID
+	}
+	return 0
+}
+
+type RemoveFavouriteIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ToyID  uint64 `protobuf:"varint,2,opt,name=toyID,proto3" json:"toyID,omitempty"`
+}
+
+func (x *RemoveFavouriteIn) Reset() {
+	*x = RemoveFavouriteIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFavouriteIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavouriteIn) ProtoMessage() {}
+
+func (x *RemoveFavouriteIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavouriteIn.ProtoReflect.Descriptor instead.
+func (*RemoveFavouriteIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveFavouriteIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *RemoveFavouriteIn) GetToyID() uint64 {
+	if x != nil {
+		return x.ToyID
+	}
+	return 0
+}
+
+type GetUserFavouritesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     uint64                 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Pagination *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Filters    *ToysFilters           `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	ReadMask   *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=readMask,proto3" json:"readMask,omitempty"` // paths of GetToyOut fields to return, all fields by default
+}
+
+func (x *GetUserFavouritesIn) Reset() {
+	*x = GetUserFavouritesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFavouritesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFavouritesIn) ProtoMessage() {}
+
+func (x *GetUserFavouritesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFavouritesIn.ProtoReflect.Descriptor instead.
+func (*GetUserFavouritesIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserFavouritesIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetUserFavouritesIn) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetUserFavouritesIn) GetFilters() *ToysFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetUserFavouritesIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type CountUserFavouritesIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  uint64       `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Filters *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *CountUserFavouritesIn) Reset() {
+	*x = CountUserFavouritesIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUserFavouritesIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUserFavouritesIn) ProtoMessage() {}
+
+func (x *CountUserFavouritesIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUserFavouritesIn.ProtoReflect.Descriptor instead.
+func (*CountUserFavouritesIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CountUserFavouritesIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *CountUserFavouritesIn) GetFilters() *ToysFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type GetFeedIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   uint64                 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Cursor   *string                `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit    *uint64                `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=readMask,proto3" json:"readMask,omitempty"` // paths of GetToyOut fields to return, all fields by default
+}
+
+func (x *GetFeedIn) Reset() {
+	*x = GetFeedIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedIn) ProtoMessage() {}
+
+func (x *GetFeedIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedIn.ProtoReflect.Descriptor instead.
+func (*GetFeedIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetFeedIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GetFeedIn) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFeedIn) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetFeedIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetFeedOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Toys       []*GetToyOut `protobuf:"bytes,1,rep,name=toys,proto3" json:"toys,omitempty"`
+	NextCursor *string      `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+}
+
+func (x *GetFeedOut) Reset() {
+	*x = GetFeedOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedOut) ProtoMessage() {}
+
+func (x *GetFeedOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedOut.ProtoReflect.Descriptor instead.
+func (*GetFeedOut) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetFeedOut) GetToys() []*GetToyOut {
+	if x != nil {
+		return x.Toys
+	}
+	return nil
+}
+
+func (x *GetFeedOut) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type ListModerationQueueIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination            `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	ReadMask   *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=readMask,proto3" json:"readMask,omitempty"` // paths of GetToyOut fields to return, all fields by default
+}
+
+func (x *ListModerationQueueIn) Reset() {
+	*x = ListModerationQueueIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationQueueIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueIn) ProtoMessage() {}
+
+func (x *ListModerationQueueIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueIn.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListModerationQueueIn) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListModerationQueueIn) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ApproveToyIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *ApproveToyIn) Reset() {
+	*x = ApproveToyIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveToyIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveToyIn) ProtoMessage() {}
+
+func (x *ApproveToyIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveToyIn.ProtoReflect.Descriptor instead.
+func (*ApproveToyIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApproveToyIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type RejectToyIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RejectToyIn) Reset() {
+	*x = RejectToyIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectToyIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectToyIn) ProtoMessage() {}
+
+func (x *RejectToyIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectToyIn.ProtoReflect.Descriptor instead.
+func (*RejectToyIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RejectToyIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RejectToyIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResubmitToyIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     uint64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Appeal *string `protobuf:"bytes,2,opt,name=appeal,proto3,oneof" json:"appeal,omitempty"`
+}
+
+func (x *ResubmitToyIn) Reset() {
+	*x = ResubmitToyIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResubmitToyIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResubmitToyIn) ProtoMessage() {}
+
+func (x *ResubmitToyIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResubmitToyIn.ProtoReflect.Descriptor instead.
+func (*ResubmitToyIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResubmitToyIn) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *ResubmitToyIn) GetAppeal() string {
+	if x != nil && x.Appeal != nil {
+		return *x.Appeal
+	}
+	return ""
+}
+
+// userID, format and dryRun are read from the first message, the next ones contain only chunks of file.
+type ImportToysIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64       `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Format ImportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=toys.ImportFormat" json:"format,omitempty"`
+	DryRun bool         `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"` // only validates rows without writing anything
+	Chunk  []byte       `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ImportToysIn) Reset() {
+	*x = ImportToysIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportToysIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportToysIn) ProtoMessage() {}
+
+func (x *ImportToysIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportToysIn.ProtoReflect.Descriptor instead.
+func (*ImportToysIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportToysIn) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ImportToysIn) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportToysIn) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportToysIn) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportViolation) Reset() {
+	*x = ImportViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportViolation) ProtoMessage() {}
+
+func (x *ImportViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportViolation.ProtoReflect.Descriptor instead.
+func (*ImportViolation) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportViolation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportToyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line       uint64             `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ToyID      *uint64            `protobuf:"varint,2,opt,name=toyID,proto3,oneof" json:"toyID,omitempty"`    // set only for imported Toys
+	Violations []*ImportViolation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"` // violation with code "INTERNAL" means, that line can be imported again
+}
+
+func (x *ImportToyResult) Reset() {
+	*x = ImportToyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportToyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportToyResult) ProtoMessage() {}
+
+func (x *ImportToyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportToyResult.ProtoReflect.Descriptor instead.
+func (*ImportToyResult) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportToyResult) GetLine() uint64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportToyResult) GetToyID() uint64 {
+	if x != nil && x.ToyID != nil {
+		return *x.ToyID
+	}
+	return 0
+}
+
+func (x *ImportToyResult) GetViolations() []*ImportViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type ImportToysOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*ImportToyResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	ValidCount   uint64             `protobuf:"varint,2,opt,name=validCount,proto3" json:"validCount,omitempty"`
+	InvalidCount uint64             `protobuf:"varint,3,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+}
+
+func (x *ImportToysOut) Reset() {
+	*x = ImportToysOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportToysOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportToysOut) ProtoMessage() {}
+
+func (x *ImportToysOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportToysOut.ProtoReflect.Descriptor instead.
+func (*ImportToysOut) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportToysOut) GetResults() []*ImportToyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportToysOut) GetValidCount() uint64 {
+	if x != nil {
+		return x.ValidCount
+	}
+	return 0
+}
+
+func (x *ImportToysOut) GetInvalidCount() uint64 {
+	if x != nil {
+		return x.InvalidCount
+	}
+	return 0
+}
+
+// FeedShop describes shop in marketplace feed, so it is required only for YML format.
+type FeedShop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Company string `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	Url     string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *FeedShop) Reset() {
+	*x = FeedShop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedShop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedShop) ProtoMessage() {}
+
+func (x *FeedShop) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedShop.ProtoReflect.Descriptor instead.
+func (*FeedShop) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FeedShop) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeedShop) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *FeedShop) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ExportToysIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MasterID *uint64      `protobuf:"varint,1,opt,name=masterID,proto3,oneof" json:"masterID,omitempty"` // Toys of all Masters are exported, if not provided
+	Filters  *ToysFilters `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`          // sorting filters are ignored, Toys are ordered by ID
+	Format   ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=toys.ExportFormat" json:"format,omitempty"`
+	Shop     *FeedShop    `protobuf:"bytes,4,opt,name=shop,proto3" json:"shop,omitempty"`
+}
+
+func (x *ExportToysIn) Reset() {
+	*x = ExportToysIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportToysIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToysIn) ProtoMessage() {}
+
+func (x *ExportToysIn) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToysIn.ProtoReflect.Descriptor instead.
+func (*ExportToysIn) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportToysIn) GetMasterID() uint64 {
+	if x != nil && x.MasterID != nil {
+		return *x.MasterID
+	}
+	return 0
+}
+
+func (x *ExportToysIn) GetFilters() *ToysFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ExportToysIn) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportToysIn) GetShop() *FeedShop {
+	if x != nil {
+		return x.Shop
+	}
+	return nil
+}
+
+type ExportToysOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportToysOut) Reset() {
+	*x = ExportToysOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_toys_toys_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportToysOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportToysOut) ProtoMessage() {}
+
+func (x *ExportToysOut) ProtoReflect() protoreflect.Message {
+	mi := &file_toys_toys_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportToysOut.ProtoReflect.Descriptor instead.
+func (*ExportToysOut) Descriptor() ([]byte, []int) {
+	return file_toys_toys_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExportToysOut) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
 	}
-	return false
+	return nil
 }
 
 var File_toys_toys_proto protoreflect.FileDescriptor
@@ -1063,201 +2789,509 @@ var file_toys_toys_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x74, 0x61, 0x67, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x6d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x01, 0x0a, 0x08, 0x41,
-	0x64, 0x64, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x74, 0x61,
+	0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x01, 0x0a,
+	0x08, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x67,
+	0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x21, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x79, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xba, 0x01, 0x0a,
+	0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x79, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc4, 0x06, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x61, 0x67, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x32, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2d, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0xca, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x38,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73,
+	0x2e, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x31, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74,
+	0x6f, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x74, 0x6f, 0x79, 0x73,
+	0x22, 0xec, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f,
+	0x79, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f,
+	0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01,
+	0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0xe6, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1d, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xf3, 0x02, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x49,
+	0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0xa5, 0x01,
+	0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x49,
+	0x44, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x6f, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x74, 0x6f, 0x79, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x73, 0x22, 0x77, 0x0a,
+	0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x5b, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x5d, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x0a, 0x76,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x64, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e,
+	0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54,
+	0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x21, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
-	0x6f, 0x79, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x49, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
-	0x22, 0xba, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x74, 0x6f, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x03,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12,
-	0x23, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x74, 0x61, 0x67, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x4f, 0x75, 0x74, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73,
-	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x92, 0x01, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79,
-	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0x31, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x12,
-	0x23, 0x0a, 0x04, 0x74, 0x6f, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x04,
-	0x74, 0x6f, 0x79, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x30,
-	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1d, 0x0a, 0x0b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x22, 0xb7, 0x02, 0x0a, 0x0b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0a, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61,
-	0x67, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f,
-	0x79, 0x73, 0x49, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79,
-	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0x6d, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0x67, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
-	0x79, 0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x0b, 0x54,
-	0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x43, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x09, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02,
-	0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
-	0x29, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52,
-	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x74, 0x61,
-	0x67, 0x49, 0x44, 0x73, 0x12, 0x35, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x04, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x43, 0x65, 0x69, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c,
-	0x6f, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x32, 0xb2, 0x04,
-	0x0a, 0x0b, 0x54, 0x6f, 0x79, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a,
-	0x06, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x12, 0x0e, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x79, 0x12, 0x0e, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x79, 0x73, 0x12, 0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79,
-	0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
-	0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x54, 0x6f, 0x79, 0x73, 0x12, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x15, 0x2e,
-	0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f,
+	0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xdf, 0x04,
+	0x0a, 0x0b, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52,
+	0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c,
+	0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0d, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x06, 0x74, 0x61, 0x67, 0x49, 0x44, 0x73, 0x12, 0x35, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x41, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x10, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x0d, 0x6f, 0x6e, 0x6c,
+	0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a,
+	0x12, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x6f, 0x79, 0x73,
+	0x2e, 0x54, 0x6f, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65, 0x69,
+	0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x6c, 0x6f,
+	0x6f, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d,
+	0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x41, 0x73, 0x63, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22,
+	0x3e, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x49,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x22,
+	0x33, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4f,
+	0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61,
+	0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x22, 0xec, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f, 0x79, 0x73, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e,
+	0x54, 0x6f, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x64, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x65, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x23,
+	0x0a, 0x04, 0x74, 0x6f, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x74,
+	0x6f, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x79,
+	0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0x35, 0x0a, 0x0b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x6f, 0x79, 0x49,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79,
+	0x73, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x6f,
+	0x79, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x55, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x81, 0x01, 0x0a,
+	0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x79, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x79, 0x49, 0x44,
+	0x22, 0x84, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x64, 0x53,
+	0x68, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f,
+	0x79, 0x73, 0x49, 0x6e, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x54, 0x6f,
+	0x79, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x73, 0x68, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x53, 0x68, 0x6f, 0x70, 0x52, 0x04, 0x73, 0x68,
+	0x6f, 0x70, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x25, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2a, 0xa7, 0x01, 0x0a, 0x13, 0x54, 0x6f, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25,
+	0x0a, 0x21, 0x54, 0x4f, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x4f, 0x59, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x59,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e,
+	0x54, 0x4f, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x2a, 0x5d, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02, 0x2a,
+	0x74, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x59, 0x4d, 0x4c, 0x10, 0x03, 0x32, 0xed, 0x0a, 0x0a, 0x0b, 0x54, 0x6f, 0x79, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x12,
+	0x0e, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a,
+	0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74,
+	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x12, 0x0e, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x12,
+	0x14, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x0f, 0x2e, 0x74, 0x6f, 0x79, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x6f, 0x79,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x11, 0x2e, 0x74, 0x6f,
+	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x11,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x6f, 0x79, 0x73, 0x12, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x6f,
+	0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f,
+	0x79, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x12,
+	0x13, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
 	0x79, 0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x6f,
-	0x79, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f,
-	0x79, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e,
-	0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
-	0x79, 0x73, 0x12, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x38,
-	0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x12, 0x11, 0x2e, 0x74, 0x6f,
-	0x79, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x6f, 0x79, 0x12, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74, 0x6d, 0x2d, 0x74,
-	0x6f, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e,
+	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x6f, 0x79, 0x12, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x12, 0x11, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x17, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x18, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x14, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x61, 0x76, 0x6f,
+	0x75, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x15, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x6f,
+	0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x13, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x1b, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x1a, 0x11,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0f,
+	0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x49, 0x6e, 0x1a,
+	0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x6f, 0x79,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x1a, 0x10, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x79, 0x12, 0x12, 0x2e, 0x74, 0x6f, 0x79, 0x73,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x54, 0x6f, 0x79, 0x12, 0x11, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x79,
+	0x12, 0x13, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x54, 0x6f, 0x79, 0x49, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x39, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x12, 0x2e,
+	0x74, 0x6f, 0x79, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49,
+	0x6e, 0x1a, 0x13, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x6f, 0x79, 0x73, 0x4f, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x12, 0x12, 0x2e, 0x74, 0x6f, 0x79, 0x73, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x49, 0x6e, 0x1a, 0x13, 0x2e, 0x74,
+	0x6f, 0x79, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x79, 0x73, 0x4f, 0x75,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x44, 0x4b, 0x68, 0x6f, 0x72, 0x6b, 0x6f, 0x76, 0x2f, 0x68, 0x6d, 0x74,
+	0x6d, 0x2d, 0x74, 0x6f, 0x79, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x6f, 0x79, 0x73, 0x3b, 0x74, 0x6f, 0x79, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1272,71 +3306,157 @@ func file_toys_toys_proto_rawDescGZIP() []byte {
 	return file_toys_toys_proto_rawDescData
 }
 
-var file_toys_toys_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_toys_toys_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_toys_toys_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_toys_toys_proto_goTypes = []interface{}{
-	(*AddToyIn)(nil),              // 0: toys.AddToyIn
-	(*AddToyOut)(nil),             // 1: toys.AddToyOut
-	(*GetToyIn)(nil),              // 2: toys.GetToyIn
-	(*Attachment)(nil),            // 3: toys.Attachment
-	(*GetToyOut)(nil),             // 4: toys.GetToyOut
-	(*GetToysIn)(nil),             // 5: toys.GetToysIn
-	(*GetToysOut)(nil),            // 6: toys.GetToysOut
-	(*GetMasterToysIn)(nil),       // 7: toys.GetMasterToysIn
-	(*GetUserToysIn)(nil),         // 8: toys.GetUserToysIn
-	(*DeleteToyIn)(nil),           // 9: toys.DeleteToyIn
-	(*UpdateToyIn)(nil),           // 10: toys.UpdateToyIn
-	(*CountToysIn)(nil),           // 11: toys.CountToysIn
-	(*CountMasterToysIn)(nil),     // 12: toys.CountMasterToysIn
-	(*CountUserToysIn)(nil),       // 13: toys.CountUserToysIn
-	(*ToysFilters)(nil),           // 14: toys.ToysFilters
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
-	(*GetTagOut)(nil),             // 16: tags.GetTagOut
-	(*Pagination)(nil),            // 17: masters.Pagination
-	(*CountOut)(nil),              // 18: masters.CountOut
-	(*emptypb.Empty)(nil),         // 19: google.protobuf.Empty
+	(ToyModerationStatus)(0),      // 0: toys.ToyModerationStatus
+	(ImportFormat)(0),             // 1: toys.ImportFormat
+	(ExportFormat)(0),             // 2: toys.ExportFormat
+	(*AddToyIn)(nil),              // 3: toys.AddToyIn
+	(*AddToyOut)(nil),             // 4: toys.AddToyOut
+	(*GetToyIn)(nil),              // 5: toys.GetToyIn
+	(*Attachment)(nil),            // 6: toys.Attachment
+	(*GetToyOut)(nil),             // 7: toys.GetToyOut
+	(*GetToysIn)(nil),             // 8: toys.GetToysIn
+	(*GetToysOut)(nil),            // 9: toys.GetToysOut
+	(*GetMasterToysIn)(nil),       // 10: toys.GetMasterToysIn
+	(*GetUserToysIn)(nil),         // 11: toys.GetUserToysIn
+	(*DeleteToyIn)(nil),           // 12: toys.DeleteToyIn
+	(*UpdateToyIn)(nil),           // 13: toys.UpdateToyIn
+	(*BatchGetToysIn)(nil),        // 14: toys.BatchGetToysIn
+	(*BatchGetToysOut)(nil),       // 15: toys.BatchGetToysOut
+	(*BatchUpdateToyItem)(nil),    // 16: toys.BatchUpdateToyItem
+	(*BatchUpdateToysIn)(nil),     // 17: toys.BatchUpdateToysIn
+	(*BatchUpdateToyResult)(nil),  // 18: toys.BatchUpdateToyResult
+	(*BatchUpdateToysOut)(nil),    // 19: toys.BatchUpdateToysOut
+	(*CountToysIn)(nil),           // 20: toys.CountToysIn
+	(*CountMasterToysIn)(nil),     // 21: toys.CountMasterToysIn
+	(*CountUserToysIn)(nil),       // 22: toys.CountUserToysIn
+	(*ToysFilters)(nil),           // 23: toys.ToysFilters
+	(*AddFavouriteIn)(nil),        // 24: toys.AddFavouriteIn
+	(*AddFavouriteOut)(nil),       // 25: toys.AddFavouriteOut
+	(*RemoveFavouriteIn)(nil),     // 26: toys.RemoveFavouriteIn
+	(*GetUserFavouritesIn)(nil),   // 27: toys.GetUserFavouritesIn
+	(*CountUserFavouritesIn)(nil), // 28: toys.CountUserFavouritesIn
+	(*GetFeedIn)(nil),             // 29: toys.GetFeedIn
+	(*GetFeedOut)(nil),            // 30: toys.GetFeedOut
+	(*ListModerationQueueIn)(nil), // 31: toys.ListModerationQueueIn
+	(*ApproveToyIn)(nil),          // 32: toys.ApproveToyIn
+	(*RejectToyIn)(nil),           // 33: toys.RejectToyIn
+	(*ResubmitToyIn)(nil),         // 34: toys.ResubmitToyIn
+	(*ImportToysIn)(nil),          // 35: toys.ImportToysIn
+	(*ImportViolation)(nil),       // 36: toys.ImportViolation
+	(*ImportToyResult)(nil),       // 37: toys.ImportToyResult
+	(*ImportToysOut)(nil),         // 38: toys.ImportToysOut
+	(*FeedShop)(nil),              // 39: toys.FeedShop
+	(*ExportToysIn)(nil),          // 40: toys.ExportToysIn
+	(*ExportToysOut)(nil),         // 41: toys.ExportToysOut
+	(*fieldmaskpb.FieldMask)(nil), // 42: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil), // 43: google.protobuf.Timestamp
+	(*GetTagOut)(nil),             // 44: tags.GetTagOut
+	(*User)(nil),                  // 45: masters.User
+	(*Pagination)(nil),            // 46: masters.Pagination
+	(*CountOut)(nil),              // 47: masters.CountOut
+	(*emptypb.Empty)(nil),         // 48: google.protobuf.Empty
 }
 var file_toys_toys_proto_depIdxs = []int32{
-	15, // 0: toys.Attachment.createdAt:type_name -> google.protobuf.Timestamp
-	15, // 1: toys.Attachment.updatedAt:type_name -> google.protobuf.Timestamp
-	16, // 2: toys.GetToyOut.tags:type_name -> tags.GetTagOut
-	3,  // 3: toys.GetToyOut.attachments:type_name -> toys.Attachment
-	15, // 4: toys.GetToyOut.createdAt:type_name -> google.protobuf.Timestamp
-	15, // 5: toys.GetToyOut.updatedAt:type_name -> google.protobuf.Timestamp
-	17, // 6: toys.GetToysIn.pagination:type_name -> masters.Pagination
-	14, // 7: toys.GetToysIn.filters:type_name -> toys.ToysFilters
-	4,  // 8: toys.GetToysOut.toys:type_name -> toys.GetToyOut
-	17, // 9: toys.GetMasterToysIn.pagination:type_name -> masters.Pagination
-	14, // 10: toys.GetMasterToysIn.filters:type_name -> toys.ToysFilters
-	17, // 11: toys.GetUserToysIn.pagination:type_name -> masters.Pagination
-	14, // 12: toys.GetUserToysIn.filters:type_name -> toys.ToysFilters
-	14, // 13: toys.CountToysIn.filters:type_name -> toys.ToysFilters
-	14, // 14: toys.CountMasterToysIn.filters:type_name -> toys.ToysFilters
-	14, // 15: toys.CountUserToysIn.filters:type_name -> toys.ToysFilters
-	0,  // 16: toys.ToysService.AddToy:input_type -> toys.AddToyIn
-	2,  // 17: toys.ToysService.GetToy:input_type -> toys.GetToyIn
-	5,  // 18: toys.ToysService.GetToys:input_type -> toys.GetToysIn
-	11, // 19: toys.ToysService.CountToys:input_type -> toys.CountToysIn
-	7,  // 20: toys.ToysService.GetMasterToys:input_type -> toys.GetMasterToysIn
-	12, // 21: toys.ToysService.CountMasterToys:input_type -> toys.CountMasterToysIn
-	8,  // 22: toys.ToysService.GetUserToys:input_type -> toys.GetUserToysIn
-	13, // 23: toys.ToysService.CountUserToys:input_type -> toys.CountUserToysIn
-	9,  // 24: toys.ToysService.DeleteToy:input_type -> toys.DeleteToyIn
-	10, // 25: toys.ToysService.UpdateToy:input_type -> toys.UpdateToyIn
-	1,  // 26: toys.ToysService.AddToy:output_type -> toys.AddToyOut
-	4,  // 27: toys.ToysService.GetToy:output_type -> toys.GetToyOut
-	6,  // 28: toys.ToysService.GetToys:output_type -> toys.GetToysOut
-	18, // 29: toys.ToysService.CountToys:output_type -> masters.CountOut
-	6,  // 30: toys.ToysService.GetMasterToys:output_type -> toys.GetToysOut
-	18, // 31: toys.ToysService.CountMasterToys:output_type -> masters.CountOut
-	6,  // 32: toys.ToysService.GetUserToys:output_type -> toys.GetToysOut
-	18, // 33: toys.ToysService.CountUserToys:output_type -> masters.CountOut
-	19, // 34: toys.ToysService.DeleteToy:output_type -> google.protobuf.Empty
-	19, // 35: toys.ToysService.UpdateToy:output_type -> google.protobuf.Empty
-	26, // [26:36] is the sub-list for method output_type
-	16, // [16:26] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	42, // 0: toys.GetToyIn.include:type_name -> google.protobuf.FieldMask
+	42, // 1: toys.GetToyIn.readMask:type_name -> google.protobuf.FieldMask
+	0,  // 2: toys.GetToyIn.moderationStatuses:type_name -> toys.ToyModerationStatus
+	43, // 3: toys.Attachment.createdAt:type_name -> google.protobuf.Timestamp
+	43, // 4: toys.Attachment.updatedAt:type_name -> google.protobuf.Timestamp
+	44, // 5: toys.GetToyOut.tags:type_name -> tags.GetTagOut
+	6,  // 6: toys.GetToyOut.attachments:type_name -> toys.Attachment
+	43, // 7: toys.GetToyOut.createdAt:type_name -> google.protobuf.Timestamp
+	43, // 8: toys.GetToyOut.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 9: toys.GetToyOut.moderationStatus:type_name -> toys.ToyModerationStatus
+	45, // 10: toys.GetToyOut.user:type_name -> masters.User
+	46, // 11: toys.GetToysIn.pagination:type_name -> masters.Pagination
+	23, // 12: toys.GetToysIn.filters:type_name -> toys.ToysFilters
+	42, // 13: toys.GetToysIn.readMask:type_name -> google.protobuf.FieldMask
+	7,  // 14: toys.GetToysOut.toys:type_name -> toys.GetToyOut
+	46, // 15: toys.GetMasterToysIn.pagination:type_name -> masters.Pagination
+	23, // 16: toys.GetMasterToysIn.filters:type_name -> toys.ToysFilters
+	42, // 17: toys.GetMasterToysIn.readMask:type_name -> google.protobuf.FieldMask
+	46, // 18: toys.GetUserToysIn.pagination:type_name -> masters.Pagination
+	23, // 19: toys.GetUserToysIn.filters:type_name -> toys.ToysFilters
+	42, // 20: toys.GetUserToysIn.readMask:type_name -> google.protobuf.FieldMask
+	42, // 21: toys.UpdateToyIn.updateMask:type_name -> google.protobuf.FieldMask
+	42, // 22: toys.BatchGetToysIn.readMask:type_name -> google.protobuf.FieldMask
+	0,  // 23: toys.BatchGetToysIn.moderationStatuses:type_name -> toys.ToyModerationStatus
+	7,  // 24: toys.BatchGetToysOut.toys:type_name -> toys.GetToyOut
+	16, // 25: toys.BatchUpdateToysIn.items:type_name -> toys.BatchUpdateToyItem
+	36, // 26: toys.BatchUpdateToyResult.violations:type_name -> toys.ImportViolation
+	18, // 27: toys.BatchUpdateToysOut.results:type_name -> toys.BatchUpdateToyResult
+	23, // 28: toys.CountToysIn.filters:type_name -> toys.ToysFilters
+	23, // 29: toys.CountMasterToysIn.filters:type_name -> toys.ToysFilters
+	23, // 30: toys.CountUserToysIn.filters:type_name -> toys.ToysFilters
+	0,  // 31: toys.ToysFilters.moderationStatuses:type_name -> toys.ToyModerationStatus
+	46, // 32: toys.GetUserFavouritesIn.pagination:type_name -> masters.Pagination
+	23, // 33: toys.GetUserFavouritesIn.filters:type_name -> toys.ToysFilters
+	42, // 34: toys.GetUserFavouritesIn.readMask:type_name -> google.protobuf.FieldMask
+	23, // 35: toys.CountUserFavouritesIn.filters:type_name -> toys.ToysFilters
+	42, // 36: toys.GetFeedIn.readMask:type_name -> google.protobuf.FieldMask
+	7,  // 37: toys.GetFeedOut.toys:type_name -> toys.GetToyOut
+	46, // 38: toys.ListModerationQueueIn.pagination:type_name -> masters.Pagination
+	42, // 39: toys.ListModerationQueueIn.readMask:type_name -> google.protobuf.FieldMask
+	1,  // 40: toys.ImportToysIn.format:type_name -> toys.ImportFormat
+	36, // 41: toys.ImportToyResult.violations:type_name -> toys.ImportViolation
+	37, // 42: toys.ImportToysOut.results:type_name -> toys.ImportToyResult
+	23, // 43: toys.ExportToysIn.filters:type_name -> toys.ToysFilters
+	2,  // 44: toys.ExportToysIn.format:type_name -> toys.ExportFormat
+	39, // 45: toys.ExportToysIn.shop:type_name -> toys.FeedShop
+	3,  // 46: toys.ToysService.AddToy:input_type -> toys.AddToyIn
+	5,  // 47: toys.ToysService.GetToy:input_type -> toys.GetToyIn
+	14, // 48: toys.ToysService.BatchGetToys:input_type -> toys.BatchGetToysIn
+	8,  // 49: toys.ToysService.GetToys:input_type -> toys.GetToysIn
+	20, // 50: toys.ToysService.CountToys:input_type -> toys.CountToysIn
+	10, // 51: toys.ToysService.GetMasterToys:input_type -> toys.GetMasterToysIn
+	21, // 52: toys.ToysService.CountMasterToys:input_type -> toys.CountMasterToysIn
+	11, // 53: toys.ToysService.GetUserToys:input_type -> toys.GetUserToysIn
+	22, // 54: toys.ToysService.CountUserToys:input_type -> toys.CountUserToysIn
+	12, // 55: toys.ToysService.DeleteToy:input_type -> toys.DeleteToyIn
+	13, // 56: toys.ToysService.UpdateToy:input_type -> toys.UpdateToyIn
+	17, // 57: toys.ToysService.BatchUpdateToys:input_type -> toys.BatchUpdateToysIn
+	24, // 58: toys.ToysService.AddFavourite:input_type -> toys.AddFavouriteIn
+	26, // 59: toys.ToysService.RemoveFavourite:input_type -> toys.RemoveFavouriteIn
+	27, // 60: toys.ToysService.GetUserFavourites:input_type -> toys.GetUserFavouritesIn
+	28, // 61: toys.ToysService.CountUserFavourites:input_type -> toys.CountUserFavouritesIn
+	29, // 62: toys.ToysService.GetFeed:input_type -> toys.GetFeedIn
+	31, // 63: toys.ToysService.ListModerationQueue:input_type -> toys.ListModerationQueueIn
+	32, // 64: toys.ToysService.ApproveToy:input_type -> toys.ApproveToyIn
+	33, // 65: toys.ToysService.RejectToy:input_type -> toys.RejectToyIn
+	34, // 66: toys.ToysService.ResubmitToy:input_type -> toys.ResubmitToyIn
+	35, // 67: toys.ToysService.ImportToys:input_type -> toys.ImportToysIn
+	40, // 68: toys.ToysService.ExportToys:input_type -> toys.ExportToysIn
+	4,  // 69: toys.ToysService.AddToy:output_type -> toys.AddToyOut
+	7,  // 70: toys.ToysService.GetToy:output_type -> toys.GetToyOut
+	15, // 71: toys.ToysService.BatchGetToys:output_type -> toys.BatchGetToysOut
+	9,  // 72: toys.ToysService.GetToys:output_type -> toys.GetToysOut
+	47, // 73: toys.ToysService.CountToys:output_type -> masters.CountOut
+	9,  // 74: toys.ToysService.GetMasterToys:output_type -> toys.GetToysOut
+	47, // 75: toys.ToysService.CountMasterToys:output_type -> masters.CountOut
+	9,  // 76: toys.ToysService.GetUserToys:output_type -> toys.GetToysOut
+	47, // 77: toys.ToysService.CountUserToys:output_type -> masters.CountOut
+	48, // 78: toys.ToysService.DeleteToy:output_type -> google.protobuf.Empty
+	48, // 79: toys.ToysService.UpdateToy:output_type -> google.protobuf.Empty
+	19, // 80: toys.ToysService.BatchUpdateToys:output_type -> toys.BatchUpdateToysOut
+	25, // 81: toys.ToysService.AddFavourite:output_type -> toys.AddFavouriteOut
+	48, // 82: toys.ToysService.RemoveFavourite:output_type -> google.protobuf.Empty
+	9,  // 83: toys.ToysService.GetUserFavourites:output_type -> toys.GetToysOut
+	47, // 84: toys.ToysService.CountUserFavourites:output_type -> masters.CountOut
+	30, // 85: toys.ToysService.GetFeed:output_type -> toys.GetFeedOut
+	9,  // 86: toys.ToysService.ListModerationQueue:output_type -> toys.GetToysOut
+	48, // 87: toys.ToysService.ApproveToy:output_type -> google.protobuf.Empty
+	48, // 88: toys.ToysService.RejectToy:output_type -> google.protobuf.Empty
+	48, // 89: toys.ToysService.ResubmitToy:output_type -> google.protobuf.Empty
+	38, // 90: toys.ToysService.ImportToys:output_type -> toys.ImportToysOut
+	41, // 91: toys.ToysService.ExportToys:output_type -> toys.ExportToysOut
+	69, // [69:92] is the sub-list for method output_type
+	46, // [46:69] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_toys_toys_proto_init() }
@@ -1480,7 +3600,7 @@ func file_toys_toys_proto_init() {
 			}
 		}
 		file_toys_toys_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountToysIn); i {
+			switch v := v.(*BatchGetToysIn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1492,7 +3612,7 @@ func file_toys_toys_proto_init() {
 			}
 		}
 		file_toys_toys_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountMasterToysIn); i {
+			switch v := v.(*BatchGetToysOut); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1504,7 +3624,7 @@ func file_toys_toys_proto_init() {
 			}
 		}
 		file_toys_toys_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountUserToysIn); i {
+			switch v := v.(*BatchUpdateToyItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1516,6 +3636,78 @@ func file_toys_toys_proto_init() {
 			}
 		}
 		file_toys_toys_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateToysIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateToyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateToysOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountToysIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountMasterToysIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountUserToysIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ToysFilters); i {
 			case 0:
 				return &v.state
@@ -1527,27 +3719,254 @@ func file_toys_toys_proto_init() {
 				return nil
 			}
 		}
+		file_toys_toys_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFavouriteIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFavouriteOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFavouriteIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFavouritesIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountUserFavouritesIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeedIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeedOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModerationQueueIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveToyIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectToyIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResubmitToyIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportToysIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportToyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportToysOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedShop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportToysIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_toys_toys_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportToysOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_toys_toys_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_toys_toys_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	file_toys_toys_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	file_toys_toys_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	file_toys_toys_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_toys_toys_proto_msgTypes[11].OneofWrappers = []interface{}{}
-	file_toys_toys_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	file_toys_toys_proto_msgTypes[13].OneofWrappers = []interface{}{}
-	file_toys_toys_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_toys_toys_proto_msgTypes[37].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_toys_toys_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_toys_toys_proto_goTypes,
 		DependencyIndexes: file_toys_toys_proto_depIdxs,
+		EnumInfos:         file_toys_toys_proto_enumTypes,
 		MessageInfos:      file_toys_toys_proto_msgTypes,
 	}.Build()
 	File_toys_toys_proto = out.File
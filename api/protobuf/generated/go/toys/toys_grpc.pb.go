@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 type ToysServiceClient interface {
 	AddToy(ctx context.Context, in *AddToyIn, opts ...grpc.CallOption) (*AddToyOut, error)
 	GetToy(ctx context.Context, in *GetToyIn, opts ...grpc.CallOption) (*GetToyOut, error)
+	BatchGetToys(ctx context.Context, in *BatchGetToysIn, opts ...grpc.CallOption) (*BatchGetToysOut, error)
 	GetToys(ctx context.Context, in *GetToysIn, opts ...grpc.CallOption) (*GetToysOut, error)
 	CountToys(ctx context.Context, in *CountToysIn, opts ...grpc.CallOption) (*CountOut, error)
 	GetMasterToys(ctx context.Context, in *GetMasterToysIn, opts ...grpc.CallOption) (*GetToysOut, error)
@@ -29,6 +30,18 @@ type ToysServiceClient interface {
 	CountUserToys(ctx context.Context, in *CountUserToysIn, opts ...grpc.CallOption) (*CountOut, error)
 	DeleteToy(ctx context.Context, in *DeleteToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateToy(ctx context.Context, in *UpdateToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchUpdateToys(ctx context.Context, in *BatchUpdateToysIn, opts ...grpc.CallOption) (*BatchUpdateToysOut, error)
+	AddFavourite(ctx context.Context, in *AddFavouriteIn, opts ...grpc.CallOption) (*AddFavouriteOut, error)
+	RemoveFavourite(ctx context.Context, in *RemoveFavouriteIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserFavourites(ctx context.Context, in *GetUserFavouritesIn, opts ...grpc.CallOption) (*GetToysOut, error)
+	CountUserFavourites(ctx context.Context, in *CountUserFavouritesIn, opts ...grpc.CallOption) (*CountOut, error)
+	GetFeed(ctx context.Context, in *GetFeedIn, opts ...grpc.CallOption) (*GetFeedOut, error)
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueIn, opts ...grpc.CallOption) (*GetToysOut, error)
+	ApproveToy(ctx context.Context, in *ApproveToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RejectToy(ctx context.Context, in *RejectToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResubmitToy(ctx context.Context, in *ResubmitToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportToys(ctx context.Context, opts ...grpc.CallOption) (ToysService_ImportToysClient, error)
+	ExportToys(ctx context.Context, in *ExportToysIn, opts ...grpc.CallOption) (ToysService_ExportToysClient, error)
 }
 
 type toysServiceClient struct {
@@ -57,6 +70,15 @@ func (c *toysServiceClient) GetToy(ctx context.Context, in *GetToyIn, opts ...gr
 	return out, nil
 }
 
+func (c *toysServiceClient) BatchGetToys(ctx context.Context, in *BatchGetToysIn, opts ...grpc.CallOption) (*BatchGetToysOut, error) {
+	out := new(BatchGetToysOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/BatchGetToys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *toysServiceClient) GetToys(ctx context.Context, in *GetToysIn, opts ...grpc.CallOption) (*GetToysOut, error) {
 	out := new(GetToysOut)
 	err := c.cc.Invoke(ctx, "/toys.ToysService/GetToys", in, out, opts...)
@@ -129,12 +151,169 @@ func (c *toysServiceClient) UpdateToy(ctx context.Context, in *UpdateToyIn, opts
 	return out, nil
 }
 
+func (c *toysServiceClient) BatchUpdateToys(ctx context.Context, in *BatchUpdateToysIn, opts ...grpc.CallOption) (*BatchUpdateToysOut, error) {
+	out := new(BatchUpdateToysOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/BatchUpdateToys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) AddFavourite(ctx context.Context, in *AddFavouriteIn, opts ...grpc.CallOption) (*AddFavouriteOut, error) {
+	out := new(AddFavouriteOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/AddFavourite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) RemoveFavourite(ctx context.Context, in *RemoveFavouriteIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/RemoveFavourite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) GetUserFavourites(ctx context.Context, in *GetUserFavouritesIn, opts ...grpc.CallOption) (*GetToysOut, error) {
+	out := new(GetToysOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/GetUserFavourites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) CountUserFavourites(ctx context.Context, in *CountUserFavouritesIn, opts ...grpc.CallOption) (*CountOut, error) {
+	out := new(CountOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/CountUserFavourites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) GetFeed(ctx context.Context, in *GetFeedIn, opts ...grpc.CallOption) (*GetFeedOut, error) {
+	out := new(GetFeedOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/GetFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueIn, opts ...grpc.CallOption) (*GetToysOut, error) {
+	out := new(GetToysOut)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/ListModerationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) ApproveToy(ctx context.Context, in *ApproveToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/ApproveToy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) RejectToy(ctx context.Context, in *RejectToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/RejectToy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) ResubmitToy(ctx context.Context, in *ResubmitToyIn, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/toys.ToysService/ResubmitToy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toysServiceClient) ImportToys(ctx context.Context, opts ...grpc.CallOption) (ToysService_ImportToysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ToysService_ServiceDesc.Streams[0], "/toys.ToysService/ImportToys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toysServiceImportToysClient{stream}
+	return x, nil
+}
+
+type ToysService_ImportToysClient interface {
+	Send(*ImportToysIn) error
+	CloseAndRecv() (*ImportToysOut, error)
+	grpc.ClientStream
+}
+
+type toysServiceImportToysClient struct {
+	grpc.ClientStream
+}
+
+func (x *toysServiceImportToysClient) Send(m *ImportToysIn) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *toysServiceImportToysClient) CloseAndRecv() (*ImportToysOut, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportToysOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *toysServiceClient) ExportToys(ctx context.Context, in *ExportToysIn, opts ...grpc.CallOption) (ToysService_ExportToysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ToysService_ServiceDesc.Streams[1], "/toys.ToysService/ExportToys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toysServiceExportToysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ToysService_ExportToysClient interface {
+	Recv() (*ExportToysOut, error)
+	grpc.ClientStream
+}
+
+type toysServiceExportToysClient struct {
+	grpc.ClientStream
+}
+
+func (x *toysServiceExportToysClient) Recv() (*ExportToysOut, error) {
+	m := new(ExportToysOut)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ToysServiceServer is the server API for ToysService service.
 // All implementations must embed UnimplementedToysServiceServer
 // for forward compatibility
 type ToysServiceServer interface {
 	AddToy(context.Context, *AddToyIn) (*AddToyOut, error)
 	GetToy(context.Context, *GetToyIn) (*GetToyOut, error)
+	BatchGetToys(context.Context, *BatchGetToysIn) (*BatchGetToysOut, error)
 	GetToys(context.Context, *GetToysIn) (*GetToysOut, error)
 	CountToys(context.Context, *CountToysIn) (*CountOut, error)
 	GetMasterToys(context.Context, *GetMasterToysIn) (*GetToysOut, error)
@@ -143,6 +322,18 @@ type ToysServiceServer interface {
 	CountUserToys(context.Context, *CountUserToysIn) (*CountOut, error)
 	DeleteToy(context.Context, *DeleteToyIn) (*emptypb.Empty, error)
 	UpdateToy(context.Context, *UpdateToyIn) (*emptypb.Empty, error)
+	BatchUpdateToys(context.Context, *BatchUpdateToysIn) (*BatchUpdateToysOut, error)
+	AddFavourite(context.Context, *AddFavouriteIn) (*AddFavouriteOut, error)
+	RemoveFavourite(context.Context, *RemoveFavouriteIn) (*emptypb.Empty, error)
+	GetUserFavourites(context.Context, *GetUserFavouritesIn) (*GetToysOut, error)
+	CountUserFavourites(context.Context, *CountUserFavouritesIn) (*CountOut, error)
+	GetFeed(context.Context, *GetFeedIn) (*GetFeedOut, error)
+	ListModerationQueue(context.Context, *ListModerationQueueIn) (*GetToysOut, error)
+	ApproveToy(context.Context, *ApproveToyIn) (*emptypb.Empty, error)
+	RejectToy(context.Context, *RejectToyIn) (*emptypb.Empty, error)
+	ResubmitToy(context.Context, *ResubmitToyIn) (*emptypb.Empty, error)
+	ImportToys(ToysService_ImportToysServer) error
+	ExportToys(*ExportToysIn, ToysService_ExportToysServer) error
 	mustEmbedUnimplementedToysServiceServer()
 }
 
@@ -156,6 +347,9 @@ func (UnimplementedToysServiceServer) AddToy(context.Context, *AddToyIn) (*AddTo
 func (UnimplementedToysServiceServer) GetToy(context.Context, *GetToyIn) (*GetToyOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetToy not implemented")
 }
+func (UnimplementedToysServiceServer) BatchGetToys(context.Context, *BatchGetToysIn) (*BatchGetToysOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetToys not implemented")
+}
 func (UnimplementedToysServiceServer) GetToys(context.Context, *GetToysIn) (*GetToysOut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetToys not implemented")
 }
@@ -180,6 +374,42 @@ func (UnimplementedToysServiceServer) DeleteToy(context.Context, *DeleteToyIn) (
 func (UnimplementedToysServiceServer) UpdateToy(context.Context, *UpdateToyIn) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateToy not implemented")
 }
+func (UnimplementedToysServiceServer) BatchUpdateToys(context.Context, *BatchUpdateToysIn) (*BatchUpdateToysOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateToys not implemented")
+}
+func (UnimplementedToysServiceServer) AddFavourite(context.Context, *AddFavouriteIn) (*AddFavouriteOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavourite not implemented")
+}
+func (UnimplementedToysServiceServer) RemoveFavourite(context.Context, *RemoveFavouriteIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavourite not implemented")
+}
+func (UnimplementedToysServiceServer) GetUserFavourites(context.Context, *GetUserFavouritesIn) (*GetToysOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFavourites not implemented")
+}
+func (UnimplementedToysServiceServer) CountUserFavourites(context.Context, *CountUserFavouritesIn) (*CountOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountUserFavourites not implemented")
+}
+func (UnimplementedToysServiceServer) GetFeed(context.Context, *GetFeedIn) (*GetFeedOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedToysServiceServer) ListModerationQueue(context.Context, *ListModerationQueueIn) (*GetToysOut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedToysServiceServer) ApproveToy(context.Context, *ApproveToyIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveToy not implemented")
+}
+func (UnimplementedToysServiceServer) RejectToy(context.Context, *RejectToyIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectToy not implemented")
+}
+func (UnimplementedToysServiceServer) ResubmitToy(context.Context, *ResubmitToyIn) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitToy not implemented")
+}
+func (UnimplementedToysServiceServer) ImportToys(ToysService_ImportToysServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportToys not implemented")
+}
+func (UnimplementedToysServiceServer) ExportToys(*ExportToysIn, ToysService_ExportToysServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportToys not implemented")
+}
 func (UnimplementedToysServiceServer) mustEmbedUnimplementedToysServiceServer() {}
 
 // UnsafeToysServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -229,6 +459,24 @@ func _ToysService_GetToy_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToysService_BatchGetToys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetToysIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).BatchGetToys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/BatchGetToys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).BatchGetToys(ctx, req.(*BatchGetToysIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ToysService_GetToys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetToysIn)
 	if err := dec(in); err != nil {
@@ -373,6 +621,233 @@ func _ToysService_UpdateToy_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToysService_BatchUpdateToys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateToysIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).BatchUpdateToys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/BatchUpdateToys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).BatchUpdateToys(ctx, req.(*BatchUpdateToysIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_AddFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavouriteIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).AddFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/AddFavourite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).AddFavourite(ctx, req.(*AddFavouriteIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_RemoveFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavouriteIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).RemoveFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/RemoveFavourite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).RemoveFavourite(ctx, req.(*RemoveFavouriteIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_GetUserFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFavouritesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).GetUserFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/GetUserFavourites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).GetUserFavourites(ctx, req.(*GetUserFavouritesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_CountUserFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountUserFavouritesIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).CountUserFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/CountUserFavourites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).CountUserFavourites(ctx, req.(*CountUserFavouritesIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/GetFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).GetFeed(ctx, req.(*GetFeedIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/ListModerationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_ApproveToy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveToyIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).ApproveToy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/ApproveToy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).ApproveToy(ctx, req.(*ApproveToyIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_RejectToy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectToyIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).RejectToy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/RejectToy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).RejectToy(ctx, req.(*RejectToyIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_ResubmitToy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResubmitToyIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToysServiceServer).ResubmitToy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/toys.ToysService/ResubmitToy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToysServiceServer).ResubmitToy(ctx, req.(*ResubmitToyIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToysService_ImportToys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ToysServiceServer).ImportToys(&toysServiceImportToysServer{stream})
+}
+
+type ToysService_ImportToysServer interface {
+	SendAndClose(*ImportToysOut) error
+	Recv() (*ImportToysIn, error)
+	grpc.ServerStream
+}
+
+type toysServiceImportToysServer struct {
+	grpc.ServerStream
+}
+
+func (x *toysServiceImportToysServer) SendAndClose(m *ImportToysOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *toysServiceImportToysServer) Recv() (*ImportToysIn, error) {
+	m := new(ImportToysIn)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ToysService_ExportToys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportToysIn)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ToysServiceServer).ExportToys(m, &toysServiceExportToysServer{stream})
+}
+
+type ToysService_ExportToysServer interface {
+	Send(*ExportToysOut) error
+	grpc.ServerStream
+}
+
+type toysServiceExportToysServer struct {
+	grpc.ServerStream
+}
+
+func (x *toysServiceExportToysServer) Send(m *ExportToysOut) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ToysService_ServiceDesc is the grpc.ServiceDesc for ToysService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -388,6 +863,10 @@ var ToysService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetToy",
 			Handler:    _ToysService_GetToy_Handler,
 		},
+		{
+			MethodName: "BatchGetToys",
+			Handler:    _ToysService_BatchGetToys_Handler,
+		},
 		{
 			MethodName: "GetToys",
 			Handler:    _ToysService_GetToys_Handler,
@@ -420,7 +899,58 @@ var ToysService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateToy",
 			Handler:    _ToysService_UpdateToy_Handler,
 		},
+		{
+			MethodName: "BatchUpdateToys",
+			Handler:    _ToysService_BatchUpdateToys_Handler,
+		},
+		{
+			MethodName: "AddFavourite",
+			Handler:    _ToysService_AddFavourite_Handler,
+		},
+		{
+			MethodName: "RemoveFavourite",
+			Handler:    _ToysService_RemoveFavourite_Handler,
+		},
+		{
+			MethodName: "GetUserFavourites",
+			Handler:    _ToysService_GetUserFavourites_Handler,
+		},
+		{
+			MethodName: "CountUserFavourites",
+			Handler:    _ToysService_CountUserFavourites_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _ToysService_GetFeed_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _ToysService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ApproveToy",
+			Handler:    _ToysService_ApproveToy_Handler,
+		},
+		{
+			MethodName: "RejectToy",
+			Handler:    _ToysService_RejectToy_Handler,
+		},
+		{
+			MethodName: "ResubmitToy",
+			Handler:    _ToysService_ResubmitToy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportToys",
+			Handler:       _ToysService_ImportToys_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportToys",
+			Handler:       _ToysService_ExportToys_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "toys/toys.proto",
 }
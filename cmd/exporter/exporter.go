@@ -0,0 +1,107 @@
+// Exporter downloads archive of all data, which is stored about User, via ExportUserData RPC and saves it to file.
+// Usage: exporter -user-id=1 [-address=0.0.0.0:8060] [-output=user_1.json].
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/DKhorkov/libs/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+)
+
+func main() {
+	address := flag.String("address", "0.0.0.0:8060", "address of hmtm-toys gRPC server")
+	userID := flag.Uint64("user-id", 0, "ID of User, whose data should be exported")
+	output := flag.String("output", "", "path to archive file, user_<user-id>.json by default")
+	flag.Parse()
+
+	if *userID == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *output == "" {
+		*output = fmt.Sprintf("user_%d.json", *userID)
+	}
+
+	clientConnection, err := grpc.NewClient(
+		*address,
+		grpc.WithTransportCredentials(
+			insecure.NewCredentials(),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err = clientConnection.Close(); err != nil {
+			fmt.Println("Failed to close connection:", err)
+		}
+	}()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), requestid.Key, requestid.New())
+
+	stream, err := toys.NewMastersServiceClient(clientConnection).ExportUserData(
+		ctx,
+		&toys.ExportUserDataIn{UserID: *userID},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	written, err := saveArchive(stream, *output)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Exported %d bytes of data of User with ID=%d to %s\n", written, *userID, *output)
+}
+
+// saveArchive writes received chunks to temporary file and renames it after whole archive is received,
+// so incomplete archive is never left at provided path.
+func saveArchive(stream toys.MastersService_ExportUserDataClient, path string) (int64, error) {
+	tmpPath := path + ".tmp"
+
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var written int64
+
+	for {
+		var chunk *toys.ExportUserDataOut
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err == nil {
+			var n int
+
+			n, err = file.Write(chunk.GetChunk())
+			written += int64(n)
+		}
+
+		if err != nil {
+			return 0, errors.Join(err, file.Close(), os.Remove(tmpPath))
+		}
+	}
+
+	if err = file.Close(); err != nil {
+		return 0, errors.Join(err, os.Remove(tmpPath))
+	}
+
+	return written, os.Rename(tmpPath, path)
+}
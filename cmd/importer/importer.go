@@ -0,0 +1,158 @@
+// Importer uploads Toys of Master from CSV or JSON Lines file via ImportToys RPC and prints report for every line.
+// Usage: importer -user-id=1 -input=toys.csv [-format=csv|jsonl] [-dry-run] [-address=0.0.0.0:8060].
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DKhorkov/libs/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+)
+
+const chunkSize = 64 * 1024
+
+func main() {
+	address := flag.String("address", "0.0.0.0:8060", "address of hmtm-toys gRPC server")
+	userID := flag.Uint64("user-id", 0, "ID of User, whose Master imports Toys")
+	input := flag.String("input", "", "path to CSV or JSON Lines file")
+	formatName := flag.String("format", "", "format of file: csv or jsonl, detected by file extension by default")
+	dryRun := flag.Bool("dry-run", false, "only validate rows without importing them")
+	flag.Parse()
+
+	if *formatName == "" {
+		*formatName = strings.TrimPrefix(strings.ToLower(filepath.Ext(*input)), ".")
+	}
+
+	formats := map[string]toys.ImportFormat{
+		"csv":   toys.ImportFormat_IMPORT_FORMAT_CSV,
+		"jsonl": toys.ImportFormat_IMPORT_FORMAT_JSONL,
+	}
+
+	format, ok := formats[*formatName]
+	if *userID == 0 || *input == "" || !ok {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(*input)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err = file.Close(); err != nil {
+			fmt.Println("Failed to close file:", err)
+		}
+	}()
+
+	clientConnection, err := grpc.NewClient(
+		*address,
+		grpc.WithTransportCredentials(
+			insecure.NewCredentials(),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err = clientConnection.Close(); err != nil {
+			fmt.Println("Failed to close connection:", err)
+		}
+	}()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), requestid.Key, requestid.New())
+
+	stream, err := toys.NewToysServiceClient(clientConnection).ImportToys(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	header := &toys.ImportToysIn{UserID: *userID, Format: format, DryRun: *dryRun}
+	if err = sendFile(stream, header, file); err != nil {
+		panic(err)
+	}
+
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		panic(err)
+	}
+
+	printReport(report, *dryRun)
+}
+
+// sendFile sends file in chunks. Header is sent with the first chunk, so empty file is sent as header only.
+func sendFile(stream toys.ToysService_ImportToysClient, header *toys.ImportToysIn, file io.Reader) error {
+	in := header
+
+	for {
+		// Sent message should not be modified, so every chunk gets own buffer:
+		chunk := make([]byte, chunkSize)
+
+		n, readErr := file.Read(chunk)
+		if n > 0 || in == header {
+			if in == nil {
+				in = &toys.ImportToysIn{}
+			}
+
+			in.Chunk = chunk[:n]
+			if err := stream.Send(in); err != nil {
+				return err
+			}
+
+			in = nil
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+func printReport(report *toys.ImportToysOut, dryRun bool) {
+	for _, result := range report.GetResults() {
+		switch {
+		case len(result.GetViolations()) > 0:
+			for _, violation := range result.GetViolations() {
+				fmt.Printf(
+					"line %d: %s [%s]: %s\n",
+					result.GetLine(),
+					violation.GetField(),
+					violation.GetCode(),
+					violation.GetMessage(),
+				)
+			}
+		case result.GetToyID() != 0:
+			fmt.Printf("line %d: imported Toy with ID=%d\n", result.GetLine(), result.GetToyID())
+		default:
+			fmt.Printf("line %d: valid\n", result.GetLine())
+		}
+	}
+
+	if dryRun {
+		fmt.Printf(
+			"Dry run: %d valid and %d invalid rows, nothing was imported\n",
+			report.GetValidCount(),
+			report.GetInvalidCount(),
+		)
+
+		return
+	}
+
+	fmt.Printf("Imported %d rows, %d invalid rows were skipped\n", report.GetValidCount(), report.GetInvalidCount())
+}
@@ -0,0 +1,140 @@
+// Reconciler finds Masters, whose Users were deleted in SSO, but events about deletion were not processed,
+// and applies deletion to them. Use -dry-run flag to only list such Masters.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	ssogrpcclient "github.com/DKhorkov/hmtm-toys/internal/clients/sso/grpc"
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/repositories"
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+	"github.com/DKhorkov/hmtm-toys/internal/usecases"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "only list Masters of deleted Users without changing them")
+	flag.Parse()
+
+	settings := config.New()
+	logger := logging.New(
+		settings.Logging.Level,
+		settings.Logging.LogFilePath,
+	)
+
+	switch settings.UserEvents.DeletedUserToysPolicy {
+	case config.DeletedUserToysPolicyArchive, config.DeletedUserToysPolicyDelete:
+	default:
+		panic("unknown deleted User Toys policy: " + settings.UserEvents.DeletedUserToysPolicy)
+	}
+
+	// Reconciliation reads all Masters and should see own writes, so replicas are not used:
+	dbConnector, err := db.New(
+		db.BuildDsn(settings.Database),
+		settings.Database.Driver,
+		logger,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err = dbConnector.Close(); err != nil {
+			logging.LogError(logger, "Failed to close db connections pool", err)
+		}
+	}()
+
+	traceProvider, err := tracing.New(settings.Tracing.Server)
+	if err != nil {
+		panic(err)
+	}
+
+	defer func() {
+		if err = traceProvider.Shutdown(context.Background()); err != nil {
+			logging.LogError(logger, "Error shutting down tracer", err)
+		}
+	}()
+
+	ssoClient, err := ssogrpcclient.New(
+		settings.Clients.SSO,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Clients.SSO,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	isolationLevel, err := transactions.ParseIsolationLevel(settings.Transactions.IsolationLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	useCases := usecases.New(
+		services.NewTagsService(
+			repositories.NewTagsRepository(dbConnector, logger, traceProvider, settings.Tracing.Spans.Repositories.Tags),
+			logger,
+		),
+		services.NewCategoriesService(
+			repositories.NewCategoriesRepository(
+				dbConnector,
+				logger,
+				traceProvider,
+				settings.Tracing.Spans.Repositories.Categories,
+			),
+			logger,
+		),
+		services.NewMastersService(
+			repositories.NewMastersRepository(
+				dbConnector,
+				logger,
+				traceProvider,
+				settings.Tracing.Spans.Repositories.Masters,
+			),
+			logger,
+		),
+		services.NewToysService(
+			repositories.NewToysRepository(dbConnector, logger, traceProvider, settings.Tracing.Spans.Repositories.Toys),
+			logger,
+		),
+		services.NewSsoService(repositories.NewSsoRepository(ssoClient), logger),
+		services.NewReviewsService(
+			repositories.NewReviewsRepository(
+				dbConnector,
+				logger,
+				traceProvider,
+				settings.Tracing.Spans.Repositories.Reviews,
+			),
+			logger,
+		),
+		// Dictionary is not used by reconciliation, so it is not loaded:
+		services.NewForbiddenWordsService(
+			repositories.NewForbiddenWordsFileRepository(settings.Validation.ForbiddenWords.FilePath),
+			logger,
+		),
+		transactions.NewManager(dbConnector, isolationLevel, logger),
+		settings.Validation,
+		settings.UserEvents,
+	)
+
+	orphanedMastersIDs, err := useCases.ReconcileMasters(context.Background(), *dryRun)
+	if err != nil {
+		logging.LogError(logger, "Failed to reconcile Masters", err)
+		panic(err)
+	}
+
+	if *dryRun {
+		fmt.Printf("Found %d Masters of deleted Users: %v\n", len(orphanedMastersIDs), orphanedMastersIDs)
+
+		return
+	}
+
+	fmt.Printf("Processed %d Masters of deleted Users: %v\n", len(orphanedMastersIDs), orphanedMastersIDs)
+}
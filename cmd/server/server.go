@@ -2,20 +2,34 @@ package main
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/DKhorkov/libs/db"
 	"github.com/DKhorkov/libs/logging"
 	"github.com/DKhorkov/libs/tracing"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/DKhorkov/hmtm-toys/internal/app"
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/clients/circuitbreaker"
 	ssogrpcclient "github.com/DKhorkov/hmtm-toys/internal/clients/sso/grpc"
 	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/consumers"
 	grpccontroller "github.com/DKhorkov/hmtm-toys/internal/controllers/grpc"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/health"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+	"github.com/DKhorkov/hmtm-toys/internal/jobs"
+	"github.com/DKhorkov/hmtm-toys/internal/loaders"
+	"github.com/DKhorkov/hmtm-toys/internal/replicas"
 	"github.com/DKhorkov/hmtm-toys/internal/repositories"
 	"github.com/DKhorkov/hmtm-toys/internal/services"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
 	"github.com/DKhorkov/hmtm-toys/internal/usecases"
 )
 
+// ssoDependencyName is a name of SSO in health service.
+const ssoDependencyName = "sso"
+
 func main() {
 	settings := config.New()
 	logger := logging.New(
@@ -23,7 +37,12 @@ func main() {
 		settings.Logging.LogFilePath,
 	)
 
-	dbConnector, err := db.New(
+	// Export of Toys holds one connection of primary database, so single connection pool blocks other requests:
+	if settings.Database.Pool.MaxOpenConnections < config.MinMaxOpenConnections {
+		panic(fmt.Sprintf("MAX_OPEN_CONNECTIONS must be at least %d", config.MinMaxOpenConnections))
+	}
+
+	primaryDBConnector, err := db.New(
 		db.BuildDsn(settings.Database),
 		settings.Database.Driver,
 		logger,
@@ -36,6 +55,13 @@ func main() {
 		panic(err)
 	}
 
+	replicasDBConnectors, err := replicas.Connect(settings.Database, settings.Replicas, logger)
+	if err != nil {
+		panic(err)
+	}
+
+	dbConnector := replicas.New(primaryDBConnector, replicasDBConnectors, settings.Replicas, logger)
+
 	defer func() {
 		if err = dbConnector.Close(); err != nil {
 			logging.LogError(logger, "Failed to close db connections pool", err)
@@ -54,10 +80,7 @@ func main() {
 	}()
 
 	ssoClient, err := ssogrpcclient.New(
-		settings.Clients.SSO.Host,
-		settings.Clients.SSO.Port,
-		settings.Clients.SSO.RetriesCount,
-		settings.Clients.SSO.RetryTimeout,
+		settings.Clients.SSO,
 		logger,
 		traceProvider,
 		settings.Tracing.Spans.Clients.SSO,
@@ -67,7 +90,44 @@ func main() {
 	}
 
 	ssoRepository := repositories.NewSsoRepository(ssoClient)
-	ssoService := services.NewSsoService(ssoRepository, logger)
+	var ssoService interfaces.SsoService = services.NewSsoService(ssoRepository, logger)
+
+	var cacheBackend interfaces.Cache
+
+	switch settings.Cache.Backend {
+	case config.CacheBackendLRU:
+		cacheBackend = cache.NewLRU(settings.Cache.LRUCapacity)
+	case config.CacheBackendRedis:
+		redisClient := redis.NewClient(
+			&redis.Options{
+				Addr:     settings.Cache.Redis.Address,
+				Password: settings.Cache.Redis.Password,
+				DB:       settings.Cache.Redis.DB,
+			},
+		)
+
+		defer func() {
+			if err = redisClient.Close(); err != nil {
+				logging.LogError(logger, "Failed to close Redis client", err)
+			}
+		}()
+
+		cacheBackend = cache.NewRedis(redisClient, settings.Cache.Redis.KeyPrefix)
+	default:
+		panic("unknown cache backend: " + settings.Cache.Backend)
+	}
+
+	cacheLoader := cache.NewLoader(cacheBackend, logger)
+
+	switch settings.Cache.Users.SsoFailurePolicy {
+	case config.SsoFailurePolicyFailClosed, config.SsoFailurePolicyServeStale:
+	default:
+		panic("unknown SSO failure policy: " + settings.Cache.Users.SsoFailurePolicy)
+	}
+
+	if settings.Cache.Users.TTL > 0 {
+		ssoService = services.NewCachedSsoService(ssoService, cacheBackend, settings.Cache.Users, logger)
+	}
 
 	tagsRepository := repositories.NewTagsRepository(
 		dbConnector,
@@ -76,11 +136,15 @@ func main() {
 		settings.Tracing.Spans.Repositories.Tags,
 	)
 
-	tagsService := services.NewTagsService(
+	var tagsService interfaces.TagsService = services.NewTagsService(
 		tagsRepository,
 		logger,
 	)
 
+	if settings.Cache.TTLs.Tags > 0 {
+		tagsService = services.NewCachedTagsService(tagsService, cacheLoader, settings.Cache.TTLs.Tags)
+	}
+
 	categoriesRepository := repositories.NewCategoriesRepository(
 		dbConnector,
 		logger,
@@ -88,11 +152,19 @@ func main() {
 		settings.Tracing.Spans.Repositories.Categories,
 	)
 
-	categoriesService := services.NewCategoriesService(
+	var categoriesService interfaces.CategoriesService = services.NewCategoriesService(
 		categoriesRepository,
 		logger,
 	)
 
+	if settings.Cache.TTLs.Categories > 0 {
+		categoriesService = services.NewCachedCategoriesService(
+			categoriesService,
+			cacheLoader,
+			settings.Cache.TTLs.Categories,
+		)
+	}
+
 	mastersRepository := repositories.NewMastersRepository(
 		dbConnector,
 		logger,
@@ -112,29 +184,128 @@ func main() {
 		settings.Tracing.Spans.Repositories.Toys,
 	)
 
-	toysService := services.NewToysService(
+	var toysService interfaces.ToysService = services.NewToysService(
 		toysRepository,
 		logger,
 	)
 
+	if settings.Cache.TTLs.Toys > 0 {
+		toysService = services.NewCachedToysService(toysService, cacheLoader, settings.Cache.TTLs.Toys)
+	}
+
+	reviewsRepository := repositories.NewReviewsRepository(
+		dbConnector,
+		logger,
+		traceProvider,
+		settings.Tracing.Spans.Repositories.Reviews,
+	)
+
+	reviewsService := services.NewReviewsService(
+		reviewsRepository,
+		logger,
+	)
+
+	var forbiddenWordsRepository interfaces.ForbiddenWordsRepository
+
+	switch settings.Validation.ForbiddenWords.Source {
+	case config.ForbiddenWordsSourceFile:
+		forbiddenWordsRepository = repositories.NewForbiddenWordsFileRepository(
+			settings.Validation.ForbiddenWords.FilePath,
+		)
+	case config.ForbiddenWordsSourceDatabase:
+		forbiddenWordsRepository = repositories.NewForbiddenWordsRepository(
+			dbConnector,
+			logger,
+			traceProvider,
+			settings.Tracing.Spans.Repositories.ForbiddenWords,
+		)
+	default:
+		panic("unknown forbidden words source: " + settings.Validation.ForbiddenWords.Source)
+	}
+
+	forbiddenWordsService := services.NewForbiddenWordsService(
+		forbiddenWordsRepository,
+		logger,
+	)
+
+	// Dictionary is loaded before serving requests, so misconfigured source is detected on start:
+	if _, err = forbiddenWordsService.ReloadForbiddenWords(context.Background()); err != nil {
+		panic(err)
+	}
+
+	isolationLevel, err := transactions.ParseIsolationLevel(settings.Transactions.IsolationLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	transactionManager := transactions.NewManager(dbConnector, isolationLevel, logger)
+
 	useCases := usecases.New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		settings.Validation,
+		settings.UserEvents,
 	)
 
+	// SSO is reported as unavailable, while circuit breaker doesn't let calls to it:
+	dependencies := map[string]health.Dependency{
+		ssoDependencyName: func() bool {
+			return ssoClient.CircuitBreakerState() != circuitbreaker.StateOpen
+		},
+	}
+
 	controller := grpccontroller.New(
 		settings.HTTP.Host,
 		settings.HTTP.Port,
 		useCases,
+		dependencies,
 		logger,
 		traceProvider,
 		settings.Tracing.Spans.Root,
+		loaders.UnaryServerUsersLoaderInterceptor(ssoService, settings.Clients.UsersLoader),
+	)
+
+	vacationsJob := jobs.NewVacationsJob(
+		useCases,
+		settings.Jobs.Vacations.Interval,
+		logger,
 	)
 
-	application := app.New(controller)
+	forbiddenWordsJob := jobs.NewForbiddenWordsJob(
+		useCases,
+		settings.Jobs.ForbiddenWords.Interval,
+		logger,
+	)
+
+	switch settings.UserEvents.DeletedUserToysPolicy {
+	case config.DeletedUserToysPolicyArchive, config.DeletedUserToysPolicyDelete:
+	default:
+		panic("unknown deleted User Toys policy: " + settings.UserEvents.DeletedUserToysPolicy)
+	}
+
+	var userEventsConsumer interfaces.UserEventsConsumer
+
+	switch settings.UserEvents.Consumer {
+	case config.UserEventsConsumerMemory:
+		userEventsConsumer = consumers.NewMemoryUserEventsConsumer(settings.UserEvents.PollInterval, logger)
+	case config.UserEventsConsumerFile:
+		userEventsConsumer = consumers.NewFileUserEventsConsumer(
+			settings.UserEvents.FilePath,
+			settings.UserEvents.PollInterval,
+			logger,
+		)
+	default:
+		panic("unknown User events consumer: " + settings.UserEvents.Consumer)
+	}
+
+	userEventsJob := jobs.NewUserEventsJob(useCases, userEventsConsumer, logger)
+
+	application := app.New(controller, vacationsJob, forbiddenWordsJob, userEventsJob)
 	application.Run()
 }
@@ -8,20 +8,26 @@ import (
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
 )
 
-func New(controller interfaces.Controller) *App {
+func New(controller interfaces.Controller, jobs ...interfaces.Job) *App {
 	return &App{
 		controller: controller,
+		jobs:       jobs,
 	}
 }
 
 type App struct {
 	controller interfaces.Controller
+	jobs       []interfaces.Job
 }
 
 func (application *App) Run() {
 	// Launch asynchronous for graceful shutdown purpose:
 	go application.controller.Run()
 
+	for _, job := range application.jobs {
+		go job.Run()
+	}
+
 	// Graceful shutdown. When system signal will be received, signal.Notify function will write it to channel.
 	// After this event, main goroutine will be unblocked (<-stopChannel blocks it) and application will be
 	// gracefully stopped:
@@ -29,4 +35,8 @@ func (application *App) Run() {
 	signal.Notify(stopChannel, syscall.SIGINT, syscall.SIGTERM)
 	<-stopChannel
 	application.controller.Stop()
+
+	for _, job := range application.jobs {
+		job.Stop()
+	}
 }
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+)
+
+// NewLoader creates Loader, which stores JSON-encoded values in cache.
+func NewLoader(cache interfaces.Cache, logger logging.Logger) *Loader {
+	return &Loader{
+		cache:  cache,
+		logger: logger,
+	}
+}
+
+// Loader implements read-through caching. Concurrent misses of the same key are merged into single load,
+// so expired hot key does not lead to thundering herd. Failures of cache are logged and values are loaded
+// directly, so unavailable cache slows service down, but does not break it.
+type Loader struct {
+	cache  interfaces.Cache
+	group  singleflight.Group
+	logger logging.Logger
+}
+
+// Load returns value by key from cache or loads it with load and caches it for ttl. Errors are not cached.
+// Inside transaction cache is bypassed, because loaded value can contain uncommitted changes.
+func Load[T any](
+	ctx context.Context,
+	loader *Loader,
+	key string,
+	ttl time.Duration,
+	load func(ctx context.Context) (T, error),
+) (T, error) {
+	if transactions.InTransaction(ctx) {
+		return load(ctx)
+	}
+
+	var value T
+
+	data, found, err := loader.cache.Get(ctx, key)
+	if err != nil {
+		logging.LogErrorContext(ctx, loader.logger, fmt.Sprintf("Failed to get %s from cache", key), err)
+	}
+
+	if found {
+		if err = json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+
+		logging.LogErrorContext(ctx, loader.logger, fmt.Sprintf("Failed to decode %s from cache", key), err)
+	}
+
+	// Load is shared by all waiting callers, so it should not be canceled together with the first caller:
+	result, err, _ := loader.group.Do(
+		key,
+		func() (any, error) {
+			loadCtx := context.WithoutCancel(ctx)
+
+			loadedValue, err := load(loadCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			loadedData, err := json.Marshal(loadedValue)
+			if err != nil {
+				return nil, err
+			}
+
+			if err = loader.cache.Set(loadCtx, key, loadedData, ttl); err != nil {
+				logging.LogErrorContext(ctx, loader.logger, fmt.Sprintf("Failed to set %s to cache", key), err)
+			}
+
+			return loadedData, nil
+		},
+	)
+	if err != nil {
+		return value, err
+	}
+
+	// Every caller decodes own copy, so shared value can't be modified by one of them:
+	loadedData, _ := result.([]byte)
+	if err = json.Unmarshal(loadedData, &value); err != nil {
+		return value, err
+	}
+
+	return value, nil
+}
+
+// Invalidate deletes keys from cache. If context contains transaction, keys are deleted once more after commit,
+// because concurrent request could have cached old value before transaction was committed.
+func (loader *Loader) Invalidate(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	loader.invalidate(ctx, keys...)
+	if !transactions.InTransaction(ctx) {
+		return
+	}
+
+	transactions.OnCommit(
+		ctx,
+		func() {
+			loader.invalidate(context.WithoutCancel(ctx), keys...)
+		},
+	)
+}
+
+func (loader *Loader) invalidate(ctx context.Context, keys ...string) {
+	// Callers, which join load started before invalidation, would get old value:
+	for _, key := range keys {
+		loader.group.Forget(key)
+	}
+
+	if err := loader.cache.Delete(ctx, keys...); err != nil {
+		logging.LogErrorContext(ctx, loader.logger, fmt.Sprintf("Failed to delete %v from cache", keys), err)
+	}
+}
@@ -0,0 +1,177 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	mockcache "github.com/DKhorkov/hmtm-toys/mocks/cache"
+)
+
+func TestLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermock.NewMockLogger(ctrl)
+	loader := cache.NewLoader(cache.NewLRU(10), logger)
+	ctx := context.Background()
+
+	var loadsCount int
+
+	load := func(_ context.Context) (*entities.Tag, error) {
+		loadsCount++
+
+		return &entities.Tag{ID: 1, Name: "Tag"}, nil
+	}
+
+	for range 2 {
+		tag, err := cache.Load(ctx, loader, "tags:1", time.Minute, load)
+		require.NoError(t, err)
+		require.Equal(t, &entities.Tag{ID: 1, Name: "Tag"}, tag)
+	}
+
+	require.Equal(t, 1, loadsCount)
+
+	loader.Invalidate(ctx, "tags:1")
+
+	_, err := cache.Load(ctx, loader, "tags:1", time.Minute, load)
+	require.NoError(t, err)
+	require.Equal(t, 2, loadsCount)
+}
+
+func TestLoadErrorIsNotCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermock.NewMockLogger(ctrl)
+	loader := cache.NewLoader(cache.NewLRU(10), logger)
+	ctx := context.Background()
+	loadErr := errors.New("test")
+
+	_, err := cache.Load(
+		ctx,
+		loader,
+		"tags:1",
+		time.Minute,
+		func(_ context.Context) (*entities.Tag, error) {
+			return nil, loadErr
+		},
+	)
+	require.ErrorIs(t, err, loadErr)
+
+	tag, err := cache.Load(
+		ctx,
+		loader,
+		"tags:1",
+		time.Minute,
+		func(_ context.Context) (*entities.Tag, error) {
+			return &entities.Tag{ID: 1}, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &entities.Tag{ID: 1}, tag)
+}
+
+func TestLoadMergesConcurrentMisses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermock.NewMockLogger(ctrl)
+	loader := cache.NewLoader(cache.NewLRU(10), logger)
+	ctx := context.Background()
+
+	const callersCount = 10
+
+	var (
+		loadsCount atomic.Int32
+		started    sync.WaitGroup
+		finished   sync.WaitGroup
+	)
+
+	release := make(chan struct{})
+	load := func(_ context.Context) ([]entities.Tag, error) {
+		loadsCount.Add(1)
+		<-release
+
+		return []entities.Tag{{ID: 1}}, nil
+	}
+
+	started.Add(callersCount)
+	finished.Add(callersCount)
+
+	for range callersCount {
+		go func() {
+			defer finished.Done()
+
+			started.Done()
+
+			tags, err := cache.Load(ctx, loader, "tags:all", time.Minute, load)
+			require.NoError(t, err)
+			require.Equal(t, []entities.Tag{{ID: 1}}, tags)
+		}()
+	}
+
+	// Gives callers time to join in-flight load before it is finished:
+	started.Wait()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	finished.Wait()
+
+	require.Equal(t, int32(1), loadsCount.Load())
+}
+
+func TestLoadWithUnavailableCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermock.NewMockLogger(ctrl)
+	cacheBackend := mockcache.NewMockCache(ctrl)
+	loader := cache.NewLoader(cacheBackend, logger)
+	ctx := context.Background()
+	cacheErr := errors.New("connection refused")
+
+	cacheBackend.
+		EXPECT().
+		Get(gomock.Any(), "tags:1").
+		Return(nil, false, cacheErr).
+		Times(1)
+
+	cacheBackend.
+		EXPECT().
+		Set(gomock.Any(), "tags:1", gomock.Any(), time.Minute).
+		Return(cacheErr).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2)
+
+	tag, err := cache.Load(
+		ctx,
+		loader,
+		"tags:1",
+		time.Minute,
+		func(_ context.Context) (*entities.Tag, error) {
+			return &entities.Tag{ID: 1}, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &entities.Tag{ID: 1}, tag)
+}
+
+func TestInvalidateWithoutKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cacheBackend := mockcache.NewMockCache(ctrl)
+	loader := cache.NewLoader(cacheBackend, loggermock.NewMockLogger(ctrl))
+
+	// Redis fails to delete empty list of keys, so cache is not called:
+	cacheBackend.
+		EXPECT().
+		Delete(gomock.Any()).
+		Times(0)
+
+	loader.Invalidate(context.Background())
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// NewLRU creates in-memory cache, which evicts least recently used entries, when capacity is reached.
+func NewLRU(capacity int) *LRU {
+	return &LRU{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// LRU is cache of single process. Entries, deleted by other instances of service, stay here till TTL expires.
+type LRU struct {
+	capacity int
+	mutex    sync.Mutex
+	entries  map[string]*list.Element
+	order    *list.List // front is the most recently used entry.
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func (cache *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := entryOf(element)
+	if time.Now().After(entry.expiresAt) {
+		cache.remove(element)
+
+		return nil, false, nil
+	}
+
+	cache.order.MoveToFront(element)
+
+	return entry.value, true, nil
+}
+
+func (cache *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.entries[key]; ok {
+		entry := entryOf(element)
+		entry.value = value
+		entry.expiresAt = time.Now().Add(ttl)
+		cache.order.MoveToFront(element)
+
+		return nil
+	}
+
+	cache.entries[key] = cache.order.PushFront(
+		&lruEntry{
+			key:       key,
+			value:     value,
+			expiresAt: time.Now().Add(ttl),
+		},
+	)
+
+	for cache.order.Len() > cache.capacity {
+		cache.remove(cache.order.Back())
+	}
+
+	return nil
+}
+
+func (cache *LRU) Delete(_ context.Context, keys ...string) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	for _, key := range keys {
+		if element, ok := cache.entries[key]; ok {
+			cache.remove(element)
+		}
+	}
+
+	return nil
+}
+
+func (cache *LRU) remove(element *list.Element) {
+	cache.order.Remove(element)
+	delete(cache.entries, entryOf(element).key)
+}
+
+func entryOf(element *list.Element) *lruEntry {
+	entry, _ := element.Value.(*lruEntry) // only *lruEntry values are pushed to list.
+
+	return entry
+}
@@ -0,0 +1,76 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+)
+
+func TestLRU_GetAndSet(t *testing.T) {
+	lru := cache.NewLRU(2)
+	ctx := context.Background()
+
+	_, found, err := lru.Get(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, lru.Set(ctx, "key", []byte("old"), time.Minute))
+	require.NoError(t, lru.Set(ctx, "key", []byte("new"), time.Minute))
+
+	value, found, err := lru.Get(ctx, "key")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("new"), value)
+}
+
+func TestLRU_Expiration(t *testing.T) {
+	lru := cache.NewLRU(2)
+	ctx := context.Background()
+
+	require.NoError(t, lru.Set(ctx, "expired", []byte("value"), -time.Second))
+
+	_, found, err := lru.Get(ctx, "expired")
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestLRU_Eviction(t *testing.T) {
+	lru := cache.NewLRU(2)
+	ctx := context.Background()
+
+	require.NoError(t, lru.Set(ctx, "first", []byte("1"), time.Minute))
+	require.NoError(t, lru.Set(ctx, "second", []byte("2"), time.Minute))
+
+	// "first" becomes the most recently used, so "second" is evicted:
+	_, found, err := lru.Get(ctx, "first")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.NoError(t, lru.Set(ctx, "third", []byte("3"), time.Minute))
+
+	_, found, err = lru.Get(ctx, "second")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	for _, key := range []string{"first", "third"} {
+		_, found, err = lru.Get(ctx, key)
+		require.NoError(t, err)
+		require.True(t, found, key)
+	}
+}
+
+func TestLRU_Delete(t *testing.T) {
+	lru := cache.NewLRU(2)
+	ctx := context.Background()
+
+	require.NoError(t, lru.Set(ctx, "key", []byte("value"), time.Minute))
+	require.NoError(t, lru.Delete(ctx, "key", "missing"))
+
+	_, found, err := lru.Get(ctx, "key")
+	require.NoError(t, err)
+	require.False(t, found)
+}
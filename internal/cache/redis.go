@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewRedis creates cache, which is shared by all instances of service. Keys are prefixed with keyPrefix,
+// so several services can use the same Redis database.
+func NewRedis(client redis.UniversalClient, keyPrefix string) *Redis {
+	return &Redis{
+		client:    client,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// Redis works with any server, compatible with Redis protocol, such as Redis, KeyDB or Valkey.
+type Redis struct {
+	client    redis.UniversalClient
+	keyPrefix string
+}
+
+func (cache *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := cache.client.Get(ctx, cache.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (cache *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return cache.client.Set(ctx, cache.keyPrefix+key, value, ttl).Err()
+}
+
+func (cache *Redis) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixedKeys := make([]string, len(keys))
+	for i, key := range keys {
+		prefixedKeys[i] = cache.keyPrefix + key
+	}
+
+	return cache.client.Del(ctx, prefixedKeys...).Err()
+}
@@ -0,0 +1,193 @@
+package circuitbreaker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type State int
+
+const (
+	StateClosed   State = iota // calls are passed to server.
+	StateOpen                  // calls fail fast without reaching server.
+	StateHalfOpen              // limited count of trial calls is passed to check, if server recovered.
+)
+
+func (state State) String() string {
+	switch state {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(state))
+	}
+}
+
+type Config struct {
+	FailureThreshold int           // count of consecutive failures, which opens circuit.
+	OpenTimeout      time.Duration // how long circuit stays open before trial calls.
+	HalfOpenMaxCalls int           // max count of concurrent trial calls.
+}
+
+// New creates CircuitBreaker in closed state.
+func New(name string, breakerConfig Config, logger logging.Logger) *CircuitBreaker {
+	return &CircuitBreaker{
+		name:   name,
+		config: breakerConfig,
+		logger: logger,
+	}
+}
+
+// CircuitBreaker stops calls to unhealthy server, so clients get error immediately instead of waiting
+// for timeouts, and server gets time to recover. Only errors, which signal server unhealthiness,
+// are considered as failures. Business errors, such as NotFound, are considered as successful calls.
+type CircuitBreaker struct {
+	name          string
+	config        Config
+	logger        logging.Logger
+	mutex         sync.Mutex
+	state         State
+	failures      int // consecutive failures in closed state.
+	openedAt      time.Time
+	halfOpenCalls int // trial calls in progress.
+}
+
+// State returns current state of circuit.
+func (breaker *CircuitBreaker) State() State {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	breaker.refreshState()
+
+	return breaker.state
+}
+
+// UnaryClientInterceptor fails calls with codes.Unavailable, while circuit is open. Calls, interrupted by
+// deadline or cancellation of caller's context, are counted neither as failures nor as successes.
+func (breaker *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if err := breaker.allow(); err != nil {
+			return err
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil && ctx.Err() != nil {
+			// Call was interrupted by caller's own deadline or cancellation, which says nothing about server:
+			breaker.release()
+
+			return err
+		}
+
+		breaker.record(!isFailure(err))
+
+		return err
+	}
+}
+
+func (breaker *CircuitBreaker) allow() error {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	breaker.refreshState()
+
+	switch breaker.state {
+	case StateOpen:
+		return status.Errorf(codes.Unavailable, "circuit breaker of %s is open", breaker.name)
+	case StateHalfOpen:
+		if breaker.halfOpenCalls >= breaker.config.HalfOpenMaxCalls {
+			return status.Errorf(codes.Unavailable, "circuit breaker of %s is half-open", breaker.name)
+		}
+
+		breaker.halfOpenCalls++
+	case StateClosed:
+	}
+
+	return nil
+}
+
+func (breaker *CircuitBreaker) record(success bool) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	switch breaker.state {
+	case StateHalfOpen:
+		// The first finished trial call decides, if server recovered:
+		if success {
+			breaker.setState(StateClosed)
+		} else {
+			breaker.setState(StateOpen)
+		}
+	case StateClosed:
+		if success {
+			breaker.failures = 0
+
+			return
+		}
+
+		breaker.failures++
+		if breaker.failures >= breaker.config.FailureThreshold {
+			breaker.setState(StateOpen)
+		}
+	case StateOpen: // call was started before circuit was opened.
+	}
+}
+
+// release frees slot of trial call, which finished without signaling server health.
+func (breaker *CircuitBreaker) release() {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	if breaker.state == StateHalfOpen && breaker.halfOpenCalls > 0 {
+		breaker.halfOpenCalls--
+	}
+}
+
+// refreshState moves open circuit to half-open state after timeout. Should be called under mutex.
+func (breaker *CircuitBreaker) refreshState() {
+	if breaker.state == StateOpen && time.Since(breaker.openedAt) >= breaker.config.OpenTimeout {
+		breaker.setState(StateHalfOpen)
+	}
+}
+
+// setState should be called under mutex.
+func (breaker *CircuitBreaker) setState(state State) {
+	logging.LogInfo(
+		breaker.logger,
+		fmt.Sprintf("Circuit breaker of %s changed state from %s to %s", breaker.name, breaker.state, state),
+	)
+
+	breaker.state = state
+	breaker.failures = 0
+	breaker.halfOpenCalls = 0
+
+	if state == StateOpen {
+		breaker.openedAt = time.Now()
+	}
+}
+
+// isFailure checks, if error signals, that server is unhealthy or unreachable.
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
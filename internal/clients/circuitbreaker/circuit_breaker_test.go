@@ -0,0 +1,141 @@
+package circuitbreaker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/clients/circuitbreaker"
+)
+
+// call calls interceptor with invoker, which returns err, and reports, if invoker was reached.
+func call(t *testing.T, breaker *circuitbreaker.CircuitBreaker, err error) (bool, error) {
+	t.Helper()
+
+	var invoked bool
+
+	callErr := breaker.UnaryClientInterceptor()(
+		context.Background(),
+		"/sso.UsersService/GetUser",
+		nil,
+		nil,
+		nil,
+		func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			invoked = true
+
+			return err
+		},
+	)
+
+	return invoked, callErr
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermock.NewMockLogger(ctrl)
+	logger.
+		EXPECT().
+		Info(gomock.Any()).
+		AnyTimes()
+
+	breaker := circuitbreaker.New(
+		"test",
+		circuitbreaker.Config{
+			FailureThreshold: 2,
+			OpenTimeout:      50 * time.Millisecond,
+			HalfOpenMaxCalls: 1,
+		},
+		logger,
+	)
+
+	unavailableErr := status.Error(codes.Unavailable, "unavailable")
+
+	// Business errors and interrupted series of failures do not open circuit:
+	for _, err := range []error{unavailableErr, status.Error(codes.NotFound, "not found"), unavailableErr, nil} {
+		invoked, _ := call(t, breaker, err)
+		require.True(t, invoked)
+		require.Equal(t, circuitbreaker.StateClosed, breaker.State())
+	}
+
+	for range 2 {
+		invoked, _ := call(t, breaker, unavailableErr)
+		require.True(t, invoked)
+	}
+
+	require.Equal(t, circuitbreaker.StateOpen, breaker.State())
+
+	invoked, err := call(t, breaker, nil)
+	require.False(t, invoked)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	time.Sleep(60 * time.Millisecond)
+	require.Equal(t, circuitbreaker.StateHalfOpen, breaker.State())
+
+	// Failed trial call opens circuit again:
+	invoked, _ = call(t, breaker, status.Error(codes.DeadlineExceeded, "timeout"))
+	require.True(t, invoked)
+	require.Equal(t, circuitbreaker.StateOpen, breaker.State())
+
+	time.Sleep(60 * time.Millisecond)
+
+	// Successful trial call closes circuit:
+	invoked, err = call(t, breaker, nil)
+	require.True(t, invoked)
+	require.NoError(t, err)
+	require.Equal(t, circuitbreaker.StateClosed, breaker.State())
+}
+
+func TestCircuitBreakerWithCallerDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := loggermock.NewMockLogger(ctrl)
+	logger.
+		EXPECT().
+		Info(gomock.Any()).
+		AnyTimes()
+
+	breaker := circuitbreaker.New(
+		"test",
+		circuitbreaker.Config{
+			FailureThreshold: 1,
+			OpenTimeout:      time.Minute,
+			HalfOpenMaxCalls: 1,
+		},
+		logger,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := breaker.UnaryClientInterceptor()(
+		ctx,
+		"/sso.UsersService/GetUser",
+		nil,
+		nil,
+		nil,
+		func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			return status.Error(codes.DeadlineExceeded, "timeout")
+		},
+	)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.Equal(t, circuitbreaker.StateClosed, breaker.State())
+
+	// Same failure under alive context of caller is caused by server:
+	invoked, _ := call(t, breaker, status.Error(codes.DeadlineExceeded, "timeout"))
+	require.True(t, invoked)
+	require.Equal(t, circuitbreaker.StateOpen, breaker.State())
+}
+
+func TestState_String(t *testing.T) {
+	require.Equal(t, "closed", circuitbreaker.StateClosed.String())
+	require.Equal(t, "open", circuitbreaker.StateOpen.String())
+	require.Equal(t, "half-open", circuitbreaker.StateHalfOpen.String())
+	require.Equal(t, "unknown(10)", circuitbreaker.State(10).String())
+}
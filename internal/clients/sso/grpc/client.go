@@ -1,7 +1,12 @@
 package ssogrpcclient
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/DKhorkov/hmtm-sso/api/protobuf/generated/go/sso"
@@ -9,32 +14,41 @@ import (
 	"github.com/DKhorkov/libs/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	customgrpc "github.com/DKhorkov/libs/grpc/interceptors"
 	grpclogging "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+
+	"github.com/DKhorkov/hmtm-toys/internal/clients/circuitbreaker"
+	"github.com/DKhorkov/hmtm-toys/internal/config"
 )
 
 type Client struct {
 	sso.AuthServiceClient
 	sso.UsersServiceClient
+
+	circuitBreaker *circuitbreaker.CircuitBreaker
+}
+
+// CircuitBreakerState returns state of circuit breaker, which protects SSO calls.
+func (client *Client) CircuitBreakerState() circuitbreaker.State {
+	return client.circuitBreaker.State()
 }
 
 func New(
-	host string,
-	port int,
-	retriesCount int,
-	retriesTimeout time.Duration,
+	clientConfig config.ClientConfig,
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
 ) (*Client, error) {
-	// Options for interceptors (перехватчики / middlewares) for retries purposes:
+	// Options for interceptors (перехватчики / middlewares) for retries purposes.
+	// NotFound is a valid answer, so it is not retried:
 	retryOptions := []grpcretry.CallOption{
-		grpcretry.WithCodes(codes.NotFound, codes.Aborted, codes.DeadlineExceeded),
-		grpcretry.WithMax(uint(retriesCount)),
-		grpcretry.WithPerRetryTimeout(retriesTimeout),
+		grpcretry.WithCodes(codes.Unavailable, codes.Aborted, codes.DeadlineExceeded),
+		grpcretry.WithMax(uint(clientConfig.RetriesCount)),
+		grpcretry.WithPerRetryTimeout(clientConfig.RetryTimeout),
 	}
 
 	// Options for interceptors for logging purposes:
@@ -45,18 +59,42 @@ func New(
 		),
 	}
 
+	transportCredentials, err := newTransportCredentials(clientConfig.TLS)
+	if err != nil {
+		logging.LogError(
+			logger,
+			"Failed to load SSO gRPC client TLS credentials",
+			err,
+		)
+
+		return nil, err
+	}
+
+	// Changes of circuit state are logged by breaker itself:
+	circuitBreaker := circuitbreaker.New(
+		"SSO gRPC client",
+		circuitbreaker.Config{
+			FailureThreshold: clientConfig.CircuitBreaker.FailureThreshold,
+			OpenTimeout:      clientConfig.CircuitBreaker.OpenTimeout,
+			HalfOpenMaxCalls: clientConfig.CircuitBreaker.HalfOpenMaxCalls,
+		},
+		logger,
+	)
+
 	// Create connection with SSO gRPC-server for client:
 	clientConnection, err := grpc.NewClient(
-		fmt.Sprintf("%s:%d", host, port),
-		grpc.WithTransportCredentials(
-			insecure.NewCredentials(),
-		),
+		fmt.Sprintf("%s:%d", clientConfig.Host, clientConfig.Port),
+		grpc.WithTransportCredentials(transportCredentials),
 		grpc.WithChainUnaryInterceptor( // Middlewares. Using chain not to overwrite interceptors.
 			customgrpc.UnaryClientTracingInterceptor(traceProvider, spanConfig),
 			grpclogging.UnaryClientInterceptor(
 				customgrpc.UnaryClientLoggingInterceptor(logger),
 				logOptions...,
 			),
+			// Breaker sees context of caller, so it can tell caller's deadline from SSO timeout.
+			// Call with all its retries is a single attempt for breaker:
+			circuitBreaker.UnaryClientInterceptor(),
+			timeoutInterceptor(clientConfig.Timeout),
 			grpcretry.UnaryClientInterceptor(retryOptions...),
 		),
 	)
@@ -73,5 +111,61 @@ func New(
 	return &Client{
 		AuthServiceClient:  sso.NewAuthServiceClient(clientConnection),
 		UsersServiceClient: sso.NewUsersServiceClient(clientConnection),
+		circuitBreaker:     circuitBreaker,
 	}, nil
 }
+
+// timeoutInterceptor sets deadline for call, unless context already has an earlier one.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func newTransportCredentials(tlsConfig config.TLSConfig) (credentials.TransportCredentials, error) {
+	if !tlsConfig.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	clientTLSConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: tlsConfig.ServerName,
+	}
+
+	if tlsConfig.CAFile != "" {
+		caCertificate, err := os.ReadFile(tlsConfig.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		clientTLSConfig.RootCAs = x509.NewCertPool()
+		if !clientTLSConfig.RootCAs.AppendCertsFromPEM(caCertificate) {
+			return nil, errors.New("failed to parse CA certificate from " + tlsConfig.CAFile)
+		}
+	}
+
+	if tlsConfig.CertFile != "" || tlsConfig.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		clientTLSConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return credentials.NewTLS(clientTLSConfig), nil
+}
@@ -0,0 +1,148 @@
+package ssogrpcclient
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/tracing"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/clients/circuitbreaker"
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+)
+
+func TestTimeoutInterceptor(t *testing.T) {
+	testCases := []struct {
+		name             string
+		timeout          time.Duration
+		parentTimeout    time.Duration
+		deadlineExpected bool
+		maxDeadline      time.Duration
+	}{
+		{
+			name:             "without timeout",
+			deadlineExpected: false,
+		},
+		{
+			name:             "with timeout",
+			timeout:          time.Second,
+			deadlineExpected: true,
+			maxDeadline:      time.Second,
+		},
+		{
+			name:             "earlier deadline of context is kept",
+			timeout:          time.Minute,
+			parentTimeout:    time.Second,
+			deadlineExpected: true,
+			maxDeadline:      time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.parentTimeout > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, tc.parentTimeout)
+				defer cancel()
+			}
+
+			err := timeoutInterceptor(tc.timeout)(
+				ctx,
+				"/sso.UsersService/GetUser",
+				nil,
+				nil,
+				nil,
+				func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+					deadline, ok := ctx.Deadline()
+					require.Equal(t, tc.deadlineExpected, ok)
+
+					if ok {
+						require.LessOrEqual(t, time.Until(deadline), tc.maxDeadline)
+					}
+
+					return nil
+				},
+			)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewTransportCredentials(t *testing.T) {
+	invalidCAFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(invalidCAFile, []byte("invalid"), 0o600))
+
+	testCases := []struct {
+		name             string
+		tlsConfig        config.TLSConfig
+		expectedProtocol string
+		errorExpected    bool
+	}{
+		{
+			name:             "TLS disabled",
+			expectedProtocol: "insecure",
+		},
+		{
+			name:             "TLS with system certificates",
+			tlsConfig:        config.TLSConfig{Enabled: true},
+			expectedProtocol: "tls",
+		},
+		{
+			name:          "missing CA file",
+			tlsConfig:     config.TLSConfig{Enabled: true, CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+			errorExpected: true,
+		},
+		{
+			name:          "invalid CA file",
+			tlsConfig:     config.TLSConfig{Enabled: true, CAFile: invalidCAFile},
+			errorExpected: true,
+		},
+		{
+			name:          "mutual TLS without key",
+			tlsConfig:     config.TLSConfig{Enabled: true, CertFile: invalidCAFile},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transportCredentials, err := newTransportCredentials(tc.tlsConfig)
+			if tc.errorExpected {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedProtocol, transportCredentials.Info().SecurityProtocol)
+		})
+	}
+}
+
+func TestClient_CircuitBreakerState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client, err := New(
+		config.ClientConfig{
+			Host:           "localhost",
+			Port:           8070,
+			CircuitBreaker: config.CircuitBreakerConfig{FailureThreshold: 1, HalfOpenMaxCalls: 1},
+		},
+		mocklogger.NewMockLogger(ctrl),
+		mocktracing.NewMockProvider(ctrl),
+		tracing.SpanConfig{},
+	)
+	require.NoError(t, err)
+
+	// Connection is established lazily, so new client has closed circuit without calls to SSO:
+	require.Equal(t, circuitbreaker.StateClosed, client.CircuitBreakerState())
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/DKhorkov/libs/db"
@@ -30,7 +31,9 @@ func New() Config {
 			Driver:       loadenv.GetEnv("POSTGRES_DRIVER", "postgres"),
 			Pool: db.PoolConfig{
 				MaxIdleConnections: loadenv.GetEnvAsInt("MAX_IDLE_CONNECTIONS", 1),
-				MaxOpenConnections: loadenv.GetEnvAsInt("MAX_OPEN_CONNECTIONS", 1),
+				// Export of Toys holds one connection, while reading snapshot, so more than one connection
+				// is required to serve other requests during export. Smaller pools are rejected at startup:
+				MaxOpenConnections: loadenv.GetEnvAsInt("MAX_OPEN_CONNECTIONS", 10),
 				MaxConnectionLifetime: time.Second * time.Duration(
 					loadenv.GetEnvAsInt("MAX_CONNECTION_LIFETIME", 20),
 				),
@@ -39,6 +42,65 @@ func New() Config {
 				),
 			},
 		},
+		Replicas: ReplicasConfig{
+			Hosts: loadenv.GetEnvAsSlice("POSTGRES_REPLICAS_HOSTS", []string{}, ";"),
+			Pool: db.PoolConfig{
+				MaxIdleConnections: loadenv.GetEnvAsInt("REPLICAS_MAX_IDLE_CONNECTIONS", 1),
+				MaxOpenConnections: loadenv.GetEnvAsInt("REPLICAS_MAX_OPEN_CONNECTIONS", 5),
+				MaxConnectionLifetime: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("REPLICAS_MAX_CONNECTION_LIFETIME", 20),
+				),
+				MaxConnectionIdleTime: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("REPLICAS_MAX_CONNECTION_IDLE_TIME", 10),
+				),
+			},
+			MaxLag: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("REPLICAS_MAX_LAG", 5),
+			),
+			LagCheckInterval: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("REPLICAS_LAG_CHECK_INTERVAL", 5),
+			),
+			LagQuery: loadenv.GetEnv(
+				"REPLICAS_LAG_QUERY",
+				"SELECT COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)",
+			),
+		},
+		Transactions: TransactionsConfig{
+			IsolationLevel: loadenv.GetEnv("TRANSACTIONS_ISOLATION_LEVEL", "Read Committed"),
+		},
+		Cache: CacheConfig{
+			Backend:     loadenv.GetEnv("CACHE_BACKEND", CacheBackendLRU),
+			LRUCapacity: loadenv.GetEnvAsInt("CACHE_LRU_CAPACITY", 10000),
+			Redis: RedisConfig{
+				Address:   loadenv.GetEnv("CACHE_REDIS_ADDRESS", "0.0.0.0:6379"),
+				Password:  loadenv.GetEnv("CACHE_REDIS_PASSWORD", ""),
+				DB:        loadenv.GetEnvAsInt("CACHE_REDIS_DB", 0),
+				KeyPrefix: loadenv.GetEnv("CACHE_REDIS_KEY_PREFIX", "hmtm-toys:v1:"),
+			},
+			TTLs: CacheTTLsConfig{
+				Categories: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("CACHE_CATEGORIES_TTL", 300),
+				),
+				Tags: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("CACHE_TAGS_TTL", 300),
+				),
+				Toys: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("CACHE_TOYS_TTL", 30),
+				),
+			},
+			Users: UsersCacheConfig{
+				TTL: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("CACHE_USERS_TTL", 60),
+				),
+				NegativeTTL: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("CACHE_MISSING_USERS_TTL", 10),
+				),
+				StaleTTL: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("CACHE_STALE_USERS_TTL", 3600),
+				),
+				SsoFailurePolicy: loadenv.GetEnv("SSO_FAILURE_POLICY", SsoFailurePolicyFailClosed),
+			},
+		},
 		Clients: ClientsConfig{
 			SSO: ClientConfig{
 				Host:         loadenv.GetEnv("SSO_CLIENT_HOST", "0.0.0.0"),
@@ -47,6 +109,30 @@ func New() Config {
 				RetryTimeout: time.Second * time.Duration(
 					loadenv.GetEnvAsInt("SSO_RETRIES_TIMEOUT", 1),
 				),
+				Timeout: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("SSO_TIMEOUT", 3),
+				),
+				CircuitBreaker: CircuitBreakerConfig{
+					FailureThreshold: loadenv.GetEnvAsInt("SSO_CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5),
+					OpenTimeout: time.Second * time.Duration(
+						loadenv.GetEnvAsInt("SSO_CIRCUIT_BREAKER_OPEN_TIMEOUT", 10),
+					),
+					HalfOpenMaxCalls: loadenv.GetEnvAsInt("SSO_CIRCUIT_BREAKER_HALF_OPEN_MAX_CALLS", 1),
+				},
+				TLS: TLSConfig{
+					Enabled:    loadenv.GetEnv("SSO_TLS_ENABLED", "false") == "true",
+					CAFile:     loadenv.GetEnv("SSO_TLS_CA_FILE", ""),
+					CertFile:   loadenv.GetEnv("SSO_TLS_CERT_FILE", ""),
+					KeyFile:    loadenv.GetEnv("SSO_TLS_KEY_FILE", ""),
+					ServerName: loadenv.GetEnv("SSO_TLS_SERVER_NAME", ""),
+				},
+			},
+			UsersLoader: UsersLoaderConfig{
+				Wait: time.Millisecond * time.Duration(
+					loadenv.GetEnvAsInt("SSO_USERS_LOADER_WAIT", 2),
+				),
+				MaxBatchSize:   loadenv.GetEnvAsInt("SSO_USERS_LOADER_MAX_BATCH_SIZE", 100),
+				MaxConcurrency: loadenv.GetEnvAsInt("SSO_USERS_LOADER_MAX_CONCURRENCY", 10),
 			},
 		},
 		Logging: logging.Config{
@@ -232,6 +318,74 @@ func New() Config {
 							},
 						},
 					},
+					Reviews: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
+					ForbiddenWords: tracing.SpanConfig{
+						Opts: []trace.SpanStartOption{
+							trace.WithAttributes(
+								attribute.String(
+									"Environment",
+									loadenv.GetEnv("ENVIRONMENT", "local"),
+								),
+							),
+						},
+						Events: tracing.SpanEventsConfig{
+							Start: tracing.SpanEventConfig{
+								Name: "Calling database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+							End: tracing.SpanEventConfig{
+								Name: "Received response from database",
+								Opts: []trace.EventOption{
+									trace.WithAttributes(
+										attribute.String(
+											"Environment",
+											loadenv.GetEnv("ENVIRONMENT", "local"),
+										),
+									),
+								},
+							},
+						},
+					},
 				},
 				Clients: SpanClients{
 					SSO: tracing.SpanConfig{
@@ -271,6 +425,27 @@ func New() Config {
 				},
 			},
 		},
+		Jobs: JobsConfig{
+			Vacations: JobConfig{
+				Interval: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("VACATIONS_JOB_INTERVAL", 60),
+				),
+			},
+			ForbiddenWords: JobConfig{
+				Interval: time.Second * time.Duration(
+					loadenv.GetEnvAsInt("FORBIDDEN_WORDS_RELOAD_INTERVAL", 60),
+				),
+			},
+		},
+		UserEvents: UserEventsConfig{
+			Consumer: loadenv.GetEnv("USER_EVENTS_CONSUMER", UserEventsConsumerMemory),
+			FilePath: loadenv.GetEnv("USER_EVENTS_FILE_PATH", "user_events.jsonl"),
+			PollInterval: time.Second * time.Duration(
+				loadenv.GetEnvAsInt("USER_EVENTS_POLL_INTERVAL", 5),
+			),
+			DeletedUserToysPolicy:   loadenv.GetEnv("DELETED_USER_TOYS_POLICY", DeletedUserToysPolicyArchive),
+			ReconciliationBatchSize: uint64(loadenv.GetEnvAsInt("RECONCILIATION_BATCH_SIZE", 100)),
+		},
 		Validation: ValidationConfig{
 			Master: MasterValidationConfig{
 				Info: loadenv.GetEnvAsSlice(
@@ -281,6 +456,62 @@ func New() Config {
 					},
 					";",
 				),
+				ShopName: loadenv.GetEnvAsSlice(
+					"MASTER_SHOP_NAME_REGEXP",
+					[]string{
+						`^.{2,100}$`,                    // длина 2-100 символов
+						`^[А-Яа-яЁёA-Za-z0-9\s.,!"-]+$`, // только буквы, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
+				Slug: loadenv.GetEnvAsSlice(
+					"MASTER_SLUG_REGEXP",
+					[]string{
+						`^.{3,50}$`,                // длина 3-50 символов
+						`^[a-z0-9]+(-[a-z0-9]+)*$`, // только латиница в нижнем регистре, цифры и одиночные дефисы
+					},
+					";",
+				),
+				Link: loadenv.GetEnvAsSlice(
+					"MASTER_LINK_REGEXP",
+					[]string{
+						`^.{10,1000}$`,   // длина 10-1000 символов
+						`^https?://\S+$`, // только http(s) ссылки без пробелов
+					},
+					";",
+				),
+				Location: loadenv.GetEnvAsSlice(
+					"MASTER_LOCATION_REGEXP",
+					[]string{
+						`^.{2,100}$`,       // длина 2-100 символов
+						`^[А-Яа-яЁё\s-]+$`, // только кириллица, пробелы и дефисы
+					},
+					";",
+				),
+				Policies: loadenv.GetEnvAsSlice(
+					"MASTER_POLICIES_REGEXP",
+					[]string{
+						`^.{10,1000}$`,                // длина 10-1000 символов
+						`^[А-Яа-яЁё0-9\s.,!?:()"-]+$`, // только кириллица, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
+				VacationMessage: loadenv.GetEnvAsSlice(
+					"MASTER_VACATION_MESSAGE_REGEXP",
+					[]string{
+						`^.{2,500}$`,                  // длина 2-500 символов
+						`^[А-Яа-яЁё0-9\s.,!?:()"-]+$`, // только кириллица, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
+				ModerationReason: loadenv.GetEnvAsSlice(
+					"MASTER_MODERATION_REASON_REGEXP",
+					[]string{
+						`^.{5,1000}$`,                 // длина 5-1000 символов
+						`^[А-Яа-яЁё0-9\s.,!?:()"-]+$`, // только кириллица, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
 			},
 			Toy: ToyValidationConfig{
 				Name: loadenv.GetEnvAsSlice(
@@ -299,6 +530,60 @@ func New() Config {
 					},
 					";",
 				),
+				ModerationReason: loadenv.GetEnvAsSlice(
+					"TOY_MODERATION_REASON_REGEXP",
+					[]string{
+						`^.{5,1000}$`,                 // длина 5-1000 символов
+						`^[А-Яа-яЁё0-9\s.,!?:()"-]+$`, // только кириллица, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
+				Appeal: loadenv.GetEnvAsSlice(
+					"TOY_APPEAL_REGEXP",
+					[]string{
+						`^.{5,1000}$`,                 // длина 5-1000 символов
+						`^[А-Яа-яЁё0-9\s.,!?:()"-]+$`, // только кириллица, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
+				Moderation: ToyModerationConfig{
+					SuspiciousWords: loadenv.GetEnvAsSlice(
+						"TOY_SUSPICIOUS_WORDS",
+						[]string{
+							"предоплата",
+							"перевод на карту",
+							"оптом",
+							"реплика",
+							"копия",
+							"подделка",
+						},
+						";",
+					),
+					ExternalLinks: mustCompileRegexps(
+						"TOY_EXTERNAL_LINKS_REGEXP",
+						loadenv.GetEnvAsSlice(
+							"TOY_EXTERNAL_LINKS_REGEXP",
+							[]string{
+								`(?i)https?://`, // ссылки с протоколом
+								`(?i)\bwww\.`,   // ссылки без протокола
+								`(?i)\b[a-z0-9-]+\.(ru|com|net|org|su)\b`, // доменные имена
+							},
+							";",
+						),
+					),
+					PriceOutlierRatio:   loadenv.GetEnvAsInt("TOY_PRICE_OUTLIER_RATIO", 5),
+					PriceOutlierMinToys: loadenv.GetEnvAsInt("TOY_PRICE_OUTLIER_MIN_TOYS", 10),
+				},
+			},
+			Review: ReviewValidationConfig{
+				Text: loadenv.GetEnvAsSlice(
+					"REVIEW_TEXT_REGEXP",
+					[]string{
+						`^.{2,1000}$`,                 // длина 2-1000 символов
+						`^[А-Яа-яЁё0-9\s.,!?:()"-]+$`, // только кириллица, цифры, пробелы и знаки препинания
+					},
+					";",
+				),
 			},
 			Tag: TagValidationConfig{
 				Name: loadenv.GetEnvAsSlice(
@@ -310,6 +595,10 @@ func New() Config {
 					";",
 				),
 			},
+			ForbiddenWords: ForbiddenWordsConfig{
+				Source:   loadenv.GetEnv("FORBIDDEN_WORDS_SOURCE", ForbiddenWordsSourceDatabase),
+				FilePath: loadenv.GetEnv("FORBIDDEN_WORDS_FILE_PATH", "forbidden_words.txt"),
+			},
 		},
 	}
 }
@@ -335,49 +624,225 @@ type SpanClients struct {
 }
 
 type SpanRepositories struct {
-	Categories tracing.SpanConfig
-	Tags       tracing.SpanConfig
-	Masters    tracing.SpanConfig
-	Toys       tracing.SpanConfig
+	Categories     tracing.SpanConfig
+	Tags           tracing.SpanConfig
+	Masters        tracing.SpanConfig
+	Toys           tracing.SpanConfig
+	Reviews        tracing.SpanConfig
+	ForbiddenWords tracing.SpanConfig
+}
+
+// MinMaxOpenConnections is a smallest allowed size of primary Database pool. Export of Toys holds one connection,
+// while reading snapshot, so at least one more connection is required for other requests.
+const MinMaxOpenConnections = 2
+
+// ReplicasConfig describes read replicas of Database. Replicas use the same credentials, database name
+// and driver as Database, but have their own connections pool.
+type ReplicasConfig struct {
+	Hosts            []string // "host:port" of each replica.
+	Pool             db.PoolConfig
+	MaxLag           time.Duration // replica with greater lag is not used till it catches up.
+	LagCheckInterval time.Duration
+	LagQuery         string // returns replication lag in seconds.
+}
+
+type TransactionsConfig struct {
+	IsolationLevel string // name of sql.IsolationLevel, such as "Read Committed" or "Serializable".
+}
+
+// Backends of cache.
+const (
+	CacheBackendLRU   = "lru"   // in-memory cache of each instance.
+	CacheBackendRedis = "redis" // cache, shared by all instances.
+)
+
+type CacheConfig struct {
+	Backend     string
+	LRUCapacity int // max count of cached entries.
+	Redis       RedisConfig
+	TTLs        CacheTTLsConfig
+	Users       UsersCacheConfig
+}
+
+type RedisConfig struct {
+	Address   string
+	Password  string
+	DB        int
+	KeyPrefix string // should be changed, when format of cached entities changes.
+}
+
+// CacheTTLsConfig contains TTL of cached entities. Zero TTL disables caching of entity.
+type CacheTTLsConfig struct {
+	Categories time.Duration
+	Tags       time.Duration
+	Toys       time.Duration
+}
+
+// Policies of serving cached Users, when SSO fails.
+const (
+	SsoFailurePolicyFailClosed = "fail_closed" // SSO error is returned.
+	SsoFailurePolicyServeStale = "serve_stale" // User with expired TTL is returned, if it is still cached.
+)
+
+// UsersCacheConfig describes caching of Users from SSO. Zero TTL disables caching of Users.
+type UsersCacheConfig struct {
+	TTL              time.Duration
+	NegativeTTL      time.Duration // TTL of missing Users.
+	StaleTTL         time.Duration // how long expired User can be served, when SSO fails.
+	SsoFailurePolicy string
 }
 
 type ClientsConfig struct {
-	SSO ClientConfig
+	SSO         ClientConfig
+	UsersLoader UsersLoaderConfig
+}
+
+// UsersLoaderConfig describes batching of Users lookups, made while handling single request.
+type UsersLoaderConfig struct {
+	Wait           time.Duration // how long to collect IDs before loading batch.
+	MaxBatchSize   int           // batch is loaded immediately, when it reaches this size.
+	MaxConcurrency int           // max count of concurrent SSO calls per batch.
 }
 
 type ClientConfig struct {
-	Host         string
-	Port         int
-	RetryTimeout time.Duration
-	RetriesCount int
+	Host           string
+	Port           int
+	RetryTimeout   time.Duration // deadline of each attempt.
+	RetriesCount   int
+	Timeout        time.Duration // deadline of call with all its retries.
+	CircuitBreaker CircuitBreakerConfig
+	TLS            TLSConfig
+}
+
+// CircuitBreakerConfig describes, when client stops calling unhealthy server.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // count of consecutive failures, which opens circuit.
+	OpenTimeout      time.Duration // how long circuit stays open before trial calls.
+	HalfOpenMaxCalls int           // max count of concurrent trial calls.
+}
+
+// TLSConfig describes client credentials. Server certificate is verified with CAFile or with system
+// certificates, if CAFile is not provided. CertFile and KeyFile are provided for mutual TLS.
+type TLSConfig struct {
+	Enabled    bool
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string // overrides name, which is used to verify server certificate.
 }
 
 type ValidationConfig struct {
-	Master MasterValidationConfig
-	Toy    ToyValidationConfig
-	Tag    TagValidationConfig
+	Master         MasterValidationConfig
+	Toy            ToyValidationConfig
+	Tag            TagValidationConfig
+	Review         ReviewValidationConfig
+	ForbiddenWords ForbiddenWordsConfig
+}
+
+// Sources of forbidden words dictionary.
+const (
+	ForbiddenWordsSourceDatabase = "database"
+	ForbiddenWordsSourceFile     = "file"
+)
+
+type ForbiddenWordsConfig struct {
+	Source   string // ForbiddenWordsSourceDatabase or ForbiddenWordsSourceFile.
+	FilePath string // file with one word per line, used only for ForbiddenWordsSourceFile.
 }
 
 type MasterValidationConfig struct {
-	Info []string // since Go's regex doesn't support backtracking.
+	Info             []string // since Go's regex doesn't support backtracking.
+	ShopName         []string // since Go's regex doesn't support backtracking.
+	Slug             []string // since Go's regex doesn't support backtracking.
+	Link             []string // since Go's regex doesn't support backtracking.
+	Location         []string // since Go's regex doesn't support backtracking.
+	Policies         []string // since Go's regex doesn't support backtracking.
+	VacationMessage  []string // since Go's regex doesn't support backtracking.
+	ModerationReason []string // since Go's regex doesn't support backtracking.
 }
 
 type ToyValidationConfig struct {
-	Name        []string // since Go's regex doesn't support backtracking.
-	Description []string // since Go's regex doesn't support backtracking.
+	Name             []string // since Go's regex doesn't support backtracking.
+	Description      []string // since Go's regex doesn't support backtracking.
+	ModerationReason []string // since Go's regex doesn't support backtracking.
+	Appeal           []string // since Go's regex doesn't support backtracking.
+	Moderation       ToyModerationConfig
+}
+
+// ToyModerationConfig contains heuristics, which send Toy to moderation queue, if any of them is triggered.
+type ToyModerationConfig struct {
+	SuspiciousWords     []string
+	ExternalLinks       []*regexp.Regexp // any of regular expressions should match to detect link.
+	PriceOutlierRatio   int              // Toy price differs from Category average price more than ratio times.
+	PriceOutlierMinToys int              // minimal count of approved Toys in Category to detect price outliers.
 }
 
 type TagValidationConfig struct {
 	Name []string // since Go's regex doesn't support backtracking.
 }
 
+type ReviewValidationConfig struct {
+	Text []string // since Go's regex doesn't support backtracking.
+}
+
+// Consumers of Users events from SSO.
+const (
+	UserEventsConsumerMemory = "memory" // events are published by the same process, used in tests and locally.
+	UserEventsConsumerFile   = "file"   // events are read from JSON lines file.
+)
+
+// Policies of handling Toys of deleted Users.
+const (
+	DeletedUserToysPolicyArchive = "archive" // Toys are kept, but hidden together with banned Master.
+	DeletedUserToysPolicyDelete  = "delete"
+)
+
+type UserEventsConfig struct {
+	Consumer string
+	FilePath string // used only for UserEventsConsumerFile. Offset of processed events is kept in FilePath.offset.
+	// PollInterval is how often file is checked for new events and how long failed event waits for retry.
+	PollInterval            time.Duration
+	DeletedUserToysPolicy   string
+	ReconciliationBatchSize uint64 // count of Masters, which are checked in SSO per page.
+}
+
+type JobsConfig struct {
+	Vacations      JobConfig
+	ForbiddenWords JobConfig // reloads forbidden words dictionary.
+}
+
+type JobConfig struct {
+	Interval time.Duration
+}
+
 type Config struct {
-	HTTP        HTTPConfig
-	Clients     ClientsConfig
-	Database    db.Config
-	Logging     logging.Config
-	Tracing     TracingConfig
-	Validation  ValidationConfig
-	Environment string
-	Version     string
+	HTTP         HTTPConfig
+	Clients      ClientsConfig
+	Database     db.Config
+	Replicas     ReplicasConfig
+	Transactions TransactionsConfig
+	Cache        CacheConfig
+	Logging      logging.Config
+	Tracing      TracingConfig
+	Validation   ValidationConfig
+	Jobs         JobsConfig
+	UserEvents   UserEventsConfig
+	Environment  string
+	Version      string
+}
+
+// mustCompileRegexps compiles regular expressions once on config load. Invalid expression fails startup,
+// because otherwise it would never match and check would be silently skipped.
+func mustCompileRegexps(env string, patterns []string) []*regexp.Regexp {
+	regexps := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("invalid regular expression %q in %s: %v", pattern, env, err))
+		}
+
+		regexps[i] = compiled
+	}
+
+	return regexps
 }
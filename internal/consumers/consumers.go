@@ -0,0 +1,42 @@
+package consumers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// deliver passes event to handler till it is processed or context is canceled.
+// Events are processed in order, so failed event blocks next ones instead of being skipped.
+func deliver(
+	ctx context.Context,
+	handler interfaces.UserEventHandler,
+	event entities.UserEvent,
+	retryInterval time.Duration,
+	logger logging.Logger,
+) error {
+	for {
+		err := handler(ctx, event)
+		if err == nil {
+			return nil
+		}
+
+		logging.LogErrorContext(
+			ctx,
+			logger,
+			fmt.Sprintf("Failed to process User event with ID=%s, retrying in %s", event.ID, retryInterval),
+			err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package consumers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+const offsetFileSuffix = ".offset"
+
+// NewFileUserEventsConsumer creates consumer of events, which are appended to JSON lines file.
+func NewFileUserEventsConsumer(
+	filePath string,
+	pollInterval time.Duration,
+	logger logging.Logger,
+) *FileUserEventsConsumer {
+	return &FileUserEventsConsumer{
+		filePath:     filePath,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// FileUserEventsConsumer reads events, one JSON object per line, from file, which is appended by publisher.
+// Offset of processed events is kept in separate file, so events are not processed again after restart.
+// Line is read only, when it is completely written, which is detected by trailing line break.
+type FileUserEventsConsumer struct {
+	filePath     string
+	pollInterval time.Duration
+	logger       logging.Logger
+}
+
+func (consumer *FileUserEventsConsumer) Consume(ctx context.Context, handler interfaces.UserEventHandler) error {
+	offset, err := consumer.readOffset()
+	if err != nil {
+		return err
+	}
+
+	for {
+		if offset, err = consumer.consumeNewEvents(ctx, handler, offset); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(consumer.pollInterval):
+		}
+	}
+}
+
+// consumeNewEvents processes all events after offset and returns offset of the first unprocessed event.
+func (consumer *FileUserEventsConsumer) consumeNewEvents(
+	ctx context.Context,
+	handler interfaces.UserEventHandler,
+	offset int64,
+) (int64, error) {
+	file, err := os.Open(consumer.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return offset, nil // nothing is published yet.
+	}
+
+	if err != nil {
+		return offset, err
+	}
+
+	defer func() {
+		if err = file.Close(); err != nil {
+			logging.LogErrorContext(ctx, consumer.logger, "Failed to close User events file", err)
+		}
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return offset, err
+	}
+
+	if info.Size() < offset {
+		logging.LogInfo(
+			consumer.logger,
+			fmt.Sprintf("User events file %s was truncated, reading it from start", consumer.filePath),
+		)
+
+		offset = 0
+	}
+
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		return offset, err
+	}
+
+	reader := bufio.NewReader(file)
+
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if errors.Is(readErr, io.EOF) {
+			return offset, nil // incomplete line is read again on next poll.
+		}
+
+		if readErr != nil {
+			return offset, readErr
+		}
+
+		if err = consumer.consumeLine(ctx, handler, line); err != nil {
+			return offset, err
+		}
+
+		offset += int64(len(line))
+		if err = consumer.writeOffset(offset); err != nil {
+			return offset, err
+		}
+	}
+}
+
+func (consumer *FileUserEventsConsumer) consumeLine(
+	ctx context.Context,
+	handler interfaces.UserEventHandler,
+	line []byte,
+) error {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+
+	var event entities.UserEvent
+	if err := json.Unmarshal(line, &event); err != nil {
+		// Malformed event would block all next events forever, so it is skipped:
+		logging.LogErrorContext(ctx, consumer.logger, "Skipped malformed User event: "+string(line), err)
+
+		return nil
+	}
+
+	return deliver(ctx, handler, event, consumer.pollInterval, consumer.logger)
+}
+
+func (consumer *FileUserEventsConsumer) readOffset() (int64, error) {
+	data, err := os.ReadFile(consumer.filePath + offsetFileSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// writeOffset replaces offset file atomically, so offset is not corrupted, if process is killed while writing.
+func (consumer *FileUserEventsConsumer) writeOffset(offset int64) error {
+	offsetFilePath := consumer.filePath + offsetFileSuffix
+	temporaryFilePath := offsetFilePath + ".tmp"
+
+	if err := os.WriteFile(temporaryFilePath, []byte(strconv.FormatInt(offset, 10)), 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(temporaryFilePath, offsetFilePath)
+}
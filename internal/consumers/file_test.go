@@ -0,0 +1,154 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/consumers"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+// consumeFile consumes events from file till expected count of events is processed.
+func consumeFile(
+	t *testing.T,
+	consumer *consumers.FileUserEventsConsumer,
+	expectedCount int,
+	handle func(event entities.UserEvent) error,
+) []entities.UserEvent {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var processed []entities.UserEvent
+
+	done := make(chan error)
+	go func() {
+		done <- consumer.Consume(
+			ctx,
+			func(_ context.Context, event entities.UserEvent) error {
+				if err := handle(event); err != nil {
+					return err
+				}
+
+				processed = append(processed, event)
+				if len(processed) == expectedCount {
+					cancel()
+				}
+
+				return nil
+			},
+		)
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("events were not consumed")
+	}
+
+	return processed
+}
+
+func TestFileUserEventsConsumer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2) // malformed event and failed attempt.
+
+	filePath := filepath.Join(t.TempDir(), "user_events.jsonl")
+	require.NoError(
+		t,
+		os.WriteFile(
+			filePath,
+			[]byte(
+				`{"id":"1","type":"user.deleted","userId":1}`+"\n"+
+					"not json\n"+
+					"\n"+
+					`{"id":"2","type":"user.banned","userId":2}`+"\n"+
+					`{"id":"3","type":"user.deleted"`, // is not completely written yet.
+			),
+			0o600,
+		),
+	)
+
+	consumer := consumers.NewFileUserEventsConsumer(filePath, time.Millisecond, logger)
+
+	failed := false
+	processed := consumeFile(
+		t,
+		consumer,
+		2,
+		func(event entities.UserEvent) error {
+			if event.ID == "2" && !failed {
+				failed = true
+
+				return errors.New("test")
+			}
+
+			return nil
+		},
+	)
+
+	require.Equal(
+		t,
+		[]entities.UserEvent{
+			{ID: "1", Type: entities.UserEventTypeDeleted, UserID: 1},
+			{ID: "2", Type: entities.UserEventTypeBanned, UserID: 2},
+		},
+		processed,
+	)
+
+	// After restart only new events are processed:
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+
+	_, err = file.WriteString(`,"userId":3}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	processed = consumeFile(
+		t,
+		consumers.NewFileUserEventsConsumer(filePath, time.Millisecond, logger),
+		1,
+		func(_ entities.UserEvent) error { return nil },
+	)
+
+	require.Equal(t, []entities.UserEvent{{ID: "3", Type: entities.UserEventTypeDeleted, UserID: 3}}, processed)
+}
+
+func TestFileUserEventsConsumerWithoutFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	consumer := consumers.NewFileUserEventsConsumer(
+		filepath.Join(t.TempDir(), "missing.jsonl"),
+		time.Millisecond,
+		mocklogger.NewMockLogger(ctrl),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.NoError(
+		t,
+		consumer.Consume(
+			ctx,
+			func(_ context.Context, _ entities.UserEvent) error {
+				t.Fatal("no events should be consumed")
+
+				return nil
+			},
+		),
+	)
+}
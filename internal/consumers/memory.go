@@ -0,0 +1,54 @@
+package consumers
+
+import (
+	"context"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+const memoryBufferSize = 1000 // count of published events, which can wait for processing.
+
+// NewMemoryUserEventsConsumer creates consumer of events, which are published to it by the same process.
+func NewMemoryUserEventsConsumer(retryInterval time.Duration, logger logging.Logger) *MemoryUserEventsConsumer {
+	return &MemoryUserEventsConsumer{
+		events:        make(chan entities.UserEvent, memoryBufferSize),
+		retryInterval: retryInterval,
+		logger:        logger,
+	}
+}
+
+// MemoryUserEventsConsumer keeps events in memory, so they are lost on restart. It is used in tests and locally,
+// where SSO events are not available.
+type MemoryUserEventsConsumer struct {
+	events        chan entities.UserEvent
+	retryInterval time.Duration
+	logger        logging.Logger
+}
+
+// Publish adds event to queue. It blocks, while queue is full.
+func (consumer *MemoryUserEventsConsumer) Publish(ctx context.Context, event entities.UserEvent) error {
+	select {
+	case consumer.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (consumer *MemoryUserEventsConsumer) Consume(ctx context.Context, handler interfaces.UserEventHandler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-consumer.events:
+			// Delivery fails only, when consumer is stopped:
+			if err := deliver(ctx, handler, event, consumer.retryInterval, consumer.logger); err != nil {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/consumers"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+func TestMemoryUserEventsConsumer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogger.NewMockLogger(ctrl)
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1) // first attempt to process second event.
+
+	consumer := consumers.NewMemoryUserEventsConsumer(time.Millisecond, logger)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	deleted := entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: 1}
+	banned := entities.UserEvent{ID: "2", Type: entities.UserEventTypeBanned, UserID: 2}
+	require.NoError(t, consumer.Publish(ctx, deleted))
+	require.NoError(t, consumer.Publish(ctx, banned))
+
+	var (
+		processed []entities.UserEvent
+		attempts  int
+	)
+
+	handler := func(_ context.Context, event entities.UserEvent) error {
+		attempts++
+		if event.ID == banned.ID && attempts == 2 {
+			return errors.New("test")
+		}
+
+		processed = append(processed, event)
+		if len(processed) == 2 {
+			cancel()
+		}
+
+		return nil
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- consumer.Consume(ctx, handler)
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("events were not consumed")
+	}
+
+	require.Equal(t, []entities.UserEvent{deleted, banned}, processed)
+	require.Equal(t, 3, attempts)
+}
+
+func TestMemoryUserEventsConsumerStopped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	consumer := consumers.NewMemoryUserEventsConsumer(time.Millisecond, mocklogger.NewMockLogger(ctrl))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Consumer, which is stopped, returns without error:
+	require.NoError(t, consumer.Consume(ctx, nil))
+}
@@ -0,0 +1,35 @@
+package categories
+
+import (
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+// mapCategoryRulesToOut maps Category rules, which are already merged with default ones.
+func mapCategoryRulesToOut(rules entities.CategoryRules) *toys.GetCategoryRulesOut {
+	requiredAttributes := make([]toys.ToyAttribute, len(rules.RequiredAttributes))
+	for i, attribute := range rules.RequiredAttributes {
+		requiredAttributes[i] = mapToyAttributeToOut(attribute)
+	}
+
+	return &toys.GetCategoryRulesOut{
+		CategoryID:           rules.CategoryID,
+		PriceFloor:           *rules.PriceFloor,
+		PriceCeil:            *rules.PriceCeil,
+		QuantityFloor:        *rules.QuantityFloor,
+		QuantityCeil:         *rules.QuantityCeil,
+		DescriptionMinLength: rules.DescriptionMinLength,
+		RequiredAttributes:   requiredAttributes,
+	}
+}
+
+func mapToyAttributeToOut(attribute entities.ToyAttribute) toys.ToyAttribute {
+	switch attribute {
+	case entities.ToyAttributeTags:
+		return toys.ToyAttribute_TOY_ATTRIBUTE_TAGS
+	case entities.ToyAttributeAttachments:
+		return toys.ToyAttribute_TOY_ATTRIBUTE_ATTACHMENTS
+	}
+
+	return toys.ToyAttribute_TOY_ATTRIBUTE_UNSPECIFIED
+}
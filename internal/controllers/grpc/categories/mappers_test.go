@@ -0,0 +1,77 @@
+package categories
+
+import (
+	"testing"
+
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+var (
+	categoryRules = &entities.CategoryRules{
+		CategoryID:           categoryID,
+		PriceFloor:           pointers.New[float32](100),
+		PriceCeil:            pointers.New[float32](1_000_000),
+		QuantityFloor:        pointers.New[uint32](1),
+		QuantityCeil:         pointers.New[uint32](10),
+		DescriptionMinLength: pointers.New[uint32](50),
+		RequiredAttributes: []entities.ToyAttribute{
+			entities.ToyAttributeTags,
+			entities.ToyAttributeAttachments,
+		},
+	}
+	mappedCategoryRules = &toys.GetCategoryRulesOut{
+		CategoryID:           categoryID,
+		PriceFloor:           100,
+		PriceCeil:            1_000_000,
+		QuantityFloor:        1,
+		QuantityCeil:         10,
+		DescriptionMinLength: pointers.New[uint32](50),
+		RequiredAttributes: []toys.ToyAttribute{
+			toys.ToyAttribute_TOY_ATTRIBUTE_TAGS,
+			toys.ToyAttribute_TOY_ATTRIBUTE_ATTACHMENTS,
+		},
+	}
+)
+
+func TestMapCategoryRulesToOut(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    entities.CategoryRules
+		expected *toys.GetCategoryRulesOut
+	}{
+		{
+			name:     "success",
+			rules:    *categoryRules,
+			expected: mappedCategoryRules,
+		},
+		{
+			name: "without optional rules",
+			rules: entities.CategoryRules{
+				CategoryID:    categoryID,
+				PriceFloor:    pointers.New[float32](1),
+				PriceCeil:     pointers.New[float32](1_000_000),
+				QuantityFloor: pointers.New[uint32](1),
+				QuantityCeil:  pointers.New[uint32](1_000),
+			},
+			expected: &toys.GetCategoryRulesOut{
+				CategoryID:         categoryID,
+				PriceFloor:         1,
+				PriceCeil:          1_000_000,
+				QuantityFloor:      1,
+				QuantityCeil:       1_000,
+				RequiredAttributes: []toys.ToyAttribute{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := mapCategoryRulesToOut(tc.rules)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -2,24 +2,18 @@ package categories
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
-
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
-	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/statuses"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
 )
 
-var categoryNotFoundError = &customerrors.CategoryNotFoundError{}
-
 // RegisterServer handler (serverAPI) for CategoriesServer to gRPC server:.
 func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
 	toys.RegisterCategoriesServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
@@ -46,12 +40,7 @@ func (api *ServerAPI) GetCategory(
 			err,
 		)
 
-		switch {
-		case errors.As(err, &categoryNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.GetCategoryOut{
@@ -76,7 +65,7 @@ func (api *ServerAPI) GetCategories(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	processedCategories := make([]*toys.GetCategoryOut, len(categories))
@@ -91,3 +80,23 @@ func (api *ServerAPI) GetCategories(
 
 	return &toys.GetCategoriesOut{Categories: processedCategories}, nil
 }
+
+// GetCategoryRules handler returns Toy validation rules of Category, so they can be checked before Toy creation.
+func (api *ServerAPI) GetCategoryRules(
+	ctx context.Context,
+	in *toys.GetCategoryRulesIn,
+) (*toys.GetCategoryRulesOut, error) {
+	rules, err := api.useCases.GetCategoryRules(ctx, in.GetCategoryID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get rules of Category with ID=%d", in.GetCategoryID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return mapCategoryRulesToOut(*rules), nil
+}
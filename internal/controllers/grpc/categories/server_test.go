@@ -212,3 +212,95 @@ func TestCategoriesServer_GetCategories(t *testing.T) {
 		})
 	}
 }
+
+func TestCategoriesServer_GetCategoryRules(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetCategoryRulesIn
+		setupMocks    func(usecases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetCategoryRulesOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.GetCategoryRulesIn{
+				CategoryID: categoryID,
+			},
+			setupMocks: func(usecases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				usecases.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(categoryRules, nil).
+					Times(1)
+			},
+			expected: mappedCategoryRules,
+		},
+		{
+			name: "Category not found",
+			in: &toys.GetCategoryRulesIn{
+				CategoryID: categoryID,
+			},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				usecases.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(nil, &customerrors.CategoryNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in: &toys.GetCategoryRulesIn{
+				CategoryID: categoryID,
+			},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				usecases.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	usecases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	categoriesServer := &ServerAPI{
+		logger:   logger,
+		useCases: usecases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(usecases, logger)
+			}
+
+			actual, err := categoriesServer.GetCategoryRules(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
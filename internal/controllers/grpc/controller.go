@@ -11,25 +11,35 @@ import (
 	customgrpc "github.com/DKhorkov/libs/grpc/interceptors"
 
 	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/categories"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/health"
 	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masters"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/reviews"
 	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/tags"
 	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/toys"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
 )
 
-// New creates an instance of gRPC Controller.
+// New creates an instance of gRPC Controller. Provided interceptors are called after tracing and logging ones.
+// Availability of dependencies is reported by standard gRPC health service under their names.
 func New(
 	host string,
 	port int,
 	useCases interfaces.UseCases,
+	dependencies map[string]health.Dependency,
 	logger logging.Logger,
 	traceProvider tracing.Provider,
 	spanConfig tracing.SpanConfig,
+	interceptors ...grpc.UnaryServerInterceptor,
 ) *Controller {
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
-			customgrpc.UnaryServerTracingInterceptor(traceProvider, spanConfig),
-			customgrpc.UnaryServerLoggingInterceptor(logger),
+			append(
+				[]grpc.UnaryServerInterceptor{
+					customgrpc.UnaryServerTracingInterceptor(traceProvider, spanConfig),
+					customgrpc.UnaryServerLoggingInterceptor(logger),
+				},
+				interceptors...,
+			)...,
 		),
 	)
 
@@ -38,6 +48,8 @@ func New(
 	categories.RegisterServer(grpcServer, useCases, logger)
 	masters.RegisterServer(grpcServer, useCases, logger)
 	toys.RegisterServer(grpcServer, useCases, logger)
+	reviews.RegisterServer(grpcServer, useCases, logger)
+	health.RegisterServer(grpcServer, dependencies)
 
 	return &Controller{
 		grpcServer: grpcServer,
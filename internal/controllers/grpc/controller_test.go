@@ -0,0 +1,863 @@
+package grpccontroller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/categories"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masters"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/reviews"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/statuses"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/tags"
+	toysserver "github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/toys"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/validation"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const bufferSize = 1024 * 1024
+
+// TestController_ErrorsMapping checks, that every RPC maps every UseCases error to the same gRPC status.
+func TestController_ErrorsMapping(t *testing.T) {
+	errorCases := []struct {
+		name           string
+		err            error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			name:           "fields validation error",
+			err:            &customerrors.ValidationError{},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: statuses.ReasonValidationFailed,
+		},
+		{
+			name:           "validation error",
+			err:            &validation.Error{},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: statuses.ReasonValidationFailed,
+		},
+		{
+			name:           "Category not found",
+			err:            &customerrors.CategoryNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonCategoryNotFound,
+		},
+		{
+			name:           "Favourite not found",
+			err:            &customerrors.FavouriteNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonFavouriteNotFound,
+		},
+		{
+			name:           "Favourite already exists",
+			err:            &customerrors.FavouriteAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: statuses.ReasonFavouriteAlreadyExists,
+		},
+		{
+			name:           "Follow not found",
+			err:            &customerrors.FollowNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonFollowNotFound,
+		},
+		{
+			name:           "Follow already exists",
+			err:            &customerrors.FollowAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: statuses.ReasonFollowAlreadyExists,
+		},
+		{
+			name:           "Master not found",
+			err:            &customerrors.MasterNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonMasterNotFound,
+		},
+		{
+			name:           "Master already exists",
+			err:            &customerrors.MasterAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: statuses.ReasonMasterAlreadyExists,
+		},
+		{
+			name:           "Master slug already exists",
+			err:            &customerrors.MasterSlugAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: statuses.ReasonMasterSlugAlreadyExists,
+		},
+		{
+			name:           "Master blocked",
+			err:            &customerrors.MasterBlockedError{},
+			expectedCode:   codes.PermissionDenied,
+			expectedReason: statuses.ReasonMasterBlocked,
+		},
+		{
+			name:           "Review not found",
+			err:            &customerrors.ReviewNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonReviewNotFound,
+		},
+		{
+			name:           "Review already exists",
+			err:            &customerrors.ReviewAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: statuses.ReasonReviewAlreadyExists,
+		},
+		{
+			name:           "Tag not found",
+			err:            &customerrors.TagNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonTagNotFound,
+		},
+		{
+			name:           "Toy not found",
+			err:            &customerrors.ToyNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonToyNotFound,
+		},
+		{
+			name:           "Toy already exists",
+			err:            &customerrors.ToyAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: statuses.ReasonToyAlreadyExists,
+		},
+		{
+			name:           "User not found",
+			err:            &customerrors.UserNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: statuses.ReasonUserNotFound,
+		},
+		{
+			name:           "database unavailable",
+			err:            sql.ErrConnDone,
+			expectedCode:   codes.Unavailable,
+			expectedReason: statuses.ReasonUnavailable,
+		},
+		{
+			name:           "internal error",
+			err:            errors.New("test"),
+			expectedCode:   codes.Internal,
+			expectedReason: statuses.ReasonInternal,
+		},
+	}
+
+	rpcCases := []struct {
+		name       string
+		setupMocks func(useCases *mockusecases.MockUseCases, err error)
+		call       func(ctx context.Context, conn *grpc.ClientConn) error
+	}{
+		{
+			name: "/tags.TagsService/CreateTags",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CreateTags(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewTagsServiceClient(conn).CreateTags(ctx, &toys.CreateTagsIn{})
+				return err
+			},
+		},
+		{
+			name: "/tags.TagsService/GetTag",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetTagByID(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewTagsServiceClient(conn).GetTag(ctx, &toys.GetTagIn{})
+				return err
+			},
+		},
+		{
+			name: "/tags.TagsService/GetTags",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewTagsServiceClient(conn).GetTags(ctx, &emptypb.Empty{})
+				return err
+			},
+		},
+		{
+			name: "/categories.CategoriesService/GetCategory",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetCategoryByID(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewCategoriesServiceClient(conn).GetCategory(ctx, &toys.GetCategoryIn{})
+				return err
+			},
+		},
+		{
+			name: "/categories.CategoriesService/GetCategories",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewCategoriesServiceClient(conn).GetCategories(ctx, &emptypb.Empty{})
+				return err
+			},
+		},
+		{
+			name: "/categories.CategoriesService/GetCategoryRules",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewCategoriesServiceClient(conn).GetCategoryRules(ctx, &toys.GetCategoryRulesIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/CountMasters",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountMasters(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).CountMasters(ctx, &toys.CountMastersIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/UpdateMaster",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					UpdateMaster(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).UpdateMaster(ctx, &toys.UpdateMasterIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetMasterStats",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasterStats(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetMasterStats(ctx, &toys.GetMasterStatsIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/SetVacationMode",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					SetVacationMode(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).SetVacationMode(ctx, &toys.SetVacationModeIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/ChangeMasterStatus",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					ChangeMasterStatus(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).ChangeMasterStatus(ctx, &toys.ChangeMasterStatusIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetMasterStatusHistory",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetMasterStatusHistory(ctx, &toys.GetMasterStatusHistoryIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetMasterBySlug",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetMasterBySlug(ctx, &toys.GetMasterBySlugIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetMasterByUser",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetMasterByUser(ctx, &toys.GetMasterByUserIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetMaster",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasterByID(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetMaster(ctx, &toys.GetMasterIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetMasters",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasters(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetMasters(ctx, &toys.GetMastersIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/RegisterMaster",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					RegisterMaster(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).RegisterMaster(ctx, &toys.RegisterMasterIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/FollowMaster",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					FollowMaster(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).FollowMaster(ctx, &toys.FollowMasterIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/UnfollowMaster",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					UnfollowMaster(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).UnfollowMaster(ctx, &toys.UnfollowMasterIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/GetFollowedMasters",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetFollowedMasters(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).GetFollowedMasters(ctx, &toys.GetFollowedMastersIn{})
+				return err
+			},
+		},
+		{
+			name: "/masters.MastersService/CountFollowedMasters",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountFollowedMasters(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewMastersServiceClient(conn).CountFollowedMasters(ctx, &toys.CountFollowedMastersIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/AddToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					AddToy(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).AddToy(ctx, &toys.AddToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/GetToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetToyByID(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).GetToy(ctx, &toys.GetToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/GetToys",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetToys(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).GetToys(ctx, &toys.GetToysIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/CountToys",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountToys(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).CountToys(ctx, &toys.CountToysIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/GetMasterToys",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetMasterToys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).GetMasterToys(ctx, &toys.GetMasterToysIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/CountMasterToys",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountMasterToys(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).CountMasterToys(ctx, &toys.CountMasterToysIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/GetUserToys",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetUserToys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).GetUserToys(ctx, &toys.GetUserToysIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/CountUserToys",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountUserToys(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).CountUserToys(ctx, &toys.CountUserToysIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/DeleteToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					DeleteToy(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).DeleteToy(ctx, &toys.DeleteToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/UpdateToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					UpdateToy(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).UpdateToy(ctx, &toys.UpdateToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/AddFavourite",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					AddFavourite(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).AddFavourite(ctx, &toys.AddFavouriteIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/RemoveFavourite",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					RemoveFavourite(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).RemoveFavourite(ctx, &toys.RemoveFavouriteIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/GetUserFavourites",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetUserFavourites(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).GetUserFavourites(ctx, &toys.GetUserFavouritesIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/CountUserFavourites",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountUserFavourites(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).CountUserFavourites(ctx, &toys.CountUserFavouritesIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/GetFeed",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetFeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).GetFeed(ctx, &toys.GetFeedIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/ListModerationQueue",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).ListModerationQueue(ctx, &toys.ListModerationQueueIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/ApproveToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					ApproveToy(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).ApproveToy(ctx, &toys.ApproveToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/RejectToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					RejectToy(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).RejectToy(ctx, &toys.RejectToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/toys.ToysService/ResubmitToy",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewToysServiceClient(conn).ResubmitToy(ctx, &toys.ResubmitToyIn{})
+				return err
+			},
+		},
+		{
+			name: "/reviews.ReviewsService/AddReview",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					AddReview(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewReviewsServiceClient(conn).AddReview(ctx, &toys.AddReviewIn{})
+				return err
+			},
+		},
+		{
+			name: "/reviews.ReviewsService/GetReview",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetReviewByID(gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewReviewsServiceClient(conn).GetReview(ctx, &toys.GetReviewIn{})
+				return err
+			},
+		},
+		{
+			name: "/reviews.ReviewsService/GetToyReviews",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					GetToyReviews(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewReviewsServiceClient(conn).GetToyReviews(ctx, &toys.GetToyReviewsIn{})
+				return err
+			},
+		},
+		{
+			name: "/reviews.ReviewsService/CountToyReviews",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					CountToyReviews(gomock.Any(), gomock.Any()).
+					Return(uint64(0), err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewReviewsServiceClient(conn).CountToyReviews(ctx, &toys.CountToyReviewsIn{})
+				return err
+			},
+		},
+		{
+			name: "/reviews.ReviewsService/UpdateReview",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					UpdateReview(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewReviewsServiceClient(conn).UpdateReview(ctx, &toys.UpdateReviewIn{})
+				return err
+			},
+		},
+		{
+			name: "/reviews.ReviewsService/DeleteReview",
+			setupMocks: func(useCases *mockusecases.MockUseCases, err error) {
+				useCases.
+					EXPECT().
+					DeleteReview(gomock.Any(), gomock.Any()).
+					Return(err).
+					Times(1)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := toys.NewReviewsServiceClient(conn).DeleteReview(ctx, &toys.DeleteReviewIn{})
+				return err
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes()
+
+	listener := bufconn.Listen(bufferSize)
+	grpcServer := grpc.NewServer()
+	tags.RegisterServer(grpcServer, useCases, logger)
+	categories.RegisterServer(grpcServer, useCases, logger)
+	masters.RegisterServer(grpcServer, useCases, logger)
+	toysserver.RegisterServer(grpcServer, useCases, logger)
+	reviews.RegisterServer(grpcServer, useCases, logger)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	ctx := context.Background()
+
+	for _, rpcCase := range rpcCases {
+		for _, errorCase := range errorCases {
+			t.Run(rpcCase.name+"/"+errorCase.name, func(t *testing.T) {
+				rpcCase.setupMocks(useCases, errorCase.err)
+
+				st := status.Convert(rpcCase.call(ctx, conn))
+				require.Equal(t, errorCase.expectedCode, st.Code())
+				require.NotEmpty(t, st.Details())
+
+				errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+				require.True(t, ok)
+				require.Equal(t, errorCase.expectedReason, errorInfo.GetReason())
+				require.Equal(t, statuses.Domain, errorInfo.GetDomain())
+				require.Equal(t, rpcCase.name, errorInfo.GetMetadata()["method"])
+			})
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package exports
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+const (
+	listSeparator = ";" // separates Tags and attachments in CSV cells, the same as in import
+	feedCurrency  = "RUR"
+)
+
+// header returns columns of CSV file. Columns are named as in import, but id and masterID columns are added.
+func header() []string {
+	return []string{"id", "masterID", "name", "description", "price", "quantity", "category", "tags", "attachments"}
+}
+
+// Writer writes exported Toys batch by batch. Close must be called after the last batch to complete file.
+type Writer interface {
+	WriteToys(toys []entities.Toy) error
+	Close() error
+}
+
+// NewWriter creates Writer for provided format and writes beginning of file. Categories are used to write names
+// of Categories instead of their IDs. Shop is required only for YML format.
+func NewWriter(
+	writer io.Writer,
+	format toys.ExportFormat,
+	categories []entities.Category,
+	shop *toys.FeedShop,
+) (Writer, error) {
+	categoriesNames := make(map[uint32]string, len(categories))
+	for _, category := range categories {
+		categoriesNames[category.ID] = category.Name
+	}
+
+	switch format {
+	case toys.ExportFormat_EXPORT_FORMAT_CSV:
+		return newCSVWriter(writer, categoriesNames)
+	case toys.ExportFormat_EXPORT_FORMAT_JSONL:
+		return &jsonlWriter{encoder: json.NewEncoder(writer), categoriesNames: categoriesNames}, nil
+	case toys.ExportFormat_EXPORT_FORMAT_YML:
+		return newYMLWriter(writer, categories, shop)
+	case toys.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
+	}
+
+	return nil, &customerrors.ValidationError{
+		Violations: []customerrors.FieldViolation{
+			{
+				Field:   "format",
+				Code:    customerrors.ViolationCodeRequired,
+				Message: "export format is not specified",
+			},
+		},
+	}
+}
+
+type csvWriter struct {
+	writer          *csv.Writer
+	categoriesNames map[uint32]string
+}
+
+func newCSVWriter(writer io.Writer, categoriesNames map[uint32]string) (*csvWriter, error) {
+	csvWriter := &csvWriter{writer: csv.NewWriter(writer), categoriesNames: categoriesNames}
+	if err := csvWriter.writer.Write(header()); err != nil {
+		return nil, err
+	}
+
+	return csvWriter, nil
+}
+
+func (writer *csvWriter) WriteToys(toys []entities.Toy) error {
+	for _, toy := range toys {
+		record := []string{
+			strconv.FormatUint(toy.ID, 10),
+			strconv.FormatUint(toy.MasterID, 10),
+			toy.Name,
+			toy.Description,
+			formatPrice(toy.Price),
+			strconv.FormatUint(uint64(toy.Quantity), 10),
+			writer.categoriesNames[toy.CategoryID],
+			strings.Join(tagsNames(toy), listSeparator),
+			strings.Join(attachmentsLinks(toy), listSeparator),
+		}
+
+		if err := writer.writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.writer.Flush()
+
+	return writer.writer.Error()
+}
+
+func (writer *csvWriter) Close() error {
+	writer.writer.Flush()
+
+	return writer.writer.Error()
+}
+
+// jsonRow is a line of JSON Lines file with the same fields as in import.
+type jsonRow struct {
+	ID          uint64   `json:"id"`
+	MasterID    uint64   `json:"masterId"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Price       float32  `json:"price"`
+	Quantity    uint32   `json:"quantity"`
+	Category    string   `json:"category"`
+	Tags        []string `json:"tags"`
+	Attachments []string `json:"attachments"`
+}
+
+type jsonlWriter struct {
+	encoder         *json.Encoder
+	categoriesNames map[uint32]string
+}
+
+func (writer *jsonlWriter) WriteToys(toys []entities.Toy) error {
+	for _, toy := range toys {
+		row := jsonRow{
+			ID:          toy.ID,
+			MasterID:    toy.MasterID,
+			Name:        toy.Name,
+			Description: toy.Description,
+			Price:       toy.Price,
+			Quantity:    toy.Quantity,
+			Category:    writer.categoriesNames[toy.CategoryID],
+			Tags:        tagsNames(toy),
+			Attachments: attachmentsLinks(toy),
+		}
+
+		// Encoder writes every row on its own line:
+		if err := writer.encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (writer *jsonlWriter) Close() error {
+	return nil
+}
+
+type ymlCategory struct {
+	ID   uint32 `xml:"id,attr"`
+	Name string `xml:",chardata"`
+}
+
+type ymlCategories struct {
+	Categories []ymlCategory `xml:"category"`
+}
+
+type ymlCurrency struct {
+	ID   string `xml:"id,attr"`
+	Rate int    `xml:"rate,attr"`
+}
+
+type ymlCurrencies struct {
+	Currencies []ymlCurrency `xml:"currency"`
+}
+
+type ymlOffer struct {
+	XMLName     xml.Name `xml:"offer"`
+	ID          uint64   `xml:"id,attr"`
+	Available   bool     `xml:"available,attr"`
+	Name        string   `xml:"name"`
+	Price       string   `xml:"price"`
+	CurrencyID  string   `xml:"currencyId"`
+	CategoryID  uint32   `xml:"categoryId"`
+	Pictures    []string `xml:"picture"`
+	Description string   `xml:"description"`
+	Count       uint32   `xml:"count"`
+}
+
+// ymlWriter writes marketplace feed in Yandex Market Language. Opening elements of catalog are written
+// on creation and closed by Close, so offers are streamed between them.
+type ymlWriter struct {
+	encoder *xml.Encoder
+}
+
+func newYMLWriter(writer io.Writer, categories []entities.Category, shop *toys.FeedShop) (*ymlWriter, error) {
+	var violations []customerrors.FieldViolation
+
+	requiredFields := []struct {
+		name  string
+		value string
+	}{
+		{name: "shop.name", value: shop.GetName()},
+		{name: "shop.company", value: shop.GetCompany()},
+		{name: "shop.url", value: shop.GetUrl()},
+	}
+
+	for _, field := range requiredFields {
+		if strings.TrimSpace(field.value) == "" {
+			violations = append(
+				violations,
+				customerrors.FieldViolation{
+					Field:   field.name,
+					Code:    customerrors.ViolationCodeRequired,
+					Message: field.name + " is required for YML format",
+				},
+			)
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, &customerrors.ValidationError{Violations: violations}
+	}
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return nil, err
+	}
+
+	feedCategories := make([]ymlCategory, len(categories))
+	for i, category := range categories {
+		feedCategories[i] = ymlCategory{ID: category.ID, Name: category.Name}
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+
+	catalog := xml.StartElement{
+		Name: xml.Name{Local: "yml_catalog"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "date"}, Value: time.Now().UTC().Format(time.RFC3339)},
+		},
+	}
+
+	if err := encoder.EncodeToken(catalog); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.EncodeToken(xml.StartElement{Name: xml.Name{Local: "shop"}}); err != nil {
+		return nil, err
+	}
+
+	shopElements := []struct {
+		name  string
+		value any
+	}{
+		{name: "name", value: shop.GetName()},
+		{name: "company", value: shop.GetCompany()},
+		{name: "url", value: shop.GetUrl()},
+		{name: "currencies", value: ymlCurrencies{Currencies: []ymlCurrency{{ID: feedCurrency, Rate: 1}}}},
+		{name: "categories", value: ymlCategories{Categories: feedCategories}},
+	}
+
+	for _, element := range shopElements {
+		if err := encoder.EncodeElement(element.value, xml.StartElement{Name: xml.Name{Local: element.name}}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := encoder.EncodeToken(xml.StartElement{Name: xml.Name{Local: "offers"}}); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+
+	return &ymlWriter{encoder: encoder}, nil
+}
+
+func (writer *ymlWriter) WriteToys(toys []entities.Toy) error {
+	for _, toy := range toys {
+		offer := ymlOffer{
+			ID:          toy.ID,
+			Available:   toy.Available && toy.Quantity > 0,
+			Name:        toy.Name,
+			Price:       formatPrice(toy.Price),
+			CurrencyID:  feedCurrency,
+			CategoryID:  toy.CategoryID,
+			Pictures:    attachmentsLinks(toy),
+			Description: toy.Description,
+			Count:       toy.Quantity,
+		}
+
+		if err := writer.encoder.Encode(offer); err != nil {
+			return err
+		}
+	}
+
+	return writer.encoder.Flush()
+}
+
+func (writer *ymlWriter) Close() error {
+	for _, name := range []string{"offers", "shop", "yml_catalog"} {
+		if err := writer.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+
+	return writer.encoder.Close()
+}
+
+func formatPrice(price float32) string {
+	return strconv.FormatFloat(float64(price), 'f', -1, 32)
+}
+
+func tagsNames(toy entities.Toy) []string {
+	names := make([]string, len(toy.Tags))
+	for i, tag := range toy.Tags {
+		names[i] = tag.Name
+	}
+
+	return names
+}
+
+func attachmentsLinks(toy entities.Toy) []string {
+	links := make([]string, len(toy.Attachments))
+	for i, attachment := range toy.Attachments {
+		links[i] = attachment.Link
+	}
+
+	return links
+}
@@ -0,0 +1,149 @@
+package exports_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/exports"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+func TestWriter(t *testing.T) {
+	categories := []entities.Category{{ID: 1, Name: "Игрушки"}}
+	batches := [][]entities.Toy{
+		{
+			{
+				ID:          1,
+				MasterID:    2,
+				CategoryID:  1,
+				Name:        "Мишка",
+				Description: "Вязаный мишка, 20 см",
+				Price:       1500.5,
+				Quantity:    2,
+				Available:   true,
+				Tags:        []entities.Tag{{Name: "вязание"}, {Name: "мишки"}},
+				Attachments: []entities.Attachment{{Link: "https://a.com/1.png"}, {Link: "https://a.com/2.png"}},
+			},
+		},
+		{
+			{
+				ID:          3,
+				MasterID:    2,
+				CategoryID:  1,
+				Name:        "Зайка",
+				Description: "Вязаный зайка",
+				Price:       900,
+				Quantity:    1,
+			},
+		},
+	}
+
+	shop := &toys.FeedShop{Name: "Игрушки ручной работы", Company: "HMTM", Url: "https://hmtm.example.com"}
+
+	testCases := []struct {
+		name          string
+		format        toys.ExportFormat
+		shop          *toys.FeedShop
+		expected      string
+		errorExpected bool
+	}{
+		{
+			name:   "CSV",
+			format: toys.ExportFormat_EXPORT_FORMAT_CSV,
+			expected: "id,masterID,name,description,price,quantity,category,tags,attachments\n" +
+				"1,2,Мишка,\"Вязаный мишка, 20 см\",1500.5,2,Игрушки,вязание;мишки,https://a.com/1.png;https://a.com/2.png\n" +
+				"3,2,Зайка,Вязаный зайка,900,1,Игрушки,,\n",
+		},
+		{
+			name:   "JSON Lines",
+			format: toys.ExportFormat_EXPORT_FORMAT_JSONL,
+			expected: `{"id":1,"masterId":2,"name":"Мишка","description":"Вязаный мишка, 20 см","price":1500.5,` +
+				`"quantity":2,"category":"Игрушки","tags":["вязание","мишки"],` +
+				`"attachments":["https://a.com/1.png","https://a.com/2.png"]}` + "\n" +
+				`{"id":3,"masterId":2,"name":"Зайка","description":"Вязаный зайка","price":900,` +
+				`"quantity":1,"category":"Игрушки","tags":[],"attachments":[]}` + "\n",
+		},
+		{
+			name:   "YML",
+			format: toys.ExportFormat_EXPORT_FORMAT_YML,
+			shop:   shop,
+			expected: xml.Header +
+				`<yml_catalog date="DATE">
+  <shop>
+    <name>Игрушки ручной работы</name>
+    <company>HMTM</company>
+    <url>https://hmtm.example.com</url>
+    <currencies>
+      <currency id="RUR" rate="1"></currency>
+    </currencies>
+    <categories>
+      <category id="1">Игрушки</category>
+    </categories>
+    <offers>
+      <offer id="1" available="true">
+        <name>Мишка</name>
+        <price>1500.5</price>
+        <currencyId>RUR</currencyId>
+        <categoryId>1</categoryId>
+        <picture>https://a.com/1.png</picture>
+        <picture>https://a.com/2.png</picture>
+        <description>Вязаный мишка, 20 см</description>
+        <count>2</count>
+      </offer>
+      <offer id="3" available="false">
+        <name>Зайка</name>
+        <price>900</price>
+        <currencyId>RUR</currencyId>
+        <categoryId>1</categoryId>
+        <description>Вязаный зайка</description>
+        <count>1</count>
+      </offer>
+    </offers>
+  </shop>
+</yml_catalog>`,
+		},
+		{
+			name:          "YML without shop",
+			format:        toys.ExportFormat_EXPORT_FORMAT_YML,
+			shop:          &toys.FeedShop{Name: "Игрушки ручной работы"},
+			errorExpected: true,
+		},
+		{
+			name:          "unspecified format",
+			format:        toys.ExportFormat_EXPORT_FORMAT_UNSPECIFIED,
+			errorExpected: true,
+		},
+	}
+
+	// Date of feed depends on current time:
+	datePattern := regexp.MustCompile(`date="[^"]+"`)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			writer, err := exports.NewWriter(buffer, tc.format, categories, tc.shop)
+			if tc.errorExpected {
+				require.ErrorAs(t, err, new(*customerrors.ValidationError))
+				require.Zero(t, buffer.Len())
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			for _, batch := range batches {
+				require.NoError(t, writer.WriteToys(batch))
+			}
+
+			require.NoError(t, writer.Close())
+			require.Equal(t, tc.expected, datePattern.ReplaceAllString(buffer.String(), `date="DATE"`))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package health
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Dependency reports, if external service, which is used by server, is available now.
+type Dependency func() bool
+
+// RegisterServer handler (serverAPI) for standard gRPC HealthServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, dependencies map[string]Dependency) {
+	healthgrpc.RegisterHealthServer(gRPCServer, &ServerAPI{dependencies: dependencies})
+}
+
+// ServerAPI reports server itself as serving under empty service name and availability of every dependency
+// under its own name, so unavailable dependency is visible without failing health of whole server.
+type ServerAPI struct {
+	// Watch is not supported, so clients should poll Check
+	healthgrpc.UnimplementedHealthServer
+	dependencies map[string]Dependency
+}
+
+// Check handler returns serving status of server or of dependency with provided name.
+func (api *ServerAPI) Check(
+	_ context.Context,
+	in *healthgrpc.HealthCheckRequest,
+) (*healthgrpc.HealthCheckResponse, error) {
+	if in.GetService() == "" {
+		return &healthgrpc.HealthCheckResponse{Status: healthgrpc.HealthCheckResponse_SERVING}, nil
+	}
+
+	dependency, ok := api.dependencies[in.GetService()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", in.GetService())
+	}
+
+	if !dependency() {
+		return &healthgrpc.HealthCheckResponse{Status: healthgrpc.HealthCheckResponse_NOT_SERVING}, nil
+	}
+
+	return &healthgrpc.HealthCheckResponse{Status: healthgrpc.HealthCheckResponse_SERVING}, nil
+}
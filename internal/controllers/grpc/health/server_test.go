@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestHealthServer_Check(t *testing.T) {
+	testCases := []struct {
+		name          string
+		service       string
+		expected      *healthgrpc.HealthCheckResponse
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name:     "server",
+			expected: &healthgrpc.HealthCheckResponse{Status: healthgrpc.HealthCheckResponse_SERVING},
+		},
+		{
+			name:     "available dependency",
+			service:  "available",
+			expected: &healthgrpc.HealthCheckResponse{Status: healthgrpc.HealthCheckResponse_SERVING},
+		},
+		{
+			name:     "unavailable dependency",
+			service:  "unavailable",
+			expected: &healthgrpc.HealthCheckResponse{Status: healthgrpc.HealthCheckResponse_NOT_SERVING},
+		},
+		{
+			name:          "unknown dependency",
+			service:       "unknown",
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+	}
+
+	healthServer := &ServerAPI{
+		dependencies: map[string]Dependency{
+			"available":   func() bool { return true },
+			"unavailable": func() bool { return false },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := healthServer.Check(context.Background(), &healthgrpc.HealthCheckRequest{Service: tc.service})
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,367 @@
+package imports
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+// MaxRows is a max number of rows in imported file, which protects server from too large imports.
+const MaxRows = 1000
+
+const (
+	listSeparator = ";" // separates Tags and attachments in CSV cells
+	maxLineSize   = 1024 * 1024
+	byteOrderMark = "\ufeff"
+	rowField      = "row"
+	fileField     = "chunk"
+)
+
+// Columns of CSV file:
+const (
+	columnName        = "name"
+	columnDescription = "description"
+	columnPrice       = "price"
+	columnQuantity    = "quantity"
+	columnCategory    = "category"
+	columnTags        = "tags"
+	columnAttachments = "attachments"
+)
+
+// requiredColumns returns columns, which must be present in CSV header.
+func requiredColumns() []string {
+	return []string{columnName, columnDescription, columnPrice, columnQuantity, columnCategory}
+}
+
+// optionalColumns returns columns, which may be omitted in CSV header.
+func optionalColumns() []string {
+	return []string{columnTags, columnAttachments}
+}
+
+// jsonRow is a line of JSON Lines file.
+type jsonRow struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Price       float32  `json:"price"`
+	Quantity    uint32   `json:"quantity"`
+	Category    string   `json:"category"`
+	Tags        []string `json:"tags"`
+	Attachments []string `json:"attachments"`
+}
+
+// ParseToys parses Toys from imported file. Lines, which can't be parsed, are returned as results with violations,
+// so they are reported together with rows, which failed validation. Error is returned, if whole file is invalid.
+func ParseToys(
+	reader io.Reader,
+	format toys.ImportFormat,
+) ([]entities.ImportToyRow, []entities.ImportToyResult, error) {
+	switch format {
+	case toys.ImportFormat_IMPORT_FORMAT_CSV:
+		return parseCSV(reader)
+	case toys.ImportFormat_IMPORT_FORMAT_JSONL:
+		return parseJSONL(reader)
+	case toys.ImportFormat_IMPORT_FORMAT_UNSPECIFIED:
+	}
+
+	return nil, nil, fileError("format", customerrors.ViolationCodeRequired, "import format is not specified")
+}
+
+func parseCSV(reader io.Reader) ([]entities.ImportToyRow, []entities.ImportToyResult, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fileError(fileField, customerrors.ViolationCodeRequired, "CSV header is missing")
+	}
+
+	if err != nil {
+		return nil, nil, csvError(err)
+	}
+
+	columns, err := parseCSVHeader(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		rows     []entities.ImportToyRow
+		failures []entities.ImportToyResult
+	)
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if len(rows)+len(failures) >= MaxRows {
+			return nil, nil, tooManyRowsError()
+		}
+
+		var parseError *csv.ParseError
+		if errors.As(err, &parseError) && errors.Is(parseError.Err, csv.ErrFieldCount) {
+			failures = append(
+				failures,
+				failure(
+					uint64(parseError.StartLine),
+					rowField,
+					fmt.Sprintf("expected %d columns, got %d", len(header), len(record)),
+				),
+			)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, csvError(err)
+		}
+
+		line, _ := csvReader.FieldPos(0)
+
+		row, violations := parseCSVRecord(record, columns, uint64(line))
+		if len(violations) > 0 {
+			failures = append(failures, entities.ImportToyResult{Line: row.Line, Violations: violations})
+
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, failures, nil
+}
+
+// parseCSVHeader returns indexes of columns by their names.
+func parseCSVHeader(header []string) (map[string]int, error) {
+	var violations []customerrors.FieldViolation
+
+	columns := make(map[string]int, len(header))
+
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, byteOrderMark)))
+
+		if _, ok := columns[column]; ok {
+			violations = append(
+				violations,
+				customerrors.FieldViolation{
+					Field:   fileField,
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: fmt.Sprintf("duplicated column %q", column),
+				},
+			)
+		}
+
+		if !slices.Contains(requiredColumns(), column) && !slices.Contains(optionalColumns(), column) {
+			violations = append(
+				violations,
+				customerrors.FieldViolation{
+					Field:   fileField,
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: fmt.Sprintf("unknown column %q", column),
+				},
+			)
+		}
+
+		columns[column] = i
+	}
+
+	for _, column := range requiredColumns() {
+		if _, ok := columns[column]; !ok {
+			violations = append(
+				violations,
+				customerrors.FieldViolation{
+					Field:   fileField,
+					Code:    customerrors.ViolationCodeRequired,
+					Message: fmt.Sprintf("column %q is missing", column),
+				},
+			)
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, &customerrors.ValidationError{Violations: violations}
+	}
+
+	return columns, nil
+}
+
+func parseCSVRecord(
+	record []string,
+	columns map[string]int,
+	line uint64,
+) (entities.ImportToyRow, []customerrors.FieldViolation) {
+	value := func(column string) string {
+		index, ok := columns[column]
+		if !ok {
+			return ""
+		}
+
+		return strings.TrimSpace(record[index])
+	}
+
+	var violations []customerrors.FieldViolation
+
+	price, err := strconv.ParseFloat(value(columnPrice), 32)
+	if err != nil {
+		violations = append(
+			violations,
+			customerrors.FieldViolation{
+				Field:   columnPrice,
+				Code:    customerrors.ViolationCodeInvalidFormat,
+				Message: "invalid toy price",
+			},
+		)
+	}
+
+	quantity, err := strconv.ParseUint(value(columnQuantity), 10, 32)
+	if err != nil {
+		violations = append(
+			violations,
+			customerrors.FieldViolation{
+				Field:   columnQuantity,
+				Code:    customerrors.ViolationCodeInvalidFormat,
+				Message: "invalid toy quantity",
+			},
+		)
+	}
+
+	return entities.ImportToyRow{
+		Line:         line,
+		Name:         value(columnName),
+		Description:  value(columnDescription),
+		Price:        float32(price),
+		Quantity:     uint32(quantity),
+		CategoryName: value(columnCategory),
+		TagNames:     splitList(value(columnTags)),
+		Attachments:  splitList(value(columnAttachments)),
+	}, violations
+}
+
+func parseJSONL(reader io.Reader) ([]entities.ImportToyRow, []entities.ImportToyResult, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, maxLineSize)
+
+	var (
+		rows     []entities.ImportToyRow
+		failures []entities.ImportToyResult
+		line     uint64
+	)
+
+	for scanner.Scan() {
+		line++
+
+		data := bytes.TrimSpace(bytes.TrimPrefix(scanner.Bytes(), []byte(byteOrderMark)))
+		if len(data) == 0 {
+			continue
+		}
+
+		if len(rows)+len(failures) >= MaxRows {
+			return nil, nil, tooManyRowsError()
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+
+		var row jsonRow
+		if err := decoder.Decode(&row); err != nil {
+			failures = append(failures, failure(line, rowField, "invalid JSON: "+err.Error()))
+
+			continue
+		}
+
+		rows = append(
+			rows,
+			entities.ImportToyRow{
+				Line:         line,
+				Name:         row.Name,
+				Description:  row.Description,
+				Price:        row.Price,
+				Quantity:     row.Quantity,
+				CategoryName: row.Category,
+				TagNames:     row.Tags,
+				Attachments:  row.Attachments,
+			},
+		)
+	}
+
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, nil, fileError(
+				fileField,
+				customerrors.ViolationCodeOutOfRange,
+				fmt.Sprintf("line %d is longer than %d bytes", line+1, maxLineSize),
+			)
+		}
+
+		return nil, nil, err
+	}
+
+	return rows, failures, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, listSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func failure(line uint64, field, message string) entities.ImportToyResult {
+	return entities.ImportToyResult{
+		Line: line,
+		Violations: []customerrors.FieldViolation{
+			{
+				Field:   field,
+				Code:    customerrors.ViolationCodeInvalidFormat,
+				Message: message,
+			},
+		},
+	}
+}
+
+// csvError converts malformed CSV error to validation error. Errors of reading stream are returned as is.
+func csvError(err error) error {
+	var parseError *csv.ParseError
+	if errors.As(err, &parseError) {
+		return fileError(fileField, customerrors.ViolationCodeInvalidFormat, "malformed CSV: "+parseError.Error())
+	}
+
+	return err
+}
+
+func tooManyRowsError() error {
+	return fileError(
+		fileField,
+		customerrors.ViolationCodeOutOfRange,
+		fmt.Sprintf("too many rows, max %d rows can be imported at once", MaxRows),
+	)
+}
+
+func fileError(field, code, message string) error {
+	return &customerrors.ValidationError{
+		Violations: []customerrors.FieldViolation{
+			{
+				Field:   field,
+				Code:    code,
+				Message: message,
+			},
+		},
+	}
+}
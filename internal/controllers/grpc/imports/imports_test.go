@@ -0,0 +1,158 @@
+package imports_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/imports"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+func TestParseToys(t *testing.T) {
+	testCases := []struct {
+		name             string
+		format           toys.ImportFormat
+		file             string
+		expectedRows     []entities.ImportToyRow
+		expectedFailures []entities.ImportToyResult
+		errorExpected    bool
+	}{
+		{
+			name:   "CSV",
+			format: toys.ImportFormat_IMPORT_FORMAT_CSV,
+			file: "\ufeffName,Description,Price,Quantity,Category,Tags,Attachments\n" +
+				"Мишка,\"Вязаный мишка, 20 см\",1500.5,2,Игрушки,вязание; мишки,https://a.com/1.png;https://a.com/2.png\n" +
+				"Зайка,Вязаный зайка,дорого,1,Игрушки,,\n" +
+				"Лиса,Вязаная лиса,900\n",
+			expectedRows: []entities.ImportToyRow{
+				{
+					Line:         2,
+					Name:         "Мишка",
+					Description:  "Вязаный мишка, 20 см",
+					Price:        1500.5,
+					Quantity:     2,
+					CategoryName: "Игрушки",
+					TagNames:     []string{"вязание", "мишки"},
+					Attachments:  []string{"https://a.com/1.png", "https://a.com/2.png"},
+				},
+			},
+			expectedFailures: []entities.ImportToyResult{
+				{
+					Line: 3,
+					Violations: []customerrors.FieldViolation{
+						{Field: "price", Code: customerrors.ViolationCodeInvalidFormat, Message: "invalid toy price"},
+					},
+				},
+				{
+					Line: 4,
+					Violations: []customerrors.FieldViolation{
+						{Field: "row", Code: customerrors.ViolationCodeInvalidFormat, Message: "expected 7 columns, got 3"},
+					},
+				},
+			},
+		},
+		{
+			name:   "CSV without optional columns",
+			format: toys.ImportFormat_IMPORT_FORMAT_CSV,
+			file:   "category,quantity,price,description,name\nИгрушки,1,100,Вязаный зайка,Зайка\n",
+			expectedRows: []entities.ImportToyRow{
+				{
+					Line:         2,
+					Name:         "Зайка",
+					Description:  "Вязаный зайка",
+					Price:        100,
+					Quantity:     1,
+					CategoryName: "Игрушки",
+				},
+			},
+		},
+		{
+			name:          "CSV with unknown and missing columns",
+			format:        toys.ImportFormat_IMPORT_FORMAT_CSV,
+			file:          "name,description,price,quantity,color\n",
+			errorExpected: true,
+		},
+		{
+			name:          "empty CSV",
+			format:        toys.ImportFormat_IMPORT_FORMAT_CSV,
+			errorExpected: true,
+		},
+		{
+			name:          "malformed CSV",
+			format:        toys.ImportFormat_IMPORT_FORMAT_CSV,
+			file:          "name,description,price,quantity,category\n\"Зайка,Вязаный зайка,100,1,Игрушки\n",
+			errorExpected: true,
+		},
+		{
+			name:   "JSON Lines",
+			format: toys.ImportFormat_IMPORT_FORMAT_JSONL,
+			file: `{"name":"Мишка","description":"Вязаный мишка","price":1500.5,"quantity":2,"category":"Игрушки",` +
+				`"tags":["вязание"],"attachments":["https://a.com/1.png"]}` + "\n\n" +
+				`{"name":"Зайка","price":"дорого"}` + "\n" +
+				`{"name":"Лиса","colour":"рыжий"}`,
+			expectedRows: []entities.ImportToyRow{
+				{
+					Line:         1,
+					Name:         "Мишка",
+					Description:  "Вязаный мишка",
+					Price:        1500.5,
+					Quantity:     2,
+					CategoryName: "Игрушки",
+					TagNames:     []string{"вязание"},
+					Attachments:  []string{"https://a.com/1.png"},
+				},
+			},
+			expectedFailures: []entities.ImportToyResult{
+				{
+					Line: 3,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "row",
+							Code:    customerrors.ViolationCodeInvalidFormat,
+							Message: "invalid JSON: json: cannot unmarshal string into Go struct field jsonRow.price of type float32",
+						},
+					},
+				},
+				{
+					Line: 4,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "row",
+							Code:    customerrors.ViolationCodeInvalidFormat,
+							Message: `invalid JSON: json: unknown field "colour"`,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:          "too many rows",
+			format:        toys.ImportFormat_IMPORT_FORMAT_JSONL,
+			file:          strings.Repeat(`{"name":"Зайка"}`+"\n", imports.MaxRows+1),
+			errorExpected: true,
+		},
+		{
+			name:          "unspecified format",
+			format:        toys.ImportFormat_IMPORT_FORMAT_UNSPECIFIED,
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rows, failures, err := imports.ParseToys(strings.NewReader(tc.file), tc.format)
+			if tc.errorExpected {
+				require.ErrorAs(t, err, new(*customerrors.ValidationError))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedRows, rows)
+			require.Equal(t, tc.expectedFailures, failures)
+		})
+	}
+}
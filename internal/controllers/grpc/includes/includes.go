@@ -0,0 +1,42 @@
+package includes
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+// User path includes profile of User from SSO into response.
+const User = "user"
+
+// Validate checks, that include mask contains only supported paths.
+func Validate(mask *fieldmaskpb.FieldMask, supported ...string) error {
+	var violations []customerrors.FieldViolation
+
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(supported, path) {
+			violations = append(
+				violations,
+				customerrors.FieldViolation{
+					Field:   "include",
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: fmt.Sprintf("unsupported include path %q", path),
+				},
+			)
+		}
+	}
+
+	if len(violations) > 0 {
+		return &customerrors.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// Has checks, if path is included.
+func Has(mask *fieldmaskpb.FieldMask, path string) bool {
+	return slices.Contains(mask.GetPaths(), path)
+}
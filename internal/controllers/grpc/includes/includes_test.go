@@ -0,0 +1,49 @@
+package includes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/includes"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mask          *fieldmaskpb.FieldMask
+		errorExpected bool
+	}{
+		{
+			name: "without mask",
+		},
+		{
+			name: "supported path",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{includes.User}},
+		},
+		{
+			name:          "unsupported path",
+			mask:          &fieldmaskpb.FieldMask{Paths: []string{includes.User, "toys"}},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := includes.Validate(tc.mask, includes.User)
+			if tc.errorExpected {
+				require.ErrorAs(t, err, new(*customerrors.ValidationError))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHas(t *testing.T) {
+	require.False(t, includes.Has(nil, includes.User))
+	require.False(t, includes.Has(&fieldmaskpb.FieldMask{Paths: []string{"toys"}}, includes.User))
+	require.True(t, includes.Has(&fieldmaskpb.FieldMask{Paths: []string{includes.User}}, includes.User))
+}
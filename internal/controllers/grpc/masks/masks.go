@@ -0,0 +1,82 @@
+package masks
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+// Validate checks, that mask contains only supported paths. Field is a name of mask in request.
+// Nested paths are not supported, so only top-level fields can be listed.
+func Validate(mask *fieldmaskpb.FieldMask, field string, supported ...string) error {
+	var violations []customerrors.FieldViolation
+
+	for _, path := range mask.GetPaths() {
+		if !slices.Contains(supported, path) {
+			violations = append(
+				violations,
+				customerrors.FieldViolation{
+					Field:   field,
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: fmt.Sprintf("unsupported path %q", path),
+				},
+			)
+		}
+	}
+
+	if len(violations) > 0 {
+		return &customerrors.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+// IsEmpty checks, if mask has no paths, which means, that client has not provided it.
+func IsEmpty(mask *fieldmaskpb.FieldMask) bool {
+	return len(mask.GetPaths()) == 0
+}
+
+// Has checks, if path is in mask. Empty mask contains all paths.
+func Has(mask *fieldmaskpb.FieldMask, path string) bool {
+	return IsEmpty(mask) || slices.Contains(mask.GetPaths(), path)
+}
+
+// Fields returns names of all fields of message, which can be used as mask paths, except excluded ones.
+func Fields(message proto.Message, excluded ...string) []string {
+	descriptors := message.ProtoReflect().Descriptor().Fields()
+
+	fields := make([]string, 0, descriptors.Len())
+
+	for i := range descriptors.Len() {
+		name := string(descriptors.Get(i).Name())
+		if !slices.Contains(excluded, name) {
+			fields = append(fields, name)
+		}
+	}
+
+	return fields
+}
+
+// Prune clears fields of message, which are not in mask, except kept ones. Nothing is cleared, if mask is empty.
+func Prune(mask *fieldmaskpb.FieldMask, message proto.Message, kept ...string) {
+	if IsEmpty(mask) {
+		return
+	}
+
+	reflected := message.ProtoReflect()
+	reflected.Range(
+		func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			name := string(field.Name())
+			if !slices.Contains(mask.GetPaths(), name) && !slices.Contains(kept, name) {
+				reflected.Clear(field) // clearing of current field is allowed while ranging
+			}
+
+			return true
+		},
+	)
+}
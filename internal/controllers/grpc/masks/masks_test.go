@@ -0,0 +1,78 @@
+package masks_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masks"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mask          *fieldmaskpb.FieldMask
+		errorExpected bool
+	}{
+		{
+			name: "without mask",
+		},
+		{
+			name: "supported paths",
+			mask: &fieldmaskpb.FieldMask{Paths: []string{"name", "tags"}},
+		},
+		{
+			name:          "unsupported path",
+			mask:          &fieldmaskpb.FieldMask{Paths: []string{"name", "tags.name"}},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := masks.Validate(tc.mask, "readMask", "name", "tags")
+			if tc.errorExpected {
+				require.ErrorAs(t, err, new(*customerrors.ValidationError))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHas(t *testing.T) {
+	require.True(t, masks.Has(nil, "tags"))
+	require.True(t, masks.Has(&fieldmaskpb.FieldMask{Paths: []string{"tags"}}, "tags"))
+	require.False(t, masks.Has(&fieldmaskpb.FieldMask{Paths: []string{"name"}}, "tags"))
+}
+
+func TestFields(t *testing.T) {
+	require.Equal(
+		t,
+		[]string{"ID", "info", "shopName", "slug", "avatar", "banner", "city", "region", "policies", "socialLinks"},
+		masks.Fields(&toys.UpdateMasterIn{}, "shipsTo", "updateMask"),
+	)
+}
+
+func TestPrune(t *testing.T) {
+	toy := &toys.GetToyOut{
+		ID:          1,
+		Name:        "Мишка",
+		Price:       100,
+		Tags:        []*toys.GetTagOut{{ID: 1}},
+		Attachments: []*toys.Attachment{{ID: 1}},
+	}
+
+	masks.Prune(nil, toy)
+	require.Len(t, toy.GetTags(), 1)
+
+	masks.Prune(&fieldmaskpb.FieldMask{Paths: []string{"ID", "tags"}}, toy, "name")
+	require.Equal(t, uint64(1), toy.GetID())
+	require.Equal(t, "Мишка", toy.GetName())
+	require.Len(t, toy.GetTags(), 1)
+	require.Zero(t, toy.GetPrice())
+	require.Empty(t, toy.GetAttachments())
+}
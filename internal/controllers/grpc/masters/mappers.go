@@ -8,11 +8,173 @@ import (
 )
 
 func mapMasterToOut(master entities.Master) *toys.GetMasterOut {
-	return &toys.GetMasterOut{
-		ID:        master.ID,
-		UserID:    master.UserID,
-		Info:      master.Info,
-		CreatedAt: timestamppb.New(master.CreatedAt),
-		UpdatedAt: timestamppb.New(master.UpdatedAt),
+	out := &toys.GetMasterOut{
+		ID:             master.ID,
+		UserID:         master.UserID,
+		Info:           master.Info,
+		CreatedAt:      timestamppb.New(master.CreatedAt),
+		UpdatedAt:      timestamppb.New(master.UpdatedAt),
+		AverageRating:  master.AverageRating,
+		ReviewsCount:   master.ReviewsCount,
+		FollowersCount: master.FollowersCount,
+		ShopName:       master.ShopName,
+		Slug:           master.Slug,
+		Avatar:         master.Avatar,
+		Banner:         master.Banner,
+		City:           master.City,
+		Region:         master.Region,
+		Policies:       master.Policies,
+		SocialLinks:    master.SocialLinks,
+		ShipsTo:        master.ShipsTo,
+		Status:         mapMasterStatusToOut(master.Status),
 	}
+
+	if master.VacationUntil != nil {
+		out.VacationUntil = timestamppb.New(*master.VacationUntil)
+		out.VacationMessage = master.VacationMessage
+	}
+
+	return out
+}
+
+func mapUserToOut(user entities.User) *toys.User {
+	return &toys.User{
+		ID:          user.ID,
+		DisplayName: user.DisplayName,
+		Avatar:      user.Avatar,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+	}
+}
+
+func mapMastersFiltersIn(filters *toys.MastersFilters) *entities.MastersFilters {
+	if filters == nil {
+		return nil
+	}
+
+	return &entities.MastersFilters{
+		Search:              filters.Search,
+		Name:                filters.Name,
+		City:                filters.City,
+		Statuses:            mapMasterStatusesIn(filters.GetStatuses()),
+		CreatedAtOrderByAsc: filters.CreatedAtOrderByAsc,
+	}
+}
+
+func mapMasterStatusToOut(status entities.MasterStatus) toys.MasterStatus {
+	switch status {
+	case entities.MasterStatusPending:
+		return toys.MasterStatus_MASTER_STATUS_PENDING
+	case entities.MasterStatusVerified:
+		return toys.MasterStatus_MASTER_STATUS_VERIFIED
+	case entities.MasterStatusSuspended:
+		return toys.MasterStatus_MASTER_STATUS_SUSPENDED
+	case entities.MasterStatusBanned:
+		return toys.MasterStatus_MASTER_STATUS_BANNED
+	}
+
+	return toys.MasterStatus_MASTER_STATUS_UNSPECIFIED
+}
+
+// mapMasterStatusIn returns empty status for unspecified or unknown status for further validation by use cases.
+func mapMasterStatusIn(status toys.MasterStatus) entities.MasterStatus {
+	switch status {
+	case toys.MasterStatus_MASTER_STATUS_PENDING:
+		return entities.MasterStatusPending
+	case toys.MasterStatus_MASTER_STATUS_VERIFIED:
+		return entities.MasterStatusVerified
+	case toys.MasterStatus_MASTER_STATUS_SUSPENDED:
+		return entities.MasterStatusSuspended
+	case toys.MasterStatus_MASTER_STATUS_BANNED:
+		return entities.MasterStatusBanned
+	case toys.MasterStatus_MASTER_STATUS_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func mapMasterStatusesIn(statuses []toys.MasterStatus) []entities.MasterStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	processedStatuses := make([]entities.MasterStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if processedStatus := mapMasterStatusIn(status); processedStatus != "" {
+			processedStatuses = append(processedStatuses, processedStatus)
+		}
+	}
+
+	return processedStatuses
+}
+
+func mapMasterStatusChangeToOut(statusChange entities.MasterStatusChange) *toys.MasterStatusChange {
+	return &toys.MasterStatusChange{
+		ID:             statusChange.ID,
+		MasterID:       statusChange.MasterID,
+		PreviousStatus: mapMasterStatusToOut(statusChange.PreviousStatus),
+		Status:         mapMasterStatusToOut(statusChange.Status),
+		Reason:         statusChange.Reason,
+		CreatedAt:      timestamppb.New(statusChange.CreatedAt),
+		UpdatedAt:      timestamppb.New(statusChange.UpdatedAt),
+	}
+}
+
+func mapStatsPeriodIn(period *toys.StatsPeriod) *entities.StatsPeriod {
+	if period == nil {
+		return nil
+	}
+
+	return &entities.StatsPeriod{
+		From: period.GetFrom().AsTime(),
+		To:   period.GetTo().AsTime(),
+	}
+}
+
+func mapMasterStatsToOut(stats entities.MasterStats) *toys.GetMasterStatsOut {
+	newToysPerDay := make([]*toys.DailyToysCount, len(stats.NewToysPerDay))
+	for i, dailyCount := range stats.NewToysPerDay {
+		newToysPerDay[i] = &toys.DailyToysCount{
+			Date:  timestamppb.New(dailyCount.Date),
+			Count: dailyCount.Count,
+		}
+	}
+
+	priceDistribution := make([]*toys.PriceBucket, len(stats.PriceDistribution))
+	for i, bucket := range stats.PriceDistribution {
+		priceDistribution[i] = &toys.PriceBucket{
+			Floor: bucket.Floor,
+			Ceil:  bucket.Ceil,
+			Count: bucket.Count,
+		}
+	}
+
+	return &toys.GetMasterStatsOut{
+		MasterID:            stats.MasterID,
+		ToysCount:           stats.ToysCount,
+		InStockToysCount:    stats.InStockToysCount,
+		OutOfStockToysCount: stats.OutOfStockToysCount,
+		TotalStock:          stats.TotalStock,
+		StockValue:          stats.StockValue,
+		NewToysPerDay:       newToysPerDay,
+		MostFavouritedToys:  mapStatsToysToOut(stats.MostFavouritedToys),
+		HighestRatedToys:    mapStatsToysToOut(stats.HighestRatedToys),
+		PriceDistribution:   priceDistribution,
+	}
+}
+
+func mapStatsToysToOut(toysList []entities.Toy) []*toys.StatsToy {
+	processedToys := make([]*toys.StatsToy, len(toysList))
+	for i, toy := range toysList {
+		processedToys[i] = &toys.StatsToy{
+			ID:              toy.ID,
+			Name:            toy.Name,
+			Price:           toy.Price,
+			Quantity:        toy.Quantity,
+			FavouritesCount: toy.FavouritesCount,
+			AverageRating:   toy.AverageRating,
+			ReviewsCount:    toy.ReviewsCount,
+		}
+	}
+
+	return processedToys
 }
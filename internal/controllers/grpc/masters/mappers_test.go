@@ -15,11 +15,19 @@ import (
 var (
 	now          = time.Now()
 	mappedMaster = &toys.GetMasterOut{
-		ID:        masterID,
-		UserID:    userID,
-		Info:      pointers.New[string]("test"),
-		CreatedAt: timestamppb.New(now),
-		UpdatedAt: timestamppb.New(now),
+		ID:             masterID,
+		UserID:         userID,
+		Info:           pointers.New[string]("test"),
+		CreatedAt:      timestamppb.New(now),
+		UpdatedAt:      timestamppb.New(now),
+		AverageRating:  4.5,
+		ReviewsCount:   2,
+		FollowersCount: 3,
+		ShopName:       pointers.New[string]("test shop"),
+		Slug:           pointers.New[string]("test-shop"),
+		City:           pointers.New[string]("test city"),
+		SocialLinks:    []string{"https://vk.com/test-shop"},
+		Status:         toys.MasterStatus_MASTER_STATUS_VERIFIED,
 	}
 )
 
@@ -34,6 +42,25 @@ func TestMapToyToOut(t *testing.T) {
 			master:   *master,
 			expected: mappedMaster,
 		},
+		{
+			name: "Master on vacation",
+			master: entities.Master{
+				ID:              masterID,
+				UserID:          userID,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+				VacationUntil:   &now,
+				VacationMessage: pointers.New[string]("test"),
+			},
+			expected: &toys.GetMasterOut{
+				ID:              masterID,
+				UserID:          userID,
+				CreatedAt:       timestamppb.New(now),
+				UpdatedAt:       timestamppb.New(now),
+				VacationUntil:   timestamppb.New(now),
+				VacationMessage: pointers.New[string]("test"),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -43,3 +70,88 @@ func TestMapToyToOut(t *testing.T) {
 		})
 	}
 }
+
+func TestMapMastersFiltersIn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filters  *toys.MastersFilters
+		expected *entities.MastersFilters
+	}{
+		{
+			name:     "nil filters",
+			filters:  nil,
+			expected: nil,
+		},
+		{
+			name: "success",
+			filters: &toys.MastersFilters{
+				Search:              pointers.New[string]("test"),
+				Name:                pointers.New[string]("test shop"),
+				City:                pointers.New[string]("test city"),
+				CreatedAtOrderByAsc: pointers.New[bool](true),
+				Statuses: []toys.MasterStatus{
+					toys.MasterStatus_MASTER_STATUS_VERIFIED,
+					toys.MasterStatus_MASTER_STATUS_UNSPECIFIED,
+					toys.MasterStatus_MASTER_STATUS_SUSPENDED,
+				},
+			},
+			expected: &entities.MastersFilters{
+				Search:              pointers.New[string]("test"),
+				Name:                pointers.New[string]("test shop"),
+				City:                pointers.New[string]("test city"),
+				Statuses:            []entities.MasterStatus{entities.MasterStatusVerified, entities.MasterStatusSuspended},
+				CreatedAtOrderByAsc: pointers.New[bool](true),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := mapMastersFiltersIn(tc.filters)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMapMasterStatsToOut(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stats    entities.MasterStats
+		expected *toys.GetMasterStatsOut
+	}{
+		{
+			name: "success",
+			stats: entities.MasterStats{
+				MasterID:            masterID,
+				ToysCount:           2,
+				InStockToysCount:    1,
+				OutOfStockToysCount: 1,
+				TotalStock:          3,
+				StockValue:          300,
+				NewToysPerDay:       []entities.DailyToysCount{{Date: now, Count: 2}},
+				MostFavouritedToys:  []entities.Toy{{ID: 1, Name: "toy", Price: 100, Quantity: 3, FavouritesCount: 4}},
+				HighestRatedToys:    []entities.Toy{{ID: 1, Name: "toy", AverageRating: 4.5, ReviewsCount: 2}},
+				PriceDistribution:   []entities.PriceBucket{{Floor: 100, Ceil: 200, Count: 2}},
+			},
+			expected: &toys.GetMasterStatsOut{
+				MasterID:            masterID,
+				ToysCount:           2,
+				InStockToysCount:    1,
+				OutOfStockToysCount: 1,
+				TotalStock:          3,
+				StockValue:          300,
+				NewToysPerDay:       []*toys.DailyToysCount{{Date: timestamppb.New(now), Count: 2}},
+				MostFavouritedToys:  []*toys.StatsToy{{ID: 1, Name: "toy", Price: 100, Quantity: 3, FavouritesCount: 4}},
+				HighestRatedToys:    []*toys.StatsToy{{ID: 1, Name: "toy", AverageRating: 4.5, ReviewsCount: 2}},
+				PriceDistribution:   []*toys.PriceBucket{{Floor: 100, Ceil: 200, Count: 2}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := mapMasterStatsToOut(tc.stats)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
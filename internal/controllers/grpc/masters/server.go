@@ -2,26 +2,23 @@ package masters
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
-
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/includes"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masks"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/statuses"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
-	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
 )
 
-var (
-	masterNotFoundError      = &customerrors.MasterNotFoundError{}
-	masterAlreadyExistsError = &customerrors.MasterAlreadyExistsError{}
-)
+// exportChunkSize is a max size of User's data archive chunk, which is far below default gRPC message size limit.
+const exportChunkSize = 64 * 1024
 
 // RegisterServer handler (serverAPI) for MastersServer to gRPC server:.
 func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
@@ -36,13 +33,7 @@ type ServerAPI struct {
 }
 
 func (api *ServerAPI) CountMasters(ctx context.Context, in *toys.CountMastersIn) (*toys.CountOut, error) {
-	var filters *entities.MastersFilters
-	if in.GetFilters() != nil {
-		filters = &entities.MastersFilters{
-			Search:              in.Filters.Search,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
+	filters := mapMastersFiltersIn(in.GetFilters())
 
 	count, err := api.useCases.CountMasters(ctx, filters)
 	if err != nil {
@@ -53,22 +44,49 @@ func (api *ServerAPI) CountMasters(ctx context.Context, in *toys.CountMastersIn)
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.CountOut{Count: count}, nil
 }
 
+// UpdateMaster handler changes profile fields of Master, which are listed in update mask, or all provided fields
+// without mask.
 func (api *ServerAPI) UpdateMaster(
 	ctx context.Context,
 	in *toys.UpdateMasterIn,
 ) (*emptypb.Empty, error) {
+	if err := masks.Validate(
+		in.GetUpdateMask(),
+		"updateMask",
+		masks.Fields(&toys.UpdateMasterIn{}, "ID", "updateMask")...,
+	); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid update mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
 	masterData := entities.UpdateMasterDTO{
-		ID: in.GetID(),
+		ID:          in.GetID(),
+		SocialLinks: in.GetSocialLinks(),
+		ShipsTo:     in.GetShipsTo(),
 	}
 
 	if in != nil {
 		masterData.Info = in.Info
+		masterData.ShopName = in.ShopName
+		masterData.Slug = in.Slug
+		masterData.Avatar = in.Avatar
+		masterData.Banner = in.Banner
+		masterData.City = in.City
+		masterData.Region = in.Region
+		masterData.Policies = in.Policies
+	}
+
+	// Without mask only provided fields are changed, and empty lists are ignored, because they can't be told apart
+	// from not provided ones:
+	if !masks.IsEmpty(in.GetUpdateMask()) {
+		masterData.UpdateMask = in.GetUpdateMask().GetPaths()
 	}
 
 	if err := api.useCases.UpdateMaster(ctx, masterData); err != nil {
@@ -79,21 +97,198 @@ func (api *ServerAPI) UpdateMaster(
 			err,
 		)
 
-		switch {
-		case errors.As(err, &masterNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// GetMasterStats handler returns aggregated statistics of Toys of Master with provided ID.
+func (api *ServerAPI) GetMasterStats(
+	ctx context.Context,
+	in *toys.GetMasterStatsIn,
+) (*toys.GetMasterStatsOut, error) {
+	stats, err := api.useCases.GetMasterStats(ctx, in.GetMasterID(), mapStatsPeriodIn(in.GetPeriod()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get stats for Master with ID=%d", in.GetMasterID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return mapMasterStatsToOut(*stats), nil
+}
+
+// SetVacationMode handler enables vacation mode of Master till provided time or disables it, if time is not provided.
+func (api *ServerAPI) SetVacationMode(ctx context.Context, in *toys.SetVacationModeIn) (*emptypb.Empty, error) {
+	vacationData := entities.SetVacationModeDTO{
+		MasterID: in.GetMasterID(),
+	}
+
+	if in.GetUntil() != nil {
+		until := in.GetUntil().AsTime()
+		vacationData.Until = &until
+	}
+
+	if in != nil {
+		vacationData.Message = in.Message
+	}
+
+	if err := api.useCases.SetVacationMode(ctx, vacationData); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to set vacation mode for Master with ID=%d", in.GetMasterID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ChangeMasterStatus handler changes moderation status of Master with provided reason.
+func (api *ServerAPI) ChangeMasterStatus(ctx context.Context, in *toys.ChangeMasterStatusIn) (*emptypb.Empty, error) {
+	statusData := entities.ChangeMasterStatusDTO{
+		MasterID: in.GetMasterID(),
+		Status:   mapMasterStatusIn(in.GetStatus()),
+		Reason:   in.GetReason(),
+	}
+
+	if err := api.useCases.ChangeMasterStatus(ctx, statusData); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to change status of Master with ID=%d", in.GetMasterID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetMasterStatusHistory handler returns all moderation status changes of Master.
+func (api *ServerAPI) GetMasterStatusHistory(
+	ctx context.Context,
+	in *toys.GetMasterStatusHistoryIn,
+) (*toys.GetMasterStatusHistoryOut, error) {
+	history, err := api.useCases.GetMasterStatusHistory(ctx, in.GetMasterID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get status history of Master with ID=%d", in.GetMasterID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	changes := make([]*toys.MasterStatusChange, len(history))
+	for i, statusChange := range history {
+		changes[i] = mapMasterStatusChangeToOut(statusChange)
+	}
+
+	return &toys.GetMasterStatusHistoryOut{Changes: changes}, nil
+}
+
+// GetMasterBySlug handler returns Master for provided shop slug.
+// ExportUserData streams JSON archive of User's data in chunks, so archives of large shops don't exceed
+// gRPC message size limit.
+func (api *ServerAPI) ExportUserData(
+	in *toys.ExportUserDataIn,
+	stream toys.MastersService_ExportUserDataServer,
+) error {
+	ctx := stream.Context()
+
+	export, err := api.useCases.ExportUserData(ctx, in.GetUserID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to export data of User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return statuses.FromError(ctx, err)
+	}
+
+	archive, err := json.Marshal(export)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to marshal data of User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return statuses.FromError(ctx, err)
+	}
+
+	for offset := 0; offset < len(archive); offset += exportChunkSize {
+		chunk := archive[offset:min(offset+exportChunkSize, len(archive))]
+		if err = stream.Send(&toys.ExportUserDataOut{Chunk: chunk}); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				api.logger,
+				fmt.Sprintf("Error occurred while trying to send data of User with ID=%d", in.GetUserID()),
+				err,
+			)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (api *ServerAPI) GetMasterBySlug(
+	ctx context.Context,
+	in *toys.GetMasterBySlugIn,
+) (*toys.GetMasterOut, error) {
+	if err := includes.Validate(in.GetInclude(), includes.User); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid include mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	master, err := api.useCases.GetMasterBySlug(ctx, in.GetSlug())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to get Master with slug="+in.GetSlug(),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	processedMaster := mapMasterToOut(*master)
+	if includes.Has(in.GetInclude(), includes.User) {
+		api.includeUsers(ctx, processedMaster)
+	}
+
+	return processedMaster, nil
+}
+
 func (api *ServerAPI) GetMasterByUser(
 	ctx context.Context,
 	in *toys.GetMasterByUserIn,
 ) (*toys.GetMasterOut, error) {
+	if err := includes.Validate(in.GetInclude(), includes.User); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid include mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
 	master, err := api.useCases.GetMasterByUserID(ctx, in.GetUserID())
 	if err != nil {
 		logging.LogErrorContext(
@@ -106,15 +301,15 @@ func (api *ServerAPI) GetMasterByUser(
 			err,
 		)
 
-		switch {
-		case errors.As(err, &masterNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
-	return mapMasterToOut(*master), nil
+	processedMaster := mapMasterToOut(*master)
+	if includes.Has(in.GetInclude(), includes.User) {
+		api.includeUsers(ctx, processedMaster)
+	}
+
+	return processedMaster, nil
 }
 
 // GetMaster handler returns Master for provided ID.
@@ -122,6 +317,12 @@ func (api *ServerAPI) GetMaster(
 	ctx context.Context,
 	in *toys.GetMasterIn,
 ) (*toys.GetMasterOut, error) {
+	if err := includes.Validate(in.GetInclude(), includes.User); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid include mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
 	master, err := api.useCases.GetMasterByID(ctx, in.GetID())
 	if err != nil {
 		logging.LogErrorContext(
@@ -131,19 +332,25 @@ func (api *ServerAPI) GetMaster(
 			err,
 		)
 
-		switch {
-		case errors.As(err, &masterNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	processedMaster := mapMasterToOut(*master)
+	if includes.Has(in.GetInclude(), includes.User) {
+		api.includeUsers(ctx, processedMaster)
 	}
 
-	return mapMasterToOut(*master), nil
+	return processedMaster, nil
 }
 
 // GetMasters handler returns all Masters.
 func (api *ServerAPI) GetMasters(ctx context.Context, in *toys.GetMastersIn) (*toys.GetMastersOut, error) {
+	if err := includes.Validate(in.GetInclude(), includes.User); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid include mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
 	var pagination *entities.Pagination
 	if in.GetPagination() != nil {
 		pagination = &entities.Pagination{
@@ -152,13 +359,7 @@ func (api *ServerAPI) GetMasters(ctx context.Context, in *toys.GetMastersIn) (*t
 		}
 	}
 
-	var filters *entities.MastersFilters
-	if in.GetFilters() != nil {
-		filters = &entities.MastersFilters{
-			Search:              in.Filters.Search,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
+	filters := mapMastersFiltersIn(in.GetFilters())
 
 	masters, err := api.useCases.GetMasters(ctx, pagination, filters)
 	if err != nil {
@@ -169,7 +370,7 @@ func (api *ServerAPI) GetMasters(ctx context.Context, in *toys.GetMastersIn) (*t
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	processedMasters := make([]*toys.GetMasterOut, len(masters))
@@ -177,6 +378,10 @@ func (api *ServerAPI) GetMasters(ctx context.Context, in *toys.GetMastersIn) (*t
 		processedMasters[i] = mapMasterToOut(master)
 	}
 
+	if includes.Has(in.GetInclude(), includes.User) {
+		api.includeUsers(ctx, processedMasters...)
+	}
+
 	return &toys.GetMastersOut{Masters: processedMasters}, nil
 }
 
@@ -202,13 +407,140 @@ func (api *ServerAPI) RegisterMaster(
 			err,
 		)
 
-		switch {
-		case errors.As(err, &masterAlreadyExistsError):
-			return nil, &customgrpc.BaseError{Status: codes.AlreadyExists, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.RegisterMasterOut{MasterID: masterID}, nil
 }
+
+// FollowMaster handler subscribes User to new Toys of Master.
+func (api *ServerAPI) FollowMaster(ctx context.Context, in *toys.FollowMasterIn) (*toys.FollowMasterOut, error) {
+	followID, err := api.useCases.FollowMaster(ctx, in.GetUserID(), in.GetMasterID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to follow Master with ID=%d for User with ID=%d",
+				in.GetMasterID(),
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.FollowMasterOut{FollowID: followID}, nil
+}
+
+// UnfollowMaster handler unsubscribes User from new Toys of Master.
+func (api *ServerAPI) UnfollowMaster(ctx context.Context, in *toys.UnfollowMasterIn) (*emptypb.Empty, error) {
+	if err := api.useCases.UnfollowMaster(ctx, in.GetUserID(), in.GetMasterID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to unfollow Master with ID=%d for User with ID=%d",
+				in.GetMasterID(),
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetFollowedMasters handler returns all Masters, which are followed by User with provided ID.
+func (api *ServerAPI) GetFollowedMasters(
+	ctx context.Context,
+	in *toys.GetFollowedMastersIn,
+) (*toys.GetMastersOut, error) {
+	if err := includes.Validate(in.GetInclude(), includes.User); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid include mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	masters, err := api.useCases.GetFollowedMasters(ctx, in.GetUserID(), pagination)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get followed Masters for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	processedMasters := make([]*toys.GetMasterOut, len(masters))
+	for i, master := range masters {
+		processedMasters[i] = mapMasterToOut(master)
+	}
+
+	if includes.Has(in.GetInclude(), includes.User) {
+		api.includeUsers(ctx, processedMasters...)
+	}
+
+	return &toys.GetMastersOut{Masters: processedMasters}, nil
+}
+
+func (api *ServerAPI) CountFollowedMasters(
+	ctx context.Context,
+	in *toys.CountFollowedMastersIn,
+) (*toys.CountOut, error) {
+	count, err := api.useCases.CountFollowedMasters(ctx, in.GetUserID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to count followed Masters for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.CountOut{Count: count}, nil
+}
+
+// includeUsers sets Users of Masters with single batched lookup. Masters are more important than their Users,
+// so if SSO fails, error is logged and Masters are returned without Users.
+func (api *ServerAPI) includeUsers(ctx context.Context, masters ...*toys.GetMasterOut) {
+	if len(masters) == 0 {
+		return
+	}
+
+	usersIDs := make([]uint64, len(masters))
+	for i, master := range masters {
+		usersIDs[i] = master.GetUserID()
+	}
+
+	users, err := api.useCases.GetUsersByIDs(ctx, usersIDs)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			"Error occurred while trying to get Users of Masters, some Users are not included",
+			err,
+		)
+	}
+
+	for _, master := range masters {
+		if user, ok := users[master.GetUserID()]; ok {
+			master.User = mapUserToOut(user)
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package masters
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
@@ -11,21 +15,46 @@ import (
 	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
 	mocklogger "github.com/DKhorkov/libs/logging/mocks"
 	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/validation"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
 	ctx    = context.Background()
 	master = &entities.Master{
-		ID:        masterID,
-		UserID:    masterID,
-		Info:      pointers.New[string]("test"),
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:             masterID,
+		UserID:         masterID,
+		Info:           pointers.New[string]("test"),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		AverageRating:  4.5,
+		ReviewsCount:   2,
+		FollowersCount: 3,
+		ShopName:       pointers.New[string]("test shop"),
+		Slug:           pointers.New[string]("test-shop"),
+		City:           pointers.New[string]("test city"),
+		Status:         entities.MasterStatusVerified,
+		SocialLinks:    []string{"https://vk.com/test-shop"},
 	}
+	user = entities.User{
+		ID:          userID,
+		DisplayName: "test user",
+		Avatar:      pointers.New[string]("https://avatar.com/test-user.png"),
+		CreatedAt:   now,
+	}
+	mappedUser = &toys.User{
+		ID:          userID,
+		DisplayName: "test user",
+		Avatar:      pointers.New[string]("https://avatar.com/test-user.png"),
+		CreatedAt:   timestamppb.New(now),
+	}
+	includeUser = &fieldmaskpb.FieldMask{Paths: []string{"user"}}
 )
 
 const (
@@ -33,6 +62,13 @@ const (
 	userID   uint64 = 1
 )
 
+func mappedMasterWithUser() *toys.GetMasterOut {
+	out := mapMasterToOut(*master)
+	out.User = mappedUser
+
+	return out
+}
+
 func TestMastersServer_GetMaster(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -56,6 +92,68 @@ func TestMastersServer_GetMaster(t *testing.T) {
 			},
 			expected: mappedMaster,
 		},
+		{
+			name: "success with User",
+			in: &toys.GetMasterIn{
+				ID:      masterID,
+				Include: includeUser,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(master, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetUsersByIDs(gomock.Any(), []uint64{userID}).
+					Return(map[uint64]entities.User{userID: user}, nil).
+					Times(1)
+			},
+			expected: mappedMasterWithUser(),
+		},
+		{
+			name: "without User, when SSO is unavailable",
+			in: &toys.GetMasterIn{
+				ID:      masterID,
+				Include: includeUser,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(master, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetUsersByIDs(gomock.Any(), []uint64{userID}).
+					Return(map[uint64]entities.User{}, errors.New("sso is unavailable")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expected: mappedMaster,
+		},
+		{
+			name: "unsupported include path",
+			in: &toys.GetMasterIn{
+				ID:      masterID,
+				Include: &fieldmaskpb.FieldMask{Paths: []string{"toys"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
 		{
 			name: "Master not found",
 			in: &toys.GetMasterIn{
@@ -217,6 +315,98 @@ func TestMastersServer_GetMasterByUser(t *testing.T) {
 	}
 }
 
+func TestMastersServer_GetMasterBySlug(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetMasterBySlugIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetMasterOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.GetMasterBySlugIn{
+				Slug: "test-shop",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "test-shop").
+					Return(master, nil).
+					Times(1)
+			},
+			expected: mappedMaster,
+		},
+		{
+			name: "Master not found",
+			in: &toys.GetMasterBySlugIn{
+				Slug: "test-shop",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "test-shop").
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in: &toys.GetMasterBySlugIn{
+				Slug: "test-shop",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "test-shop").
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := mastersServer.GetMasterBySlug(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestMastersServer_GetMasters(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -266,6 +456,76 @@ func TestMastersServer_GetMasters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "success with Users",
+			in: &toys.GetMastersIn{
+				Include: includeUser,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasters(gomock.Any(), nil, nil).
+					Return([]entities.Master{*master, *master}, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetUsersByIDs(gomock.Any(), []uint64{userID, userID}).
+					Return(map[uint64]entities.User{userID: user}, nil).
+					Times(1)
+			},
+			expected: &toys.GetMastersOut{
+				Masters: []*toys.GetMasterOut{
+					mappedMasterWithUser(),
+					mappedMasterWithUser(),
+				},
+			},
+		},
+		{
+			name: "without Users, when SSO is unavailable",
+			in: &toys.GetMastersIn{
+				Include: includeUser,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasters(gomock.Any(), nil, nil).
+					Return([]entities.Master{*master}, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetUsersByIDs(gomock.Any(), []uint64{userID}).
+					Return(nil, errors.New("sso is unavailable")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expected: &toys.GetMastersOut{
+				Masters: []*toys.GetMasterOut{
+					mappedMaster,
+				},
+			},
+		},
+		{
+			name: "without Users for empty page",
+			in: &toys.GetMastersIn{
+				Include: includeUser,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasters(gomock.Any(), nil, nil).
+					Return([]entities.Master{}, nil).
+					Times(1)
+			},
+			expected: &toys.GetMastersOut{
+				Masters: []*toys.GetMasterOut{},
+			},
+		},
 		{
 			name: "error",
 			in: &toys.GetMastersIn{
@@ -483,6 +743,44 @@ func TestMastersServer_RegisterMaster(t *testing.T) {
 			errorExpected: true,
 			errorCode:     codes.AlreadyExists,
 		},
+		{
+			name: "field violations",
+			in: &toys.RegisterMasterIn{
+				UserID: userID,
+				Info:   pointers.New[string]("test"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RegisterMaster(
+						gomock.Any(),
+						entities.RegisterMasterDTO{
+							UserID: userID,
+							Info:   pointers.New[string]("test"),
+						},
+					).
+					Return(
+						uint64(0),
+						&customerrors.ValidationError{
+							Violations: []customerrors.FieldViolation{
+								{
+									Field:   "info",
+									Code:    customerrors.ViolationCodeForbiddenWords,
+									Message: "master info contains forbidden words",
+								},
+							},
+						},
+					).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
 		{
 			name: "internal error",
 			in: &toys.RegisterMasterIn{
@@ -567,6 +865,44 @@ func TestMastersServer_UpdateMaster(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name: "success with update mask",
+			in: &toys.UpdateMasterIn{
+				ID:         masterID,
+				Info:       pointers.New[string]("test"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"info", "banner"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:         masterID,
+							Info:       pointers.New[string]("test"),
+							UpdateMask: []string{entities.MasterFieldInfo, entities.MasterFieldBanner},
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid update mask",
+			in: &toys.UpdateMasterIn{
+				ID:         masterID,
+				Info:       pointers.New[string]("test"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"userID"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
 		{
 			name: "Master not found",
 			in: &toys.UpdateMasterIn{
@@ -621,15 +957,103 @@ func TestMastersServer_UpdateMaster(t *testing.T) {
 			errorExpected: true,
 			errorCode:     codes.Internal,
 		},
-	}
+		{
+			name: "validation error",
+			in: &toys.UpdateMasterIn{
+				ID:   masterID,
+				Slug: pointers.New[string]("Invalid Slug"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:   masterID,
+							Slug: pointers.New[string]("Invalid Slug"),
+						},
+					).
+					Return(&validation.Error{}).
+					Times(1)
 
-	ctrl := gomock.NewController(t)
-	useCases := mockusecases.NewMockUseCases(ctrl)
-	logger := mocklogger.NewMockLogger(ctrl)
-	mastersServer := &ServerAPI{
-		logger:   logger,
-		useCases: useCases,
-	}
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "field violations",
+			in: &toys.UpdateMasterIn{
+				ID:   masterID,
+				Slug: pointers.New[string]("Invalid Slug"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:   masterID,
+							Slug: pointers.New[string]("Invalid Slug"),
+						},
+					).
+					Return(&customerrors.ValidationError{
+						Violations: []customerrors.FieldViolation{
+							{Field: "info", Code: customerrors.ViolationCodeInvalidFormat, Message: "invalid master info"},
+							{Field: "slug", Code: customerrors.ViolationCodeInvalidFormat, Message: "invalid master slug"},
+						},
+					}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "slug already exists",
+			in: &toys.UpdateMasterIn{
+				ID:          masterID,
+				Slug:        pointers.New[string]("test-shop"),
+				SocialLinks: []string{"https://vk.com/test-shop"},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:          masterID,
+							Slug:        pointers.New[string]("test-shop"),
+							SocialLinks: []string{"https://vk.com/test-shop"},
+						},
+					).
+					Return(&customerrors.MasterSlugAlreadyExistsError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.AlreadyExists,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -647,3 +1071,888 @@ func TestMastersServer_UpdateMaster(t *testing.T) {
 		})
 	}
 }
+
+func TestMastersServer_FollowMaster(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.FollowMasterIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.FollowMasterOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.FollowMasterIn{
+				UserID:   userID,
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					FollowMaster(gomock.Any(), userID, masterID).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: &toys.FollowMasterOut{FollowID: 1},
+		},
+		{
+			name: "Master not found",
+			in: &toys.FollowMasterIn{
+				UserID:   userID,
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					FollowMaster(gomock.Any(), userID, masterID).
+					Return(uint64(0), &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "Follow already exists",
+			in: &toys.FollowMasterIn{
+				UserID:   userID,
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					FollowMaster(gomock.Any(), userID, masterID).
+					Return(uint64(0), &customerrors.FollowAlreadyExistsError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.AlreadyExists,
+		},
+		{
+			name: "internal error",
+			in: &toys.FollowMasterIn{
+				UserID:   userID,
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					FollowMaster(gomock.Any(), userID, masterID).
+					Return(uint64(0), errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := mastersServer.FollowMaster(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMastersServer_UnfollowMaster(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.UnfollowMasterIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.UnfollowMasterIn{
+				UserID:   userID,
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UnfollowMaster(gomock.Any(), userID, masterID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Follow not found",
+			in: &toys.UnfollowMasterIn{
+				UserID:   userID,
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UnfollowMaster(gomock.Any(), userID, masterID).
+					Return(&customerrors.FollowNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := mastersServer.UnfollowMaster(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMastersServer_GetFollowedMasters(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetFollowedMastersIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetMastersOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.GetFollowedMastersIn{UserID: userID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetFollowedMasters(gomock.Any(), userID, nil).
+					Return([]entities.Master{*master}, nil).
+					Times(1)
+			},
+			expected: &toys.GetMastersOut{Masters: []*toys.GetMasterOut{mappedMaster}},
+		},
+		{
+			name: "internal error",
+			in:   &toys.GetFollowedMastersIn{UserID: userID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetFollowedMasters(gomock.Any(), userID, nil).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := mastersServer.GetFollowedMasters(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMastersServer_GetMasterStats(t *testing.T) {
+	stats := &entities.MasterStats{
+		MasterID:         masterID,
+		ToysCount:        2,
+		InStockToysCount: 2,
+		TotalStock:       5,
+		StockValue:       500,
+	}
+
+	testCases := []struct {
+		name          string
+		in            *toys.GetMasterStatsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetMasterStatsOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.GetMasterStatsIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStats(gomock.Any(), masterID, nil).
+					Return(stats, nil).
+					Times(1)
+			},
+			expected: mapMasterStatsToOut(*stats),
+		},
+		{
+			name: "invalid period",
+			in: &toys.GetMasterStatsIn{
+				MasterID: masterID,
+				Period: &toys.StatsPeriod{
+					From: timestamppb.New(now),
+					To:   timestamppb.New(now),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStats(
+						gomock.Any(),
+						masterID,
+						&entities.StatsPeriod{
+							From: timestamppb.New(now).AsTime(),
+							To:   timestamppb.New(now).AsTime(),
+						},
+					).
+					Return(nil, &validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Master not found",
+			in: &toys.GetMasterStatsIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStats(gomock.Any(), masterID, nil).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in: &toys.GetMasterStatsIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStats(gomock.Any(), masterID, nil).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := mastersServer.GetMasterStats(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMastersServer_SetVacationMode(t *testing.T) {
+	until := now.Add(24 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		in            *toys.SetVacationModeIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.SetVacationModeIn{
+				MasterID: masterID,
+				Until:    timestamppb.New(until),
+				Message:  pointers.New[string]("test"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					SetVacationMode(
+						gomock.Any(),
+						entities.SetVacationModeDTO{
+							MasterID: masterID,
+							Until:    pointers.New(timestamppb.New(until).AsTime()),
+							Message:  pointers.New[string]("test"),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "success disabling vacation",
+			in: &toys.SetVacationModeIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					SetVacationMode(gomock.Any(), entities.SetVacationModeDTO{MasterID: masterID}).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "validation error",
+			in: &toys.SetVacationModeIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					SetVacationMode(gomock.Any(), entities.SetVacationModeDTO{MasterID: masterID}).
+					Return(&validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Master not found",
+			in: &toys.SetVacationModeIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					SetVacationMode(gomock.Any(), entities.SetVacationModeDTO{MasterID: masterID}).
+					Return(&customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in: &toys.SetVacationModeIn{
+				MasterID: masterID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					SetVacationMode(gomock.Any(), entities.SetVacationModeDTO{MasterID: masterID}).
+					Return(errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := mastersServer.SetVacationMode(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMastersServer_ChangeMasterStatus(t *testing.T) {
+	statusData := entities.ChangeMasterStatusDTO{
+		MasterID: masterID,
+		Status:   entities.MasterStatusBanned,
+		Reason:   "test",
+	}
+
+	testCases := []struct {
+		name          string
+		in            *toys.ChangeMasterStatusIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.ChangeMasterStatusIn{
+				MasterID: masterID,
+				Status:   toys.MasterStatus_MASTER_STATUS_BANNED,
+				Reason:   "test",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeMasterStatus(gomock.Any(), statusData).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "validation error",
+			in: &toys.ChangeMasterStatusIn{
+				MasterID: masterID,
+				Reason:   "test",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: masterID,
+							Reason:   "test",
+						},
+					).
+					Return(&validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Master not found",
+			in: &toys.ChangeMasterStatusIn{
+				MasterID: masterID,
+				Status:   toys.MasterStatus_MASTER_STATUS_BANNED,
+				Reason:   "test",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeMasterStatus(gomock.Any(), statusData).
+					Return(&customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in: &toys.ChangeMasterStatusIn{
+				MasterID: masterID,
+				Status:   toys.MasterStatus_MASTER_STATUS_BANNED,
+				Reason:   "test",
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ChangeMasterStatus(gomock.Any(), statusData).
+					Return(errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := mastersServer.ChangeMasterStatus(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMastersServer_GetMasterStatusHistory(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetMasterStatusHistoryIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetMasterStatusHistoryOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.GetMasterStatusHistoryIn{MasterID: masterID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(
+						[]entities.MasterStatusChange{
+							{
+								ID:             1,
+								MasterID:       masterID,
+								PreviousStatus: entities.MasterStatusPending,
+								Status:         entities.MasterStatusVerified,
+								Reason:         "test",
+								CreatedAt:      now,
+								UpdatedAt:      now,
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected: &toys.GetMasterStatusHistoryOut{
+				Changes: []*toys.MasterStatusChange{
+					{
+						ID:             1,
+						MasterID:       masterID,
+						PreviousStatus: toys.MasterStatus_MASTER_STATUS_PENDING,
+						Status:         toys.MasterStatus_MASTER_STATUS_VERIFIED,
+						Reason:         "test",
+						CreatedAt:      timestamppb.New(now),
+						UpdatedAt:      timestamppb.New(now),
+					},
+				},
+			},
+		},
+		{
+			name: "Master not found",
+			in:   &toys.GetMasterStatusHistoryIn{MasterID: masterID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in:   &toys.GetMasterStatusHistoryIn{MasterID: masterID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := mastersServer.GetMasterStatusHistory(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+// exportStream collects chunks, sent by ExportUserData.
+type exportStream struct {
+	grpc.ServerStream
+	chunks  [][]byte
+	sendErr error
+}
+
+func (stream *exportStream) Context() context.Context {
+	return ctx
+}
+
+func (stream *exportStream) Send(out *toys.ExportUserDataOut) error {
+	if stream.sendErr != nil {
+		return stream.sendErr
+	}
+
+	stream.chunks = append(stream.chunks, out.GetChunk())
+
+	return nil
+}
+
+func TestMastersServer_ExportUserData(t *testing.T) {
+	export := &entities.UserDataExport{
+		Version:    entities.UserDataExportVersion,
+		UserID:     userID,
+		ExportedAt: now,
+		Master:     master,
+		Toys: []entities.Toy{
+			{
+				ID:          1,
+				MasterID:    masterID,
+				Description: strings.Repeat("a", exportChunkSize), // archive doesn't fit in one chunk.
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		stream         *exportStream
+		setupMocks     func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		chunksExpected int
+		errorExpected  bool
+		errorCode      codes.Code
+	}{
+		{
+			name:   "success",
+			stream: &exportStream{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ExportUserData(gomock.Any(), userID).
+					Return(export, nil).
+					Times(1)
+			},
+			chunksExpected: 2,
+		},
+		{
+			name:   "failed to export",
+			stream: &exportStream{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ExportUserData(gomock.Any(), userID).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+		{
+			name:   "failed to send chunk",
+			stream: &exportStream{sendErr: status.Error(codes.Canceled, "canceled")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ExportUserData(gomock.Any(), userID).
+					Return(export, nil).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Canceled,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	mastersServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := mastersServer.ExportUserData(&toys.ExportUserDataIn{UserID: userID}, tc.stream)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, tc.stream.chunks, tc.chunksExpected)
+
+			actual := &entities.UserDataExport{}
+			require.NoError(t, json.Unmarshal(bytes.Join(tc.stream.chunks, nil), actual))
+			require.Equal(t, export.Toys[0].Description, actual.Toys[0].Description)
+			require.Equal(t, export.Version, actual.Version)
+			require.Equal(t, export.Master.ID, actual.Master.ID)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package reviews
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+func mapReviewToOut(review entities.Review) *toys.GetReviewOut {
+	photos := make([]*toys.ReviewPhoto, len(review.Photos))
+	for i, photo := range review.Photos {
+		photos[i] = &toys.ReviewPhoto{
+			ID:        photo.ID,
+			ReviewID:  photo.ReviewID,
+			Link:      photo.Link,
+			CreatedAt: timestamppb.New(photo.CreatedAt),
+			UpdatedAt: timestamppb.New(photo.UpdatedAt),
+		}
+	}
+
+	return &toys.GetReviewOut{
+		ID:        review.ID,
+		ToyID:     review.ToyID,
+		UserID:    review.UserID,
+		Rating:    review.Rating,
+		Text:      review.Text,
+		Photos:    photos,
+		CreatedAt: timestamppb.New(review.CreatedAt),
+		UpdatedAt: timestamppb.New(review.UpdatedAt),
+	}
+}
@@ -0,0 +1,56 @@
+package reviews
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+var (
+	now          = time.Now()
+	mappedReview = &toys.GetReviewOut{
+		ID:     reviewID,
+		ToyID:  toyID,
+		UserID: userID,
+		Rating: 5,
+		Text:   pointers.New("Отличная игрушка"),
+		Photos: []*toys.ReviewPhoto{
+			{
+				ID:        photoID,
+				ReviewID:  reviewID,
+				Link:      "https://example.com/photo",
+				CreatedAt: timestamppb.New(now),
+				UpdatedAt: timestamppb.New(now),
+			},
+		},
+		CreatedAt: timestamppb.New(now),
+		UpdatedAt: timestamppb.New(now),
+	}
+)
+
+func TestMapReviewToOut(t *testing.T) {
+	testCases := []struct {
+		name     string
+		review   entities.Review
+		expected *toys.GetReviewOut
+	}{
+		{
+			name:     "success",
+			review:   *review,
+			expected: mappedReview,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := mapReviewToOut(tc.review)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
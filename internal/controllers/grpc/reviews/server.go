@@ -0,0 +1,182 @@
+package reviews
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masks"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/statuses"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// Names of masks and fields, which are listed in them:
+const (
+	updateMaskField = "updateMask"
+	photosField     = "photos"
+)
+
+// RegisterServer handler (serverAPI) for ReviewsServer to gRPC server:.
+func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
+	toys.RegisterReviewsServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
+}
+
+type ServerAPI struct {
+	// Helps to test single endpoints, if others is not implemented yet
+	toys.UnimplementedReviewsServiceServer
+	useCases interfaces.UseCases
+	logger   logging.Logger
+}
+
+// AddReview handler adds new Review for Toy from User.
+func (api *ServerAPI) AddReview(ctx context.Context, in *toys.AddReviewIn) (*toys.AddReviewOut, error) {
+	reviewData := entities.AddReviewDTO{
+		UserID: in.GetUserID(),
+		ToyID:  in.GetToyID(),
+		Rating: in.GetRating(),
+		Photos: in.GetPhotos(),
+	}
+
+	if in != nil {
+		reviewData.Text = in.Text
+	}
+
+	reviewID, err := api.useCases.AddReview(ctx, reviewData)
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to add new Review", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.AddReviewOut{ReviewID: reviewID}, nil
+}
+
+// GetReview handler returns Review for provided ID.
+func (api *ServerAPI) GetReview(ctx context.Context, in *toys.GetReviewIn) (*toys.GetReviewOut, error) {
+	review, err := api.useCases.GetReviewByID(ctx, in.GetID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get Review with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return mapReviewToOut(*review), nil
+}
+
+// GetToyReviews handler returns Reviews for Toy with provided ID.
+func (api *ServerAPI) GetToyReviews(ctx context.Context, in *toys.GetToyReviewsIn) (*toys.GetReviewsOut, error) {
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	reviews, err := api.useCases.GetToyReviews(ctx, in.GetToyID(), pagination)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get Reviews for Toy with ID=%d", in.GetToyID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	processedReviews := make([]*toys.GetReviewOut, len(reviews))
+	for i, review := range reviews {
+		processedReviews[i] = mapReviewToOut(review)
+	}
+
+	return &toys.GetReviewsOut{Reviews: processedReviews}, nil
+}
+
+// CountToyReviews handler returns count of Reviews for Toy with provided ID.
+func (api *ServerAPI) CountToyReviews(ctx context.Context, in *toys.CountToyReviewsIn) (*toys.CountOut, error) {
+	count, err := api.useCases.CountToyReviews(ctx, in.GetToyID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to count Reviews for Toy with ID=%d", in.GetToyID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.CountOut{Count: count}, nil
+}
+
+// UpdateReview handler changes fields of Review, which are listed in update mask, or all provided fields without mask.
+func (api *ServerAPI) UpdateReview(ctx context.Context, in *toys.UpdateReviewIn) (*emptypb.Empty, error) {
+	updateMask := in.GetUpdateMask()
+	if err := masks.Validate(
+		updateMask,
+		updateMaskField,
+		masks.Fields(&toys.UpdateReviewIn{}, "ID", updateMaskField)...,
+	); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid update mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	reviewData := entities.RawUpdateReviewDTO{ID: in.GetID()}
+
+	if in != nil {
+		if masks.Has(updateMask, "rating") {
+			reviewData.Rating = in.Rating
+		}
+
+		if masks.Has(updateMask, "text") {
+			reviewData.Text = in.Text
+		}
+	}
+
+	// Photos from mask replace old ones, so empty list removes all of them. Without mask empty list is ignored,
+	// because it can't be told apart from not provided one:
+	if masks.Has(updateMask, photosField) && (!masks.IsEmpty(updateMask) || len(in.GetPhotos()) > 0) {
+		reviewData.Photos = append(make([]string, 0, len(in.GetPhotos())), in.GetPhotos()...)
+	}
+
+	if err := api.useCases.UpdateReview(ctx, reviewData); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to update Review with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteReview handler deletes Review with provided ID.
+func (api *ServerAPI) DeleteReview(ctx context.Context, in *toys.DeleteReviewIn) (*emptypb.Empty, error) {
+	if err := api.useCases.DeleteReview(ctx, in.GetID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to delete Review with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
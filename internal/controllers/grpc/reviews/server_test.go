@@ -0,0 +1,613 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/validation"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
+)
+
+const (
+	reviewID uint64 = 1
+	toyID    uint64 = 1
+	userID   uint64 = 1
+	photoID  uint64 = 1
+)
+
+var (
+	ctx    = context.Background()
+	review = &entities.Review{
+		ID:     reviewID,
+		ToyID:  toyID,
+		UserID: userID,
+		Rating: 5,
+		Text:   pointers.New("Отличная игрушка"),
+		Photos: []entities.ReviewPhoto{
+			{
+				ID:        photoID,
+				ReviewID:  reviewID,
+				Link:      "https://example.com/photo",
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+)
+
+func TestReviewsServer_AddReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.AddReviewIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.AddReviewOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.AddReviewIn{
+				UserID: userID,
+				ToyID:  toyID,
+				Rating: 5,
+				Text:   pointers.New("Отличная игрушка"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddReview(
+						gomock.Any(),
+						entities.AddReviewDTO{
+							UserID: userID,
+							ToyID:  toyID,
+							Rating: 5,
+							Text:   pointers.New("Отличная игрушка"),
+						},
+					).
+					Return(reviewID, nil).
+					Times(1)
+			},
+			expected: &toys.AddReviewOut{ReviewID: reviewID},
+		},
+		{
+			name: "validation error",
+			in: &toys.AddReviewIn{
+				UserID: userID,
+				ToyID:  toyID,
+				Rating: 6,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddReview(gomock.Any(), gomock.Any()).
+					Return(uint64(0), &validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Toy not found",
+			in: &toys.AddReviewIn{
+				UserID: userID,
+				ToyID:  toyID,
+				Rating: 5,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddReview(gomock.Any(), gomock.Any()).
+					Return(uint64(0), &customerrors.ToyNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "Review already exists",
+			in: &toys.AddReviewIn{
+				UserID: userID,
+				ToyID:  toyID,
+				Rating: 5,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddReview(gomock.Any(), gomock.Any()).
+					Return(uint64(0), &customerrors.ReviewAlreadyExistsError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.AlreadyExists,
+		},
+		{
+			name: "internal error",
+			in: &toys.AddReviewIn{
+				UserID: userID,
+				ToyID:  toyID,
+				Rating: 5,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddReview(gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	reviewsServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := reviewsServer.AddReview(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReviewsServer_GetReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetReviewIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetReviewOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.GetReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetReviewByID(gomock.Any(), reviewID).
+					Return(review, nil).
+					Times(1)
+			},
+			expected: mappedReview,
+		},
+		{
+			name: "Review not found",
+			in:   &toys.GetReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetReviewByID(gomock.Any(), reviewID).
+					Return(nil, &customerrors.ReviewNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in:   &toys.GetReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetReviewByID(gomock.Any(), reviewID).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	reviewsServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := reviewsServer.GetReview(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReviewsServer_GetToyReviews(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetToyReviewsIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetReviewsOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.GetToyReviewsIn{
+				ToyID: toyID,
+				Pagination: &toys.Pagination{
+					Limit:  pointers.New[uint64](1),
+					Offset: pointers.New[uint64](0),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetToyReviews(
+						gomock.Any(),
+						toyID,
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](0),
+						},
+					).
+					Return([]entities.Review{*review}, nil).
+					Times(1)
+			},
+			expected: &toys.GetReviewsOut{Reviews: []*toys.GetReviewOut{mappedReview}},
+		},
+		{
+			name: "internal error",
+			in:   &toys.GetToyReviewsIn{ToyID: toyID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetToyReviews(gomock.Any(), toyID, nil).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	reviewsServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := reviewsServer.GetToyReviews(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReviewsServer_UpdateReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.UpdateReviewIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *emptypb.Empty
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.UpdateReviewIn{
+				ID:     reviewID,
+				Rating: pointers.New[uint32](4),
+				Photos: []string{"https://example.com/photo"},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateReview(
+						gomock.Any(),
+						entities.RawUpdateReviewDTO{
+							ID:     reviewID,
+							Rating: pointers.New[uint32](4),
+							Photos: []string{"https://example.com/photo"},
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+			expected: &emptypb.Empty{},
+		},
+		{
+			name: "success with update mask",
+			in: &toys.UpdateReviewIn{
+				ID:         reviewID,
+				Rating:     pointers.New[uint32](4),
+				Text:       pointers.New("text"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"text", "photos"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateReview(
+						gomock.Any(),
+						entities.RawUpdateReviewDTO{
+							ID:     reviewID,
+							Text:   pointers.New("text"),
+							Photos: []string{},
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+			expected: &emptypb.Empty{},
+		},
+		{
+			name: "success without Photos",
+			in: &toys.UpdateReviewIn{
+				ID:     reviewID,
+				Rating: pointers.New[uint32](4),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateReview(
+						gomock.Any(),
+						entities.RawUpdateReviewDTO{
+							ID:     reviewID,
+							Rating: pointers.New[uint32](4),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+			expected: &emptypb.Empty{},
+		},
+		{
+			name: "invalid update mask",
+			in: &toys.UpdateReviewIn{
+				ID:         reviewID,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"userID"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "validation error",
+			in:   &toys.UpdateReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateReview(gomock.Any(), gomock.Any()).
+					Return(&validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Review not found",
+			in:   &toys.UpdateReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateReview(gomock.Any(), gomock.Any()).
+					Return(&customerrors.ReviewNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	reviewsServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := reviewsServer.UpdateReview(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReviewsServer_DeleteReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.DeleteReviewIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *emptypb.Empty
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.DeleteReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteReview(gomock.Any(), reviewID).
+					Return(nil).
+					Times(1)
+			},
+			expected: &emptypb.Empty{},
+		},
+		{
+			name: "Review not found",
+			in:   &toys.DeleteReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteReview(gomock.Any(), reviewID).
+					Return(&customerrors.ReviewNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in:   &toys.DeleteReviewIn{ID: reviewID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					DeleteReview(gomock.Any(), reviewID).
+					Return(errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	reviewsServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := reviewsServer.DeleteReview(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package statuses
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/DKhorkov/libs/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+// Domain of errors, which is passed to clients in ErrorInfo details.
+const Domain = "hmtm-toys"
+
+// Reasons of errors, which are passed to clients in ErrorInfo details:
+const (
+	ReasonValidationFailed        = "VALIDATION_FAILED"
+	ReasonCategoryNotFound        = "CATEGORY_NOT_FOUND"
+	ReasonFavouriteNotFound       = "FAVOURITE_NOT_FOUND"
+	ReasonFavouriteAlreadyExists  = "FAVOURITE_ALREADY_EXISTS"
+	ReasonFollowNotFound          = "FOLLOW_NOT_FOUND"
+	ReasonFollowAlreadyExists     = "FOLLOW_ALREADY_EXISTS"
+	ReasonMasterNotFound          = "MASTER_NOT_FOUND"
+	ReasonMasterAlreadyExists     = "MASTER_ALREADY_EXISTS"
+	ReasonMasterSlugAlreadyExists = "MASTER_SLUG_ALREADY_EXISTS"
+	ReasonMasterBlocked           = "MASTER_BLOCKED"
+	ReasonReviewNotFound          = "REVIEW_NOT_FOUND"
+	ReasonReviewAlreadyExists     = "REVIEW_ALREADY_EXISTS"
+	ReasonTagNotFound             = "TAG_NOT_FOUND"
+	ReasonToyNotFound             = "TOY_NOT_FOUND"
+	ReasonToyAlreadyExists        = "TOY_ALREADY_EXISTS"
+	ReasonUserNotFound            = "USER_NOT_FOUND"
+	ReasonUnavailable             = "UNAVAILABLE"
+	ReasonInternal                = "INTERNAL"
+)
+
+const (
+	methodMetadataKey = "method"
+	fieldsMetadataKey = "fields"
+	fieldsSeparator   = ","
+)
+
+// FromError maps error, returned by UseCases, to gRPC error with ErrorInfo details. Validation errors with
+// field violations additionally contain google.rpc.BadRequest details, so clients can highlight invalid fields.
+// Failures of database connection are considered as unavailability and errors of unknown types as internal.
+func FromError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code, reason := classify(err)
+	errorInfo := &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   Domain,
+		Metadata: make(map[string]string),
+	}
+
+	if method, ok := grpc.Method(ctx); ok {
+		errorInfo.Metadata[methodMetadataKey] = method
+	}
+
+	details := []protoadapt.MessageV1{errorInfo}
+
+	var fieldsValidationError *customerrors.ValidationError
+	if errors.As(err, &fieldsValidationError) {
+		fields := make([]string, len(fieldsValidationError.Violations))
+		fieldViolations := make([]*errdetails.BadRequest_FieldViolation, len(fieldsValidationError.Violations))
+
+		for i, violation := range fieldsValidationError.Violations {
+			fields[i] = violation.Field
+			fieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Message,
+				Reason:      violation.Code,
+			}
+		}
+
+		errorInfo.Metadata[fieldsMetadataKey] = strings.Join(fields, fieldsSeparator)
+		details = append(details, &errdetails.BadRequest{FieldViolations: fieldViolations})
+	}
+
+	st := status.New(code, err.Error())
+
+	detailedStatus, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailedStatus.Err()
+}
+
+// classify returns gRPC code and ErrorInfo reason for error.
+func classify(err error) (codes.Code, string) {
+	switch {
+	case errors.As(err, new(*customerrors.ValidationError)), errors.As(err, new(*validation.Error)):
+		return codes.FailedPrecondition, ReasonValidationFailed
+	case errors.As(err, new(*customerrors.CategoryNotFoundError)):
+		return codes.NotFound, ReasonCategoryNotFound
+	case errors.As(err, new(*customerrors.FavouriteNotFoundError)):
+		return codes.NotFound, ReasonFavouriteNotFound
+	case errors.As(err, new(*customerrors.FavouriteAlreadyExistsError)):
+		return codes.AlreadyExists, ReasonFavouriteAlreadyExists
+	case errors.As(err, new(*customerrors.FollowNotFoundError)):
+		return codes.NotFound, ReasonFollowNotFound
+	case errors.As(err, new(*customerrors.FollowAlreadyExistsError)):
+		return codes.AlreadyExists, ReasonFollowAlreadyExists
+	case errors.As(err, new(*customerrors.MasterNotFoundError)):
+		return codes.NotFound, ReasonMasterNotFound
+	case errors.As(err, new(*customerrors.MasterAlreadyExistsError)):
+		return codes.AlreadyExists, ReasonMasterAlreadyExists
+	case errors.As(err, new(*customerrors.MasterSlugAlreadyExistsError)):
+		return codes.AlreadyExists, ReasonMasterSlugAlreadyExists
+	case errors.As(err, new(*customerrors.MasterBlockedError)):
+		return codes.PermissionDenied, ReasonMasterBlocked
+	case errors.As(err, new(*customerrors.ReviewNotFoundError)):
+		return codes.NotFound, ReasonReviewNotFound
+	case errors.As(err, new(*customerrors.ReviewAlreadyExistsError)):
+		return codes.AlreadyExists, ReasonReviewAlreadyExists
+	case errors.As(err, new(*customerrors.TagNotFoundError)):
+		return codes.NotFound, ReasonTagNotFound
+	case errors.As(err, new(*customerrors.ToyNotFoundError)):
+		return codes.NotFound, ReasonToyNotFound
+	case errors.As(err, new(*customerrors.ToyAlreadyExistsError)):
+		return codes.AlreadyExists, ReasonToyAlreadyExists
+	case errors.As(err, new(*customerrors.UserNotFoundError)):
+		return codes.NotFound, ReasonUserNotFound
+	case isUnavailable(err):
+		return codes.Unavailable, ReasonUnavailable
+	default:
+		return codes.Internal, ReasonInternal
+	}
+}
+
+// isUnavailable checks, if error is caused by lost or refused database connection or by unavailable SSO,
+// so request can be retried.
+func isUnavailable(err error) bool {
+	var netErr net.Error
+
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.As(err, &netErr) ||
+		status.Code(err) == codes.Unavailable
+}
@@ -0,0 +1,235 @@
+package statuses
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/DKhorkov/libs/validation"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+const method = "/toys.ToysService/AddToy"
+
+// serverTransportStream is used to provide gRPC method to context, as gRPC server does.
+type serverTransportStream struct{}
+
+func (stream serverTransportStream) Method() string                 { return method }
+func (stream serverTransportStream) SetHeader(_ metadata.MD) error  { return nil }
+func (stream serverTransportStream) SendHeader(_ metadata.MD) error { return nil }
+func (stream serverTransportStream) SetTrailer(_ metadata.MD) error { return nil }
+
+func TestFromError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			name:           "fields validation error",
+			err:            &customerrors.ValidationError{},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: ReasonValidationFailed,
+		},
+		{
+			name:           "validation error",
+			err:            &validation.Error{Message: "invalid stats period"},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: ReasonValidationFailed,
+		},
+		{
+			name:           "Category not found",
+			err:            &customerrors.CategoryNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonCategoryNotFound,
+		},
+		{
+			name:           "Favourite not found",
+			err:            &customerrors.FavouriteNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonFavouriteNotFound,
+		},
+		{
+			name:           "Favourite already exists",
+			err:            &customerrors.FavouriteAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonFavouriteAlreadyExists,
+		},
+		{
+			name:           "Follow not found",
+			err:            &customerrors.FollowNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonFollowNotFound,
+		},
+		{
+			name:           "Follow already exists",
+			err:            &customerrors.FollowAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonFollowAlreadyExists,
+		},
+		{
+			name:           "Master not found",
+			err:            &customerrors.MasterNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonMasterNotFound,
+		},
+		{
+			name:           "Master already exists",
+			err:            &customerrors.MasterAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonMasterAlreadyExists,
+		},
+		{
+			name:           "Master slug already exists",
+			err:            &customerrors.MasterSlugAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonMasterSlugAlreadyExists,
+		},
+		{
+			name:           "Master blocked",
+			err:            &customerrors.MasterBlockedError{},
+			expectedCode:   codes.PermissionDenied,
+			expectedReason: ReasonMasterBlocked,
+		},
+		{
+			name:           "Review not found",
+			err:            &customerrors.ReviewNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonReviewNotFound,
+		},
+		{
+			name:           "Review already exists",
+			err:            &customerrors.ReviewAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonReviewAlreadyExists,
+		},
+		{
+			name:           "Tag not found",
+			err:            &customerrors.TagNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonTagNotFound,
+		},
+		{
+			name:           "Toy not found",
+			err:            &customerrors.ToyNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonToyNotFound,
+		},
+		{
+			name:           "Toy already exists",
+			err:            &customerrors.ToyAlreadyExistsError{},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: ReasonToyAlreadyExists,
+		},
+		{
+			name:           "User not found",
+			err:            &customerrors.UserNotFoundError{},
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonUserNotFound,
+		},
+		{
+			name:           "wrapped error",
+			err:            fmt.Errorf("failed to update Toy: %w", &customerrors.TagNotFoundError{}),
+			expectedCode:   codes.NotFound,
+			expectedReason: ReasonTagNotFound,
+		},
+		{
+			name:           "bad database connection",
+			err:            fmt.Errorf("failed to get Toy with ID=1: %w", driver.ErrBadConn),
+			expectedCode:   codes.Unavailable,
+			expectedReason: ReasonUnavailable,
+		},
+		{
+			name:           "closed database connection",
+			err:            fmt.Errorf("failed to get Tag with ID=1: %w", sql.ErrConnDone),
+			expectedCode:   codes.Unavailable,
+			expectedReason: ReasonUnavailable,
+		},
+		{
+			name:           "refused database connection",
+			err:            &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			expectedCode:   codes.Unavailable,
+			expectedReason: ReasonUnavailable,
+		},
+		{
+			name:           "unavailable SSO",
+			err:            status.Error(codes.Unavailable, "circuit breaker of SSO gRPC client is open"),
+			expectedCode:   codes.Unavailable,
+			expectedReason: ReasonUnavailable,
+		},
+		{
+			name:           "unknown error",
+			err:            errors.New("test"),
+			expectedCode:   codes.Internal,
+			expectedReason: ReasonInternal,
+		},
+	}
+
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), serverTransportStream{})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := status.Convert(FromError(ctx, tc.err))
+			require.Equal(t, tc.expectedCode, st.Code())
+			require.Equal(t, tc.err.Error(), st.Message())
+			require.NotEmpty(t, st.Details())
+
+			errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			require.Equal(t, tc.expectedReason, errorInfo.GetReason())
+			require.Equal(t, Domain, errorInfo.GetDomain())
+			require.Equal(t, method, errorInfo.GetMetadata()[methodMetadataKey])
+		})
+	}
+}
+
+func TestFromErrorWithFieldViolations(t *testing.T) {
+	err := &customerrors.ValidationError{
+		Violations: []customerrors.FieldViolation{
+			{
+				Field:   "name",
+				Code:    customerrors.ViolationCodeForbiddenWords,
+				Message: "toy name contains forbidden words",
+			},
+			{
+				Field:   "price",
+				Code:    customerrors.ViolationCodeOutOfRange,
+				Message: "invalid toy price",
+			},
+		},
+	}
+
+	st := status.Convert(FromError(context.Background(), err))
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 2)
+
+	errorInfo, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{fieldsMetadataKey: "name,price"}, errorInfo.GetMetadata())
+
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), len(err.Violations))
+
+	for i, violation := range badRequest.GetFieldViolations() {
+		require.Equal(t, err.Violations[i].Field, violation.GetField())
+		require.Equal(t, err.Violations[i].Message, violation.GetDescription())
+		require.Equal(t, err.Violations[i].Code, violation.GetReason())
+	}
+}
+
+func TestFromErrorWithoutError(t *testing.T) {
+	require.NoError(t, FromError(context.Background(), nil))
+}
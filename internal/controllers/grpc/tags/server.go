@@ -2,29 +2,19 @@ package tags
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
-	"github.com/DKhorkov/libs/validation"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	customgrpc "github.com/DKhorkov/libs/grpc"
-
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/statuses"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
-	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
 )
 
-var (
-	tagNotFoundError = &customerrors.TagNotFoundError{}
-	validationError  = &validation.Error{}
-)
-
 // RegisterServer handler (serverAPI) for TagsServer to gRPC server:.
 func RegisterServer(gRPCServer *grpc.Server, useCases interfaces.UseCases, logger logging.Logger) {
 	toys.RegisterTagsServiceServer(gRPCServer, &ServerAPI{useCases: useCases, logger: logger})
@@ -58,12 +48,7 @@ func (api *ServerAPI) CreateTags(
 			err,
 		)
 
-		switch {
-		case errors.As(err, &validationError):
-			return nil, &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	processedTags := make([]*toys.CreateTagOut, len(tagIDs))
@@ -87,12 +72,7 @@ func (api *ServerAPI) GetTag(ctx context.Context, in *toys.GetTagIn) (*toys.GetT
 			err,
 		)
 
-		switch {
-		case errors.As(err, &tagNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.GetTagOut{
@@ -109,7 +89,7 @@ func (api *ServerAPI) GetTags(ctx context.Context, _ *emptypb.Empty) (*toys.GetT
 	if err != nil {
 		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to get all Tags", err)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	processedTags := make([]*toys.GetTagOut, len(tags))
@@ -18,6 +18,7 @@ import (
 	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
 	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/validation"
 )
 
 var (
@@ -304,7 +305,60 @@ func TestTagsServer_CreateTags(t *testing.T) {
 							},
 						},
 					).
-					Return(nil, validationError).
+					Return(nil, &validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "field violations",
+			in: &toys.CreateTagsIn{
+				Tags: []*toys.CreateTagIn{
+					{
+						Name: "test",
+					},
+					{
+						Name: "сука",
+					},
+				},
+			},
+			setupMocks: func(usecases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				usecases.
+					EXPECT().
+					CreateTags(
+						gomock.Any(),
+						[]entities.CreateTagDTO{
+							{
+								Name: "test",
+							},
+							{
+								Name: "сука",
+							},
+						},
+					).
+					Return(
+						nil,
+						&customerrors.ValidationError{
+							Violations: []customerrors.FieldViolation{
+								{
+									Field:   "tags[0].name",
+									Code:    customerrors.ViolationCodeInvalidFormat,
+									Message: "invalid tag name",
+								},
+								{
+									Field:   "tags[1].name",
+									Code:    customerrors.ViolationCodeForbiddenWords,
+									Message: "tag name contains forbidden words",
+								},
+							},
+						},
+					).
 					Times(1)
 
 				logger.
@@ -1,10 +1,19 @@
 package toys
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masks"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 )
 
 func mapToyToOut(toy entities.Toy) *toys.GetToyOut {
@@ -28,16 +37,201 @@ func mapToyToOut(toy entities.Toy) *toys.GetToyOut {
 	}
 
 	return &toys.GetToyOut{
-		ID:          toy.ID,
-		MasterID:    toy.MasterID,
-		Name:        toy.Name,
-		Description: toy.Description,
-		Price:       toy.Price,
-		Quantity:    toy.Quantity,
-		CategoryID:  toy.CategoryID,
-		Tags:        tags,
-		Attachments: attachments,
-		CreatedAt:   timestamppb.New(toy.CreatedAt),
-		UpdatedAt:   timestamppb.New(toy.UpdatedAt),
+		ID:               toy.ID,
+		MasterID:         toy.MasterID,
+		Name:             toy.Name,
+		Description:      toy.Description,
+		Price:            toy.Price,
+		Quantity:         toy.Quantity,
+		CategoryID:       toy.CategoryID,
+		Tags:             tags,
+		Attachments:      attachments,
+		CreatedAt:        timestamppb.New(toy.CreatedAt),
+		UpdatedAt:        timestamppb.New(toy.UpdatedAt),
+		FavouritesCount:  toy.FavouritesCount,
+		AverageRating:    toy.AverageRating,
+		ReviewsCount:     toy.ReviewsCount,
+		Available:        toy.Available,
+		ModerationStatus: mapToyModerationStatusToOut(toy.ModerationStatus),
+		ModerationFlags:  toy.ModerationFlags,
+		RejectionReason:  toy.RejectionReason,
+		Appeal:           toy.Appeal,
 	}
 }
+
+// mapToysToOut maps Toys and drops their fields, which are not requested by read mask.
+func mapToysToOut(foundToys []entities.Toy, readMask *fieldmaskpb.FieldMask) []*toys.GetToyOut {
+	processedToys := make([]*toys.GetToyOut, len(foundToys))
+	for i, toy := range foundToys {
+		processedToys[i] = mapToyToOut(toy)
+		masks.Prune(readMask, processedToys[i])
+	}
+
+	return processedToys
+}
+
+func mapUserToOut(user entities.User) *toys.User {
+	return &toys.User{
+		ID:          user.ID,
+		DisplayName: user.DisplayName,
+		Avatar:      user.Avatar,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+	}
+}
+
+func mapToyModerationStatusToOut(status entities.ToyModerationStatus) toys.ToyModerationStatus {
+	switch status {
+	case entities.ToyModerationStatusApproved:
+		return toys.ToyModerationStatus_TOY_MODERATION_STATUS_APPROVED
+	case entities.ToyModerationStatusPending:
+		return toys.ToyModerationStatus_TOY_MODERATION_STATUS_PENDING
+	case entities.ToyModerationStatusRejected:
+		return toys.ToyModerationStatus_TOY_MODERATION_STATUS_REJECTED
+	}
+
+	return toys.ToyModerationStatus_TOY_MODERATION_STATUS_UNSPECIFIED
+}
+
+func mapToyModerationStatusesIn(statuses []toys.ToyModerationStatus) []entities.ToyModerationStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	processedStatuses := make([]entities.ToyModerationStatus, 0, len(statuses))
+	for _, status := range statuses {
+		switch status {
+		case toys.ToyModerationStatus_TOY_MODERATION_STATUS_APPROVED:
+			processedStatuses = append(processedStatuses, entities.ToyModerationStatusApproved)
+		case toys.ToyModerationStatus_TOY_MODERATION_STATUS_PENDING:
+			processedStatuses = append(processedStatuses, entities.ToyModerationStatusPending)
+		case toys.ToyModerationStatus_TOY_MODERATION_STATUS_REJECTED:
+			processedStatuses = append(processedStatuses, entities.ToyModerationStatusRejected)
+		case toys.ToyModerationStatus_TOY_MODERATION_STATUS_UNSPECIFIED:
+		}
+	}
+
+	return processedStatuses
+}
+
+// feedCursorSeparator separates Toy creation time and Toy ID in encoded Feed cursor.
+const feedCursorSeparator = ":"
+
+func mapFeedCursorToOut(toy entities.Toy) string {
+	raw := strconv.FormatInt(toy.CreatedAt.UnixNano(), 10) + feedCursorSeparator + strconv.FormatUint(toy.ID, 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func mapFeedCursorIn(cursor string) (*entities.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed cursor: %w", err)
+	}
+
+	createdAtPart, toyIDPart, found := strings.Cut(string(raw), feedCursorSeparator)
+	if !found {
+		return nil, fmt.Errorf("invalid feed cursor: %s", cursor)
+	}
+
+	createdAt, err := strconv.ParseInt(createdAtPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed cursor: %w", err)
+	}
+
+	toyID, err := strconv.ParseUint(toyIDPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feed cursor: %w", err)
+	}
+
+	return &entities.FeedCursor{
+		CreatedAt: time.Unix(0, createdAt).UTC(),
+		ToyID:     toyID,
+	}, nil
+}
+
+func mapToysFiltersIn(filters *toys.ToysFilters) *entities.ToysFilters {
+	if filters == nil {
+		return nil
+	}
+
+	return &entities.ToysFilters{
+		Search:              filters.Search,
+		PriceCeil:           filters.PriceCeil,
+		PriceFloor:          filters.PriceFloor,
+		QuantityFloor:       filters.QuantityFloor,
+		CategoryIDs:         filters.CategoryIDs,
+		TagIDs:              filters.TagIDs,
+		CreatedAtOrderByAsc: filters.CreatedAtOrderByAsc,
+		MinRating:           filters.MinRating,
+		RatingOrderByAsc:    filters.RatingOrderByAsc,
+		OnlyAvailable:       filters.OnlyAvailable,
+		ModerationStatuses:  mapToyModerationStatusesIn(filters.GetModerationStatuses()),
+	}
+}
+
+func mapImportToysOut(results []entities.ImportToyResult) *toys.ImportToysOut {
+	out := &toys.ImportToysOut{Results: make([]*toys.ImportToyResult, len(results))}
+
+	for i, result := range results {
+		violations := mapViolationsOut(result.Violations)
+		out.Results[i] = &toys.ImportToyResult{
+			Line:       result.Line,
+			ToyID:      result.ToyID,
+			Violations: violations,
+		}
+
+		if len(violations) > 0 {
+			out.InvalidCount++
+		} else {
+			out.ValidCount++
+		}
+	}
+
+	return out
+}
+
+func mapBatchUpdateToysIn(items []*toys.BatchUpdateToyItem) []entities.BatchUpdateToyDTO {
+	toysData := make([]entities.BatchUpdateToyDTO, len(items))
+	for i, item := range items {
+		toysData[i] = entities.BatchUpdateToyDTO{
+			ID:       item.GetID(),
+			Price:    item.Price,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return toysData
+}
+
+func mapBatchUpdateToysOut(results []entities.BatchUpdateToyResult) *toys.BatchUpdateToysOut {
+	out := &toys.BatchUpdateToysOut{
+		Applied: true,
+		Results: make([]*toys.BatchUpdateToyResult, len(results)),
+	}
+
+	for i, result := range results {
+		out.Results[i] = &toys.BatchUpdateToyResult{
+			ID:         result.ID,
+			Violations: mapViolationsOut(result.Violations),
+		}
+
+		if len(result.Violations) > 0 {
+			out.Applied = false
+		}
+	}
+
+	return out
+}
+
+func mapViolationsOut(violations []customerrors.FieldViolation) []*toys.ImportViolation {
+	processedViolations := make([]*toys.ImportViolation, len(violations))
+	for i, violation := range violations {
+		processedViolations[i] = &toys.ImportViolation{
+			Field:   violation.Field,
+			Code:    violation.Code,
+			Message: violation.Message,
+		}
+	}
+
+	return processedViolations
+}
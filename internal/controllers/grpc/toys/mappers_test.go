@@ -1,10 +1,13 @@
 package toys
 
 import (
+	"encoding/base64"
 	"testing"
 	"time"
 
+	"github.com/DKhorkov/libs/pointers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
@@ -35,8 +38,16 @@ var (
 				UpdatedAt: timestamppb.New(now),
 			},
 		},
-		CreatedAt: timestamppb.New(now),
-		UpdatedAt: timestamppb.New(now),
+		CreatedAt:        timestamppb.New(now),
+		UpdatedAt:        timestamppb.New(now),
+		FavouritesCount:  2,
+		AverageRating:    4.5,
+		ReviewsCount:     2,
+		Available:        true,
+		ModerationStatus: toys.ToyModerationStatus_TOY_MODERATION_STATUS_REJECTED,
+		ModerationFlags:  pointers.New("external links in description"),
+		RejectionReason:  pointers.New("Ссылки на сторонние сайты запрещены"),
+		Appeal:           pointers.New("Ссылки удалены из описания"),
 	}
 )
 
@@ -60,3 +71,100 @@ func TestMapToyToOut(t *testing.T) {
 		})
 	}
 }
+
+func TestMapToysFiltersIn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filters  *toys.ToysFilters
+		expected *entities.ToysFilters
+	}{
+		{
+			name: "success",
+			filters: &toys.ToysFilters{
+				Search:              pointers.New("toy"),
+				PriceCeil:           pointers.New[float32](1000),
+				PriceFloor:          pointers.New[float32](10),
+				QuantityFloor:       pointers.New[uint32](1),
+				CategoryIDs:         []uint32{categoryID},
+				TagIDs:              []uint32{tagID},
+				CreatedAtOrderByAsc: pointers.New(true),
+				MinRating:           pointers.New[float32](4),
+				RatingOrderByAsc:    pointers.New(false),
+				OnlyAvailable:       pointers.New(true),
+				ModerationStatuses: []toys.ToyModerationStatus{
+					toys.ToyModerationStatus_TOY_MODERATION_STATUS_PENDING,
+					toys.ToyModerationStatus_TOY_MODERATION_STATUS_UNSPECIFIED,
+				},
+			},
+			expected: &entities.ToysFilters{
+				Search:              pointers.New("toy"),
+				PriceCeil:           pointers.New[float32](1000),
+				PriceFloor:          pointers.New[float32](10),
+				QuantityFloor:       pointers.New[uint32](1),
+				CategoryIDs:         []uint32{categoryID},
+				TagIDs:              []uint32{tagID},
+				CreatedAtOrderByAsc: pointers.New(true),
+				MinRating:           pointers.New[float32](4),
+				RatingOrderByAsc:    pointers.New(false),
+				OnlyAvailable:       pointers.New(true),
+				ModerationStatuses:  []entities.ToyModerationStatus{entities.ToyModerationStatusPending},
+			},
+		},
+		{
+			name: "nil filters",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := mapToysFiltersIn(tc.filters)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestMapFeedCursorIn(t *testing.T) {
+	testCases := []struct {
+		name          string
+		cursor        string
+		expected      *entities.FeedCursor
+		errorExpected bool
+	}{
+		{
+			name:   "success",
+			cursor: mapFeedCursorToOut(*toy),
+			expected: &entities.FeedCursor{
+				CreatedAt: toy.CreatedAt.UTC(),
+				ToyID:     toy.ID,
+			},
+		},
+		{
+			name:          "not base64",
+			cursor:        "!!!",
+			errorExpected: true,
+		},
+		{
+			name:          "without separator",
+			cursor:        base64.RawURLEncoding.EncodeToString([]byte("123")),
+			errorExpected: true,
+		},
+		{
+			name:          "invalid Toy ID",
+			cursor:        base64.RawURLEncoding.EncodeToString([]byte("123:abc")),
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := mapFeedCursorIn(tc.cursor)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -1,31 +1,44 @@
 package toys
 
 import (
+	"bufio"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"slices"
 
 	"github.com/DKhorkov/libs/logging"
-	"github.com/DKhorkov/libs/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	customgrpc "github.com/DKhorkov/libs/grpc"
 
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/exports"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/imports"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/includes"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/masks"
+	"github.com/DKhorkov/hmtm-toys/internal/controllers/grpc/statuses"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
-	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
 )
 
-var (
-	toyNotFoundError      = &customerrors.ToyNotFoundError{}
-	toyAlreadyExistsError = &customerrors.ToyAlreadyExistsError{}
-	tagNotFoundError      = &customerrors.TagNotFoundError{}
-	masterNotFoundError   = &customerrors.MasterNotFoundError{}
-	categoryNotFoundError = &customerrors.CategoryNotFoundError{}
-	validationError       = &validation.Error{}
+// exportChunkSize is a max size of exported Toys file chunk, which is far below default gRPC message size limit.
+const exportChunkSize = 64 * 1024
+
+// Names of masks and fields, which are listed in them:
+const (
+	readMaskField    = "readMask"
+	updateMaskField  = "updateMask"
+	tagsField        = "tags"
+	attachmentsField = "attachments"
+	tagIDsField      = "tagIDs"
 )
 
 // RegisterServer handler (serverAPI) for ToysServer to gRPC server:.
@@ -41,18 +54,7 @@ type ServerAPI struct {
 }
 
 func (api *ServerAPI) CountMasterToys(ctx context.Context, in *toys.CountMasterToysIn) (*toys.CountOut, error) {
-	var filters *entities.ToysFilters
-	if in.GetFilters() != nil {
-		filters = &entities.ToysFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
+	filters := mapToysFiltersIn(in.GetFilters())
 
 	count, err := api.useCases.CountMasterToys(ctx, in.GetMasterID(), filters)
 	if err != nil {
@@ -63,25 +65,14 @@ func (api *ServerAPI) CountMasterToys(ctx context.Context, in *toys.CountMasterT
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.CountOut{Count: count}, nil
 }
 
 func (api *ServerAPI) CountUserToys(ctx context.Context, in *toys.CountUserToysIn) (*toys.CountOut, error) {
-	var filters *entities.ToysFilters
-	if in.GetFilters() != nil {
-		filters = &entities.ToysFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
+	filters := mapToysFiltersIn(in.GetFilters())
 
 	count, err := api.useCases.CountUserToys(ctx, in.GetUserID(), filters)
 	if err != nil {
@@ -92,25 +83,14 @@ func (api *ServerAPI) CountUserToys(ctx context.Context, in *toys.CountUserToysI
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.CountOut{Count: count}, nil
 }
 
 func (api *ServerAPI) CountToys(ctx context.Context, in *toys.CountToysIn) (*toys.CountOut, error) {
-	var filters *entities.ToysFilters
-	if in.GetFilters() != nil {
-		filters = &entities.ToysFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
-	}
+	filters := mapToysFiltersIn(in.GetFilters())
 
 	count, err := api.useCases.CountToys(ctx, filters)
 	if err != nil {
@@ -121,25 +101,58 @@ func (api *ServerAPI) CountToys(ctx context.Context, in *toys.CountToysIn) (*toy
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.CountOut{Count: count}, nil
 }
 
+// UpdateToy handler changes fields of Toy, which are listed in update mask, or all provided fields without mask.
 func (api *ServerAPI) UpdateToy(ctx context.Context, in *toys.UpdateToyIn) (*emptypb.Empty, error) {
-	toyData := entities.RawUpdateToyDTO{
-		ID:          in.GetID(),
-		TagIDs:      in.GetTagIDs(),
-		Attachments: in.GetAttachments(),
+	updateMask := in.GetUpdateMask()
+	if err := masks.Validate(
+		updateMask,
+		updateMaskField,
+		masks.Fields(&toys.UpdateToyIn{}, "ID", updateMaskField)...,
+	); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid update mask", err)
+
+		return nil, statuses.FromError(ctx, err)
 	}
 
+	toyData := entities.RawUpdateToyDTO{ID: in.GetID()}
+
+	// Fields of Toy can't be cleared, so not provided fields from mask are left untouched:
 	if in != nil {
-		toyData.CategoryID = in.CategoryID
-		toyData.Name = in.Name
-		toyData.Description = in.Description
-		toyData.Price = in.Price
-		toyData.Quantity = in.Quantity
+		if masks.Has(updateMask, "categoryID") {
+			toyData.CategoryID = in.CategoryID
+		}
+
+		if masks.Has(updateMask, "name") {
+			toyData.Name = in.Name
+		}
+
+		if masks.Has(updateMask, "description") {
+			toyData.Description = in.Description
+		}
+
+		if masks.Has(updateMask, "price") {
+			toyData.Price = in.Price
+		}
+
+		if masks.Has(updateMask, "quantity") {
+			toyData.Quantity = in.Quantity
+		}
+	}
+
+	// Lists from mask replace Tags and Attachments, so empty list removes all of them. Without mask empty lists
+	// are ignored, because they can't be told apart from not provided ones:
+	if masks.Has(updateMask, tagIDsField) && (!masks.IsEmpty(updateMask) || len(in.GetTagIDs()) > 0) {
+		toyData.TagIDs = append(make([]uint32, 0, len(in.GetTagIDs())), in.GetTagIDs()...)
+	}
+
+	if masks.Has(updateMask, attachmentsField) && (!masks.IsEmpty(updateMask) || len(in.GetAttachments()) > 0) {
+		toyData.Attachments = append(make([]string, 0, len(in.GetAttachments())), in.GetAttachments()...)
 	}
 
 	if err := api.useCases.UpdateToy(ctx, toyData); err != nil {
@@ -150,19 +163,33 @@ func (api *ServerAPI) UpdateToy(ctx context.Context, in *toys.UpdateToyIn) (*emp
 			err,
 		)
 
-		switch {
-		case errors.As(err, &validationError):
-			return nil, &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: err.Error()}
-		case errors.As(err, &toyNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// BatchUpdateToys handler changes prices and quantities of Toys of Master atomically. Toys are updated only
+// if all items are valid, otherwise violations of invalid items are returned.
+func (api *ServerAPI) BatchUpdateToys(
+	ctx context.Context,
+	in *toys.BatchUpdateToysIn,
+) (*toys.BatchUpdateToysOut, error) {
+	results, err := api.useCases.BatchUpdateToys(ctx, in.GetUserID(), mapBatchUpdateToysIn(in.GetItems()))
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to batch update Toys of User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return mapBatchUpdateToysOut(results), nil
+}
+
 func (api *ServerAPI) DeleteToy(ctx context.Context, in *toys.DeleteToyIn) (*emptypb.Empty, error) {
 	if err := api.useCases.DeleteToy(ctx, in.GetID()); err != nil {
 		logging.LogErrorContext(
@@ -172,12 +199,7 @@ func (api *ServerAPI) DeleteToy(ctx context.Context, in *toys.DeleteToyIn) (*emp
 			err,
 		)
 
-		switch {
-		case errors.As(err, &toyNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -185,6 +207,22 @@ func (api *ServerAPI) DeleteToy(ctx context.Context, in *toys.DeleteToyIn) (*emp
 
 // GetToy handler returns Toy for provided ID.
 func (api *ServerAPI) GetToy(ctx context.Context, in *toys.GetToyIn) (*toys.GetToyOut, error) {
+	if err := includes.Validate(in.GetInclude(), includes.User); err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid include mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	// Not approved Toys are requested by owner and moderators:
+	ctx = visibility.WithToyModerationStatuses(ctx, mapToyModerationStatusesIn(in.GetModerationStatuses())...)
+
 	toy, err := api.useCases.GetToyByID(ctx, in.GetID())
 	if err != nil {
 		logging.LogErrorContext(
@@ -194,32 +232,98 @@ func (api *ServerAPI) GetToy(ctx context.Context, in *toys.GetToyIn) (*toys.GetT
 			err,
 		)
 
-		switch {
-		case errors.As(err, &toyNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	processedToy := mapToyToOut(*toy)
+	if includes.Has(in.GetInclude(), includes.User) {
+		api.includeUser(ctx, processedToy)
+	}
+
+	masks.Prune(in.GetReadMask(), processedToy, includes.User)
+
+	return processedToy, nil
+}
+
+// BatchGetToys handler returns found Toys by their IDs and IDs of missing Toys.
+func (api *ServerAPI) BatchGetToys(ctx context.Context, in *toys.BatchGetToysIn) (*toys.BatchGetToysOut, error) {
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	// Not approved Toys are requested by owner and moderators:
+	ctx = visibility.WithToyModerationStatuses(ctx, mapToyModerationStatusesIn(in.GetModerationStatuses())...)
+
+	foundToys, missingIDs, err := api.useCases.BatchGetToys(ctx, in.GetIDs())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to batch get Toys", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.BatchGetToysOut{Toys: mapToysToOut(foundToys, in.GetReadMask()), MissingIDs: missingIDs}, nil
+}
+
+// includeUser sets User of Toy's Master. Toy is more important than User, so if Master or User lookup fails,
+// error is logged and Toy is returned without User.
+func (api *ServerAPI) includeUser(ctx context.Context, toy *toys.GetToyOut) {
+	master, err := api.useCases.GetMasterByID(ctx, toy.GetMasterID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get Master with ID=%d, User is not included", toy.GetMasterID()),
+			err,
+		)
+
+		return
+	}
+
+	users, err := api.useCases.GetUsersByIDs(ctx, []uint64{master.UserID})
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get User with ID=%d, User is not included", master.UserID),
+			err,
+		)
+	}
+
+	if user, ok := users[master.UserID]; ok {
+		toy.User = mapUserToOut(user)
+	}
+}
+
+// withReadMask validates read mask and returns context, which contains relations of Toys requested by it,
+// so repositories skip reading of not requested relations.
+func withReadMask(ctx context.Context, readMask *fieldmaskpb.FieldMask) (context.Context, error) {
+	// User is not a field of Toy, so it is requested by include mask only:
+	if err := masks.Validate(readMask, readMaskField, masks.Fields(&toys.GetToyOut{}, includes.User)...); err != nil {
+		return ctx, err
 	}
 
-	return mapToyToOut(*toy), nil
+	relations := readmasks.ToyRelations{
+		Tags:        masks.Has(readMask, tagsField),
+		Attachments: masks.Has(readMask, attachmentsField),
+	}
+
+	return readmasks.WithToyRelations(ctx, relations), nil
 }
 
 // GetToys handler returns all Toys.
 func (api *ServerAPI) GetToys(ctx context.Context, in *toys.GetToysIn) (*toys.GetToysOut, error) {
-	var filters *entities.ToysFilters
-	if in.GetFilters() != nil {
-		filters = &entities.ToysFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
 	}
 
+	filters := mapToysFiltersIn(in.GetFilters())
+
 	var pagination *entities.Pagination
 	if in.GetPagination() != nil {
 		pagination = &entities.Pagination{
@@ -232,15 +336,10 @@ func (api *ServerAPI) GetToys(ctx context.Context, in *toys.GetToysIn) (*toys.Ge
 	if err != nil {
 		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to get all Toys", err)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-	}
-
-	processedToys := make([]*toys.GetToyOut, len(allToys))
-	for i, toy := range allToys {
-		processedToys[i] = mapToyToOut(toy)
+		return nil, statuses.FromError(ctx, err)
 	}
 
-	return &toys.GetToysOut{Toys: processedToys}, nil
+	return &toys.GetToysOut{Toys: mapToysToOut(allToys, in.GetReadMask())}, nil
 }
 
 // GetMasterToys handler returns all Toys for master with provided ID.
@@ -248,19 +347,15 @@ func (api *ServerAPI) GetMasterToys(
 	ctx context.Context,
 	in *toys.GetMasterToysIn,
 ) (*toys.GetToysOut, error) {
-	var filters *entities.ToysFilters
-	if in.GetFilters() != nil {
-		filters = &entities.ToysFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
 	}
 
+	filters := mapToysFiltersIn(in.GetFilters())
+
 	var pagination *entities.Pagination
 	if in.GetPagination() != nil {
 		pagination = &entities.Pagination{
@@ -281,34 +376,25 @@ func (api *ServerAPI) GetMasterToys(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-	}
-
-	processedToys := make([]*toys.GetToyOut, len(masterToys))
-	for i, toy := range masterToys {
-		processedToys[i] = mapToyToOut(toy)
+		return nil, statuses.FromError(ctx, err)
 	}
 
-	return &toys.GetToysOut{Toys: processedToys}, nil
+	return &toys.GetToysOut{Toys: mapToysToOut(masterToys, in.GetReadMask())}, nil
 }
 
 func (api *ServerAPI) GetUserToys(
 	ctx context.Context,
 	in *toys.GetUserToysIn,
 ) (*toys.GetToysOut, error) {
-	var filters *entities.ToysFilters
-	if in.GetFilters() != nil {
-		filters = &entities.ToysFilters{
-			Search:              in.Filters.Search,
-			PriceCeil:           in.Filters.PriceCeil,
-			PriceFloor:          in.Filters.PriceFloor,
-			QuantityFloor:       in.Filters.QuantityFloor,
-			CategoryIDs:         in.Filters.CategoryIDs,
-			TagIDs:              in.Filters.TagIDs,
-			CreatedAtOrderByAsc: in.Filters.CreatedAtOrderByAsc,
-		}
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
 	}
 
+	filters := mapToysFiltersIn(in.GetFilters())
+
 	var pagination *entities.Pagination
 	if in.GetPagination() != nil {
 		pagination = &entities.Pagination{
@@ -329,15 +415,10 @@ func (api *ServerAPI) GetUserToys(
 			err,
 		)
 
-		return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
+		return nil, statuses.FromError(ctx, err)
 	}
 
-	processedToys := make([]*toys.GetToyOut, len(userToys))
-	for i, toy := range userToys {
-		processedToys[i] = mapToyToOut(toy)
-	}
-
-	return &toys.GetToysOut{Toys: processedToys}, nil
+	return &toys.GetToysOut{Toys: mapToysToOut(userToys, in.GetReadMask())}, nil
 }
 
 // AddToy handler adds new Toy for Master.
@@ -357,19 +438,398 @@ func (api *ServerAPI) AddToy(ctx context.Context, in *toys.AddToyIn) (*toys.AddT
 	if err != nil {
 		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to add new Toy", err)
 
-		switch {
-		case errors.As(err, &validationError):
-			return nil, &customgrpc.BaseError{Status: codes.FailedPrecondition, Message: err.Error()}
-		case errors.As(err, &masterNotFoundError),
-			errors.As(err, &categoryNotFoundError),
-			errors.As(err, &tagNotFoundError):
-			return nil, &customgrpc.BaseError{Status: codes.NotFound, Message: err.Error()}
-		case errors.As(err, &toyAlreadyExistsError):
-			return nil, &customgrpc.BaseError{Status: codes.AlreadyExists, Message: err.Error()}
-		default:
-			return nil, &customgrpc.BaseError{Status: codes.Internal, Message: err.Error()}
-		}
+		return nil, statuses.FromError(ctx, err)
 	}
 
 	return &toys.AddToyOut{ToyID: toyID}, nil
 }
+
+// ImportToys handler imports Toys of Master from CSV or JSON Lines file, streamed in chunks,
+// and returns report for every line of file.
+func (api *ServerAPI) ImportToys(stream toys.ToysService_ImportToysServer) error {
+	ctx := stream.Context()
+
+	// Empty stream is reported as import without format:
+	header, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to receive Toys import", err)
+
+		return err
+	}
+
+	reader := &chunksReader{stream: stream, chunk: header.GetChunk(), eof: err != nil}
+
+	rows, failures, err := imports.ParseToys(reader, header.GetFormat())
+	if reader.recvErr != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to receive Toys import", reader.recvErr)
+
+		return reader.recvErr
+	}
+
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to parse imported Toys", err)
+
+		return statuses.FromError(ctx, err)
+	}
+
+	results, err := api.useCases.ImportToys(ctx, header.GetUserID(), rows, header.GetDryRun())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to import Toys of User with ID=%d", header.GetUserID()),
+			err,
+		)
+
+		return statuses.FromError(ctx, err)
+	}
+
+	// Internal failures of single lines are reported to client without their causes, so causes are only logged:
+	for _, result := range results {
+		if result.Err != nil {
+			logging.LogErrorContext(
+				ctx,
+				api.logger,
+				fmt.Sprintf("Error occurred while trying to import Toy of User with ID=%d", header.GetUserID()),
+				result.Err,
+			)
+		}
+	}
+
+	results = append(results, failures...)
+	slices.SortFunc(
+		results,
+		func(a, b entities.ImportToyResult) int {
+			return cmp.Compare(a.Line, b.Line)
+		},
+	)
+
+	return stream.SendAndClose(mapImportToysOut(results))
+}
+
+// ExportToys handler streams Toys of Master or all Toys, selected by filters, as file of requested format,
+// which is split into chunks. File is written by batches of Toys, so whole export is never kept in memory.
+// Batches are read ahead of sending, so slow client doesn't hold database connection.
+func (api *ServerAPI) ExportToys(in *toys.ExportToysIn, stream toys.ToysService_ExportToysServer) error {
+	ctx := stream.Context()
+
+	categories, err := api.useCases.GetAllCategories(ctx)
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to get Categories for Toys export", err)
+
+		return statuses.FromError(ctx, err)
+	}
+
+	sender := &chunksWriter{stream: stream}
+	buffer := bufio.NewWriterSize(sender, exportChunkSize)
+
+	writer, err := exports.NewWriter(buffer, in.GetFormat(), categories, in.GetShop())
+	if err == nil {
+		err = api.useCases.ExportToys(ctx, in.MasterID, mapToysFiltersIn(in.GetFilters()), writer.WriteToys)
+	}
+
+	if err == nil {
+		err = writer.Close()
+	}
+
+	if err == nil {
+		err = buffer.Flush()
+	}
+
+	if sender.sendErr != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to send exported Toys", sender.sendErr)
+
+		return sender.sendErr
+	}
+
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to export Toys", err)
+
+		return statuses.FromError(ctx, err)
+	}
+
+	return nil
+}
+
+// AddFavourite handler adds Toy to User's Favourites.
+func (api *ServerAPI) AddFavourite(ctx context.Context, in *toys.AddFavouriteIn) (*toys.AddFavouriteOut, error) {
+	favouriteID, err := api.useCases.AddFavourite(ctx, in.GetUserID(), in.GetToyID())
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to add Toy with ID=%d to Favourites for User with ID=%d",
+				in.GetToyID(),
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.AddFavouriteOut{FavouriteID: favouriteID}, nil
+}
+
+// RemoveFavourite handler removes Toy from User's Favourites.
+func (api *ServerAPI) RemoveFavourite(ctx context.Context, in *toys.RemoveFavouriteIn) (*emptypb.Empty, error) {
+	if err := api.useCases.RemoveFavourite(ctx, in.GetUserID(), in.GetToyID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to remove Toy with ID=%d from Favourites for User with ID=%d",
+				in.GetToyID(),
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetUserFavourites handler returns all Toys, which were added to Favourites by User with provided ID.
+func (api *ServerAPI) GetUserFavourites(
+	ctx context.Context,
+	in *toys.GetUserFavouritesIn,
+) (*toys.GetToysOut, error) {
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	filters := mapToysFiltersIn(in.GetFilters())
+
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	favouriteToys, err := api.useCases.GetUserFavourites(ctx, in.GetUserID(), pagination, filters)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf(
+				"Error occurred while trying to get Favourites for User with ID=%d",
+				in.GetUserID(),
+			),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.GetToysOut{Toys: mapToysToOut(favouriteToys, in.GetReadMask())}, nil
+}
+
+func (api *ServerAPI) CountUserFavourites(
+	ctx context.Context,
+	in *toys.CountUserFavouritesIn,
+) (*toys.CountOut, error) {
+	filters := mapToysFiltersIn(in.GetFilters())
+
+	count, err := api.useCases.CountUserFavourites(ctx, in.GetUserID(), filters)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to count Favourites for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.CountOut{Count: count}, nil
+}
+
+// GetFeed handler returns newly published Toys of Masters, which are followed by User with provided ID.
+func (api *ServerAPI) GetFeed(ctx context.Context, in *toys.GetFeedIn) (*toys.GetFeedOut, error) {
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	// Feed is read from the beginning, if cursor is not provided:
+	var cursor *entities.FeedCursor
+	if in.GetCursor() != "" {
+		if cursor, err = mapFeedCursorIn(in.GetCursor()); err != nil {
+			logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to parse Feed cursor", err)
+
+			return nil, &customgrpc.BaseError{Status: codes.InvalidArgument, Message: err.Error()}
+		}
+	}
+
+	feedToys, err := api.useCases.GetFeed(ctx, in.GetUserID(), cursor, in.Limit)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to get Feed for User with ID=%d", in.GetUserID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	out := &toys.GetFeedOut{Toys: mapToysToOut(feedToys, in.GetReadMask())}
+	if len(feedToys) > 0 {
+		nextCursor := mapFeedCursorToOut(feedToys[len(feedToys)-1])
+		out.NextCursor = &nextCursor
+	}
+
+	return out, nil
+}
+
+// ListModerationQueue handler returns Toys, waiting for moderators decision, starting from the oldest one.
+func (api *ServerAPI) ListModerationQueue(
+	ctx context.Context,
+	in *toys.ListModerationQueueIn,
+) (*toys.GetToysOut, error) {
+	ctx, err := withReadMask(ctx, in.GetReadMask())
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Invalid read mask", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	var pagination *entities.Pagination
+	if in.GetPagination() != nil {
+		pagination = &entities.Pagination{
+			Limit:  in.Pagination.Limit,
+			Offset: in.Pagination.Offset,
+		}
+	}
+
+	queue, err := api.useCases.ListModerationQueue(ctx, pagination)
+	if err != nil {
+		logging.LogErrorContext(ctx, api.logger, "Error occurred while trying to get moderation queue", err)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &toys.GetToysOut{Toys: mapToysToOut(queue, in.GetReadMask())}, nil
+}
+
+// ApproveToy handler publishes Toy, waiting for moderators decision or rejected earlier.
+func (api *ServerAPI) ApproveToy(ctx context.Context, in *toys.ApproveToyIn) (*emptypb.Empty, error) {
+	if err := api.useCases.ApproveToy(ctx, in.GetID()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to approve Toy with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RejectToy handler hides Toy with provided reason, which is shown to Master.
+func (api *ServerAPI) RejectToy(ctx context.Context, in *toys.RejectToyIn) (*emptypb.Empty, error) {
+	if err := api.useCases.RejectToy(ctx, in.GetID(), in.GetReason()); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to reject Toy with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResubmitToy handler sends rejected Toy to moderation queue again with optional Master's appeal.
+func (api *ServerAPI) ResubmitToy(ctx context.Context, in *toys.ResubmitToyIn) (*emptypb.Empty, error) {
+	var appeal *string
+	if in != nil {
+		appeal = in.Appeal
+	}
+
+	if err := api.useCases.ResubmitToy(ctx, in.GetID(), appeal); err != nil {
+		logging.LogErrorContext(
+			ctx,
+			api.logger,
+			fmt.Sprintf("Error occurred while trying to resubmit Toy with ID=%d", in.GetID()),
+			err,
+		)
+
+		return nil, statuses.FromError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// chunksReader reads imported file from chunks of ImportToys stream. Error of stream is saved to recvErr,
+// so it is not confused with errors of parsing.
+type chunksReader struct {
+	stream  toys.ToysService_ImportToysServer
+	chunk   []byte
+	eof     bool
+	recvErr error
+}
+
+func (reader *chunksReader) Read(p []byte) (int, error) {
+	for len(reader.chunk) == 0 {
+		if reader.eof {
+			return 0, io.EOF
+		}
+
+		in, err := reader.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			reader.eof = true
+
+			continue
+		}
+
+		if err != nil {
+			reader.recvErr = err
+
+			return 0, err
+		}
+
+		reader.chunk = in.GetChunk()
+	}
+
+	n := copy(p, reader.chunk)
+	reader.chunk = reader.chunk[n:]
+
+	return n, nil
+}
+
+// chunksWriter sends written data as chunks of ExportToys stream. Error of stream is saved to sendErr,
+// so it is not confused with errors of export.
+type chunksWriter struct {
+	stream  toys.ToysService_ExportToysServer
+	sendErr error
+}
+
+func (writer *chunksWriter) Write(p []byte) (int, error) {
+	// Buffer passes large writes as is, so they are split to not exceed chunk size:
+	for offset := 0; offset < len(p); offset += exportChunkSize {
+		chunk := p[offset:min(offset+exportChunkSize, len(p))]
+		if err := writer.stream.Send(&toys.ExportToysOut{Chunk: chunk}); err != nil {
+			writer.sendErr = err
+
+			return offset, err
+		}
+	}
+
+	return len(p), nil
+}
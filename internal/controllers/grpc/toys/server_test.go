@@ -1,34 +1,51 @@
 package toys
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/DKhorkov/hmtm-toys/api/protobuf/generated/go/toys"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
 	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
 	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
 	mocklogger "github.com/DKhorkov/libs/logging/mocks"
 	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/validation"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
 	ctx = context.Background()
 	toy = &entities.Toy{
-		ID:          toyID,
-		MasterID:    masterID,
-		CategoryID:  categoryID,
-		Name:        "test toy",
-		Description: "test description",
-		Quantity:    1,
-		Price:       110,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:               toyID,
+		MasterID:         masterID,
+		CategoryID:       categoryID,
+		Name:             "test toy",
+		Description:      "test description",
+		Quantity:         1,
+		Price:            110,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		FavouritesCount:  2,
+		AverageRating:    4.5,
+		ReviewsCount:     2,
+		Available:        true,
+		ModerationStatus: entities.ToyModerationStatusRejected,
+		ModerationFlags:  pointers.New("external links in description"),
+		RejectionReason:  pointers.New("Ссылки на сторонние сайты запрещены"),
+		Appeal:           pointers.New("Ссылки удалены из описания"),
 		Tags: []entities.Tag{
 			{
 				ID:   tagID,
@@ -78,6 +95,131 @@ func TestToysServer_GetToy(t *testing.T) {
 			},
 			expected: mappedToy,
 		},
+		{
+			name: "success with User of Master",
+			in: &toys.GetToyIn{
+				ID:      toyID,
+				Include: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(toy, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetUsersByIDs(gomock.Any(), []uint64{userID}).
+					Return(
+						map[uint64]entities.User{
+							userID: {ID: userID, DisplayName: "test user", CreatedAt: now},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected: func() *toys.GetToyOut {
+				out := mapToyToOut(*toy)
+				out.User = &toys.User{ID: userID, DisplayName: "test user", CreatedAt: timestamppb.New(now)}
+
+				return out
+			}(),
+		},
+		{
+			name: "without User, when SSO is unavailable",
+			in: &toys.GetToyIn{
+				ID:      toyID,
+				Include: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(toy, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetUsersByIDs(gomock.Any(), []uint64{userID}).
+					Return(nil, errors.New("sso is unavailable")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expected: mappedToy,
+		},
+		{
+			name: "without User, when Master lookup failed",
+			in: &toys.GetToyIn{
+				ID:      toyID,
+				Include: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(toy, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expected: mappedToy,
+		},
+		{
+			name: "unsupported read mask path",
+			in: &toys.GetToyIn{
+				ID:       toyID,
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "unsupported include path",
+			in: &toys.GetToyIn{
+				ID:      toyID,
+				Include: &fieldmaskpb.FieldMask{Paths: []string{"reviews"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
 		{
 			name: "Toy not found",
 			in: &toys.GetToyIn{
@@ -147,6 +289,167 @@ func TestToysServer_GetToy(t *testing.T) {
 	}
 }
 
+func TestToysServer_GetToyWithReadMask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	toysServer := &ServerAPI{
+		logger:   mocklogger.NewMockLogger(ctrl),
+		useCases: useCases,
+	}
+
+	useCases.
+		EXPECT().
+		GetToyByID(gomock.Any(), toyID).
+		DoAndReturn(func(ctx context.Context, _ uint64) (*entities.Toy, error) {
+			require.Equal(t, readmasks.ToyRelations{Tags: true}, readmasks.ToyRelationsFromContext(ctx))
+
+			return toy, nil
+		}).
+		Times(1)
+
+	actual, err := toysServer.GetToy(
+		ctx,
+		&toys.GetToyIn{
+			ID:       toyID,
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"ID", "name", "tags"}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, toyID, actual.GetID())
+	require.Equal(t, toy.Name, actual.GetName())
+	require.Len(t, actual.GetTags(), 1)
+	require.Empty(t, actual.GetDescription())
+	require.Empty(t, actual.GetAttachments())
+}
+
+func TestToysServer_GetToyWithModerationStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	toysServer := &ServerAPI{
+		logger:   mocklogger.NewMockLogger(ctrl),
+		useCases: useCases,
+	}
+
+	useCases.
+		EXPECT().
+		GetToyByID(gomock.Any(), toyID).
+		DoAndReturn(func(ctx context.Context, _ uint64) (*entities.Toy, error) {
+			require.Equal(
+				t,
+				[]entities.ToyModerationStatus{entities.ToyModerationStatusRejected},
+				visibility.ToyModerationStatusesFromContext(ctx),
+			)
+
+			return toy, nil
+		}).
+		Times(1)
+
+	actual, err := toysServer.GetToy(
+		ctx,
+		&toys.GetToyIn{
+			ID:                 toyID,
+			ModerationStatuses: []toys.ToyModerationStatus{toys.ToyModerationStatus_TOY_MODERATION_STATUS_REJECTED},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, toyID, actual.GetID())
+}
+
+func TestToysServer_BatchGetToys(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.BatchGetToysIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.BatchGetToysOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.BatchGetToysIn{
+				IDs: []uint64{toyID, 2},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchGetToys(gomock.Any(), []uint64{toyID, 2}).
+					Return([]entities.Toy{*toy}, []uint64{2}, nil).
+					Times(1)
+			},
+			expected: &toys.BatchGetToysOut{
+				Toys:       []*toys.GetToyOut{mapToyToOut(*toy)},
+				MissingIDs: []uint64{2},
+			},
+		},
+		{
+			name: "too many IDs",
+			in: &toys.BatchGetToysIn{
+				IDs: []uint64{toyID},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchGetToys(gomock.Any(), []uint64{toyID}).
+					Return(nil, nil, &customerrors.ValidationError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "internal error",
+			in: &toys.BatchGetToysIn{
+				IDs: []uint64{toyID},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchGetToys(gomock.Any(), []uint64{toyID}).
+					Return(nil, nil, errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.BatchGetToys(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestToysServer_GetToys(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -206,6 +509,20 @@ func TestToysServer_GetToys(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "unsupported read mask path",
+			in: &toys.GetToysIn{
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"tags.name"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
 		{
 			name: "error",
 			in: &toys.GetToysIn{
@@ -282,6 +599,36 @@ func TestToysServer_GetToys(t *testing.T) {
 	}
 }
 
+func TestToysServer_GetToysWithReadMask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	toysServer := &ServerAPI{
+		logger:   mocklogger.NewMockLogger(ctrl),
+		useCases: useCases,
+	}
+
+	useCases.
+		EXPECT().
+		GetToys(gomock.Any(), nil, nil).
+		DoAndReturn(func(ctx context.Context, _ *entities.Pagination, _ *entities.ToysFilters) ([]entities.Toy, error) {
+			require.Equal(t, readmasks.ToyRelations{}, readmasks.ToyRelationsFromContext(ctx))
+
+			return []entities.Toy{*toy}, nil
+		}).
+		Times(1)
+
+	actual, err := toysServer.GetToys(
+		ctx,
+		&toys.GetToysIn{ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"ID", "name"}}},
+	)
+	require.NoError(t, err)
+	require.Len(t, actual.GetToys(), 1)
+	require.Equal(t, toyID, actual.GetToys()[0].GetID())
+	require.Equal(t, toy.Name, actual.GetToys()[0].GetName())
+	require.Zero(t, actual.GetToys()[0].GetPrice())
+	require.Empty(t, actual.GetToys()[0].GetTags())
+}
+
 func TestToysServer_CountToys(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -946,6 +1293,41 @@ func TestToysServer_AddToy(t *testing.T) {
 				ToyID: toyID,
 			},
 		},
+		{
+			name: "Master blocked",
+			in: &toys.AddToyIn{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "test toy",
+				Description: "test description",
+				Quantity:    1,
+				Price:       110,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddToy(
+						gomock.Any(),
+						entities.RawAddToyDTO{
+							UserID:      userID,
+							CategoryID:  categoryID,
+							Name:        "test toy",
+							Description: "test description",
+							Quantity:    1,
+							Price:       110,
+						},
+					).
+					Return(uint64(0), &customerrors.MasterBlockedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.PermissionDenied,
+		},
 		{
 			name: "Toy already exists",
 			in: &toys.AddToyIn{
@@ -1169,7 +1551,7 @@ func TestToysServer_AddToy(t *testing.T) {
 							Attachments: []string{"test attachment"},
 						},
 					).
-					Return(uint64(0), validationError).
+					Return(uint64(0), &validation.Error{}).
 					Times(1)
 
 				logger.
@@ -1180,25 +1562,222 @@ func TestToysServer_AddToy(t *testing.T) {
 			errorExpected: true,
 			errorCode:     codes.FailedPrecondition,
 		},
-	}
-
-	ctrl := gomock.NewController(t)
-	useCases := mockusecases.NewMockUseCases(ctrl)
-	logger := mocklogger.NewMockLogger(ctrl)
-	toysServer := &ServerAPI{
-		logger:   logger,
-		useCases: useCases,
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			if tc.setupMocks != nil {
-				tc.setupMocks(useCases, logger)
-			}
-
-			actual, err := toysServer.AddToy(ctx, tc.in)
-			if tc.errorExpected {
-				require.Error(t, err)
+		{
+			name: "field violations",
+			in: &toys.AddToyIn{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "test toy",
+				Description: "test description",
+				Quantity:    1,
+				Price:       110,
+				TagIDs:      []uint32{tagID},
+				Attachments: []string{"test attachment"},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddToy(
+						gomock.Any(),
+						entities.RawAddToyDTO{
+							UserID:      userID,
+							CategoryID:  categoryID,
+							Name:        "test toy",
+							Description: "test description",
+							Quantity:    1,
+							Price:       110,
+							TagIDs:      []uint32{tagID},
+							Attachments: []string{"test attachment"},
+						},
+					).
+					Return(
+						uint64(0),
+						&customerrors.ValidationError{
+							Violations: []customerrors.FieldViolation{
+								{Field: "name", Code: customerrors.ViolationCodeInvalidFormat, Message: "invalid toy name"},
+								{Field: "price", Code: customerrors.ViolationCodeOutOfRange, Message: "invalid toy price"},
+							},
+						},
+					).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.AddToy(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestToysServer_BatchUpdateToys(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.BatchUpdateToysIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.BatchUpdateToysOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.BatchUpdateToysIn{
+				UserID: userID,
+				Items: []*toys.BatchUpdateToyItem{
+					{ID: toyID, Price: pointers.New[float32](90)},
+					{ID: 2, Quantity: pointers.New[uint32](3)},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchUpdateToys(
+						gomock.Any(),
+						userID,
+						[]entities.BatchUpdateToyDTO{
+							{ID: toyID, Price: pointers.New[float32](90)},
+							{ID: 2, Quantity: pointers.New[uint32](3)},
+						},
+					).
+					Return([]entities.BatchUpdateToyResult{{ID: toyID}, {ID: 2}}, nil).
+					Times(1)
+			},
+			expected: &toys.BatchUpdateToysOut{
+				Applied: true,
+				Results: []*toys.BatchUpdateToyResult{
+					{ID: toyID, Violations: []*toys.ImportViolation{}},
+					{ID: 2, Violations: []*toys.ImportViolation{}},
+				},
+			},
+		},
+		{
+			name: "invalid item",
+			in: &toys.BatchUpdateToysIn{
+				UserID: userID,
+				Items: []*toys.BatchUpdateToyItem{
+					{ID: toyID, Quantity: pointers.New[uint32](3)},
+					{ID: 2},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchUpdateToys(gomock.Any(), userID, gomock.Any()).
+					Return(
+						[]entities.BatchUpdateToyResult{
+							{ID: toyID},
+							{
+								ID: 2,
+								Violations: []customerrors.FieldViolation{
+									{Field: "ID", Code: customerrors.ViolationCodeNotFound, Message: "toy not found"},
+								},
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected: &toys.BatchUpdateToysOut{
+				Results: []*toys.BatchUpdateToyResult{
+					{ID: toyID, Violations: []*toys.ImportViolation{}},
+					{
+						ID: 2,
+						Violations: []*toys.ImportViolation{
+							{Field: "ID", Code: customerrors.ViolationCodeNotFound, Message: "toy not found"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Master is blocked",
+			in: &toys.BatchUpdateToysIn{
+				UserID: userID,
+				Items:  []*toys.BatchUpdateToyItem{{ID: toyID, Quantity: pointers.New[uint32](3)}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchUpdateToys(gomock.Any(), userID, gomock.Any()).
+					Return(nil, &customerrors.MasterBlockedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.PermissionDenied,
+		},
+		{
+			name: "internal error",
+			in: &toys.BatchUpdateToysIn{
+				UserID: userID,
+				Items:  []*toys.BatchUpdateToyItem{{ID: toyID, Quantity: pointers.New[uint32](3)}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					BatchUpdateToys(gomock.Any(), userID, gomock.Any()).
+					Return(nil, errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.BatchUpdateToys(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
 				require.Equal(t, tc.errorCode, status.Code(err))
 			} else {
 				require.NoError(t, err)
@@ -1337,6 +1916,95 @@ func TestToysServer_UpdateToy(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name: "empty lists without update mask",
+			in: &toys.UpdateToyIn{
+				ID:          toyID,
+				Name:        pointers.New[string]("test toy"),
+				TagIDs:      []uint32{},
+				Attachments: []string{},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateToy(
+						gomock.Any(),
+						entities.RawUpdateToyDTO{
+							ID:   toyID,
+							Name: pointers.New[string]("test toy"),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "success with update mask",
+			in: &toys.UpdateToyIn{
+				ID:          toyID,
+				Name:        pointers.New[string]("test toy"),
+				Price:       pointers.New[float32](110),
+				Attachments: []string{"test attachment"},
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"name", "tagIDs"}},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateToy(
+						gomock.Any(),
+						entities.RawUpdateToyDTO{
+							ID:     toyID,
+							Name:   pointers.New[string]("test toy"),
+							TagIDs: []uint32{},
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid update mask",
+			in: &toys.UpdateToyIn{
+				ID:         toyID,
+				Name:       pointers.New[string]("test toy"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ID"}},
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Master blocked",
+			in: &toys.UpdateToyIn{
+				ID:   toyID,
+				Name: pointers.New[string]("test toy"),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateToy(
+						gomock.Any(),
+						entities.RawUpdateToyDTO{
+							ID:   toyID,
+							Name: pointers.New[string]("test toy"),
+						},
+					).
+					Return(&customerrors.MasterBlockedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.PermissionDenied,
+		},
 		{
 			name: "Toy not found",
 			in: &toys.UpdateToyIn{
@@ -1443,7 +2111,7 @@ func TestToysServer_UpdateToy(t *testing.T) {
 							Attachments: []string{"test attachment"},
 						},
 					).
-					Return(validationError).
+					Return(&validation.Error{}).
 					Times(1)
 
 				logger.
@@ -1454,7 +2122,51 @@ func TestToysServer_UpdateToy(t *testing.T) {
 			errorExpected: true,
 			errorCode:     codes.FailedPrecondition,
 		},
-	}
+		{
+			name: "field violations",
+			in: &toys.UpdateToyIn{
+				ID:          toyID,
+				CategoryID:  pointers.New[uint32](categoryID),
+				Name:        pointers.New[string]("test toy"),
+				Description: pointers.New[string]("test description"),
+				Quantity:    pointers.New[uint32](1),
+				Price:       pointers.New[float32](110),
+				TagIDs:      []uint32{tagID},
+				Attachments: []string{"test attachment"},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					UpdateToy(
+						gomock.Any(),
+						entities.RawUpdateToyDTO{
+							ID:          toyID,
+							CategoryID:  pointers.New[uint32](categoryID),
+							Name:        pointers.New[string]("test toy"),
+							Description: pointers.New[string]("test description"),
+							Quantity:    pointers.New[uint32](1),
+							Price:       pointers.New[float32](110),
+							TagIDs:      []uint32{tagID},
+							Attachments: []string{"test attachment"},
+						},
+					).
+					Return(&customerrors.ValidationError{
+						Violations: []customerrors.FieldViolation{
+							{Field: "name", Code: customerrors.ViolationCodeInvalidFormat, Message: "invalid toy name"},
+							{Field: "price", Code: customerrors.ViolationCodeOutOfRange, Message: "invalid toy price"},
+						},
+					}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+	}
 
 	ctrl := gomock.NewController(t)
 	useCases := mockusecases.NewMockUseCases(ctrl)
@@ -1480,3 +2192,1254 @@ func TestToysServer_UpdateToy(t *testing.T) {
 		})
 	}
 }
+
+func TestToysServer_AddFavourite(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.AddFavouriteIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.AddFavouriteOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.AddFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddFavourite(gomock.Any(), userID, toyID).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: &toys.AddFavouriteOut{FavouriteID: 1},
+		},
+		{
+			name: "Toy not found",
+			in: &toys.AddFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddFavourite(gomock.Any(), userID, toyID).
+					Return(uint64(0), &customerrors.ToyNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "Favourite already exists",
+			in: &toys.AddFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddFavourite(gomock.Any(), userID, toyID).
+					Return(uint64(0), &customerrors.FavouriteAlreadyExistsError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.AlreadyExists,
+		},
+		{
+			name: "internal error",
+			in: &toys.AddFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					AddFavourite(gomock.Any(), userID, toyID).
+					Return(uint64(0), errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.AddFavourite(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestToysServer_RemoveFavourite(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.RemoveFavouriteIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.RemoveFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RemoveFavourite(gomock.Any(), userID, toyID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Favourite not found",
+			in: &toys.RemoveFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RemoveFavourite(gomock.Any(), userID, toyID).
+					Return(&customerrors.FavouriteNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in: &toys.RemoveFavouriteIn{
+				UserID: userID,
+				ToyID:  toyID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RemoveFavourite(gomock.Any(), userID, toyID).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := toysServer.RemoveFavourite(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestToysServer_GetUserFavourites(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.GetUserFavouritesIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetToysOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.GetUserFavouritesIn{
+				UserID: userID,
+				Pagination: &toys.Pagination{
+					Limit:  pointers.New[uint64](1),
+					Offset: pointers.New[uint64](1),
+				},
+				Filters: &toys.ToysFilters{
+					Search: pointers.New("toy"),
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserFavourites(
+						gomock.Any(),
+						userID,
+						&entities.Pagination{
+							Limit:  pointers.New[uint64](1),
+							Offset: pointers.New[uint64](1),
+						},
+						&entities.ToysFilters{
+							Search: pointers.New("toy"),
+						},
+					).
+					Return([]entities.Toy{*toy}, nil).
+					Times(1)
+			},
+			expected: &toys.GetToysOut{
+				Toys: []*toys.GetToyOut{
+					mapToyToOut(*toy),
+				},
+			},
+		},
+		{
+			name: "error",
+			in: &toys.GetUserFavouritesIn{
+				UserID: userID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetUserFavourites(gomock.Any(), userID, nil, nil).
+					Return(nil, errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.GetUserFavourites(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestToysServer_GetFeed(t *testing.T) {
+	nextCursor := mapFeedCursorToOut(*toy)
+
+	testCases := []struct {
+		name          string
+		in            *toys.GetFeedIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetFeedOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.GetFeedIn{
+				UserID: userID,
+				Cursor: pointers.New(nextCursor),
+				Limit:  pointers.New[uint64](1),
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetFeed(
+						gomock.Any(),
+						userID,
+						&entities.FeedCursor{
+							CreatedAt: toy.CreatedAt.UTC(),
+							ToyID:     toy.ID,
+						},
+						pointers.New[uint64](1),
+					).
+					Return([]entities.Toy{*toy}, nil).
+					Times(1)
+			},
+			expected: &toys.GetFeedOut{
+				Toys:       []*toys.GetToyOut{mappedToy},
+				NextCursor: pointers.New(nextCursor),
+			},
+		},
+		{
+			name: "empty Feed",
+			in: &toys.GetFeedIn{
+				UserID: userID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetFeed(gomock.Any(), userID, nil, nil).
+					Return([]entities.Toy{}, nil).
+					Times(1)
+			},
+			expected: &toys.GetFeedOut{
+				Toys: []*toys.GetToyOut{},
+			},
+		},
+		{
+			name: "invalid cursor",
+			in: &toys.GetFeedIn{
+				UserID: userID,
+				Cursor: pointers.New("invalid"),
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.InvalidArgument,
+		},
+		{
+			name: "internal error",
+			in: &toys.GetFeedIn{
+				UserID: userID,
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetFeed(gomock.Any(), userID, nil, nil).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.GetFeed(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestToysServer_ListModerationQueue(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.ListModerationQueueIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.GetToysOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in: &toys.ListModerationQueueIn{
+				Pagination: &toys.Pagination{Limit: pointers.New[uint64](10)},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ListModerationQueue(gomock.Any(), &entities.Pagination{Limit: pointers.New[uint64](10)}).
+					Return([]entities.Toy{*toy}, nil).
+					Times(1)
+			},
+			expected: &toys.GetToysOut{Toys: []*toys.GetToyOut{mappedToy}},
+		},
+		{
+			name: "internal error",
+			in:   &toys.ListModerationQueueIn{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ListModerationQueue(gomock.Any(), gomock.Nil()).
+					Return(nil, errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			actual, err := toysServer.ListModerationQueue(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestToysServer_ApproveToy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.ApproveToyIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.ApproveToyIn{ID: toyID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ApproveToy(gomock.Any(), toyID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "validation error",
+			in:   &toys.ApproveToyIn{ID: toyID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ApproveToy(gomock.Any(), toyID).
+					Return(&validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Toy not found",
+			in:   &toys.ApproveToyIn{ID: toyID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ApproveToy(gomock.Any(), toyID).
+					Return(&customerrors.ToyNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in:   &toys.ApproveToyIn{ID: toyID},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ApproveToy(gomock.Any(), toyID).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := toysServer.ApproveToy(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestToysServer_RejectToy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.RejectToyIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.RejectToyIn{ID: toyID, Reason: "Ссылки на сторонние сайты запрещены"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectToy(gomock.Any(), toyID, "Ссылки на сторонние сайты запрещены").
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "validation error",
+			in:   &toys.RejectToyIn{ID: toyID, Reason: "Ссылки на сторонние сайты запрещены"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectToy(gomock.Any(), toyID, "Ссылки на сторонние сайты запрещены").
+					Return(&validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Toy not found",
+			in:   &toys.RejectToyIn{ID: toyID, Reason: "Ссылки на сторонние сайты запрещены"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectToy(gomock.Any(), toyID, "Ссылки на сторонние сайты запрещены").
+					Return(&customerrors.ToyNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "internal error",
+			in:   &toys.RejectToyIn{ID: toyID, Reason: "Ссылки на сторонние сайты запрещены"},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					RejectToy(gomock.Any(), toyID, "Ссылки на сторонние сайты запрещены").
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := toysServer.RejectToy(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestToysServer_ResubmitToy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		in            *toys.ResubmitToyIn
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			in:   &toys.ResubmitToyIn{ID: toyID, Appeal: pointers.New("Ссылки удалены из описания")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), toyID, pointers.New("Ссылки удалены из описания")).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "validation error",
+			in:   &toys.ResubmitToyIn{ID: toyID, Appeal: pointers.New("Ссылки удалены из описания")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), toyID, pointers.New("Ссылки удалены из описания")).
+					Return(&validation.Error{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "Toy not found",
+			in:   &toys.ResubmitToyIn{ID: toyID, Appeal: pointers.New("Ссылки удалены из описания")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), toyID, pointers.New("Ссылки удалены из описания")).
+					Return(&customerrors.ToyNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "Master not found",
+			in:   &toys.ResubmitToyIn{ID: toyID, Appeal: pointers.New("Ссылки удалены из описания")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), toyID, pointers.New("Ссылки удалены из описания")).
+					Return(&customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name: "Master blocked",
+			in:   &toys.ResubmitToyIn{ID: toyID, Appeal: pointers.New("Ссылки удалены из описания")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), toyID, pointers.New("Ссылки удалены из описания")).
+					Return(&customerrors.MasterBlockedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.PermissionDenied,
+		},
+		{
+			name: "internal error",
+			in:   &toys.ResubmitToyIn{ID: toyID, Appeal: pointers.New("Ссылки удалены из описания")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ResubmitToy(gomock.Any(), toyID, pointers.New("Ссылки удалены из описания")).
+					Return(errors.New("test error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			_, err := toysServer.ResubmitToy(ctx, tc.in)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// importStream sends queued messages to ImportToys and collects its report.
+type importStream struct {
+	grpc.ServerStream
+	messages []*toys.ImportToysIn
+	recvErr  error
+	out      *toys.ImportToysOut
+}
+
+func (stream *importStream) Context() context.Context {
+	return ctx
+}
+
+func (stream *importStream) Recv() (*toys.ImportToysIn, error) {
+	if len(stream.messages) == 0 {
+		if stream.recvErr != nil {
+			return nil, stream.recvErr
+		}
+
+		return nil, io.EOF
+	}
+
+	in := stream.messages[0]
+	stream.messages = stream.messages[1:]
+
+	return in, nil
+}
+
+func (stream *importStream) SendAndClose(out *toys.ImportToysOut) error {
+	stream.out = out
+
+	return nil
+}
+
+func TestToysServer_ImportToys(t *testing.T) {
+	header := &toys.ImportToysIn{
+		UserID: userID,
+		Format: toys.ImportFormat_IMPORT_FORMAT_CSV,
+		DryRun: true,
+		Chunk:  []byte("name,description,price,quantity,category\nМишка,Вязаный мишка,1500,2,Игру"),
+	}
+
+	rows := []entities.ImportToyRow{
+		{
+			Line:         2,
+			Name:         "Мишка",
+			Description:  "Вязаный мишка",
+			Price:        1500,
+			Quantity:     2,
+			CategoryName: "Игрушки",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		stream        *importStream
+		setupMocks    func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		expected      *toys.ImportToysOut
+		errorExpected bool
+		errorCode     codes.Code
+	}{
+		{
+			name: "success",
+			stream: &importStream{
+				messages: []*toys.ImportToysIn{
+					header,
+					{Chunk: []byte("шки\nЗайка,Вязаный зайка,дорого,1,Игрушки\n")},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ImportToys(gomock.Any(), userID, rows, true).
+					Return([]entities.ImportToyResult{{Line: 2}}, nil).
+					Times(1)
+			},
+			expected: &toys.ImportToysOut{
+				Results: []*toys.ImportToyResult{
+					{
+						Line:       2,
+						Violations: []*toys.ImportViolation{},
+					},
+					{
+						Line: 3,
+						Violations: []*toys.ImportViolation{
+							{
+								Field:   "price",
+								Code:    customerrors.ViolationCodeInvalidFormat,
+								Message: "invalid toy price",
+							},
+						},
+					},
+				},
+				ValidCount:   1,
+				InvalidCount: 1,
+			},
+		},
+		{
+			name: "failed to import line",
+			stream: &importStream{
+				messages: []*toys.ImportToysIn{
+					header,
+					{Chunk: []byte("шки\n")},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ImportToys(gomock.Any(), userID, rows, true).
+					Return(
+						[]entities.ImportToyResult{
+							{
+								Line: 2,
+								Violations: []customerrors.FieldViolation{
+									{
+										Code:    customerrors.ViolationCodeInternal,
+										Message: "toy was not imported because of internal error",
+									},
+								},
+								Err: errors.New("test"),
+							},
+						},
+						nil,
+					).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expected: &toys.ImportToysOut{
+				Results: []*toys.ImportToyResult{
+					{
+						Line: 2,
+						Violations: []*toys.ImportViolation{
+							{
+								Code:    customerrors.ViolationCodeInternal,
+								Message: "toy was not imported because of internal error",
+							},
+						},
+					},
+				},
+				InvalidCount: 1,
+			},
+		},
+		{
+			name:   "empty stream",
+			stream: &importStream{},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name: "failed to receive chunk",
+			stream: &importStream{
+				messages: []*toys.ImportToysIn{header},
+				recvErr:  status.Error(codes.Canceled, "canceled"),
+			},
+			setupMocks: func(_ *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Canceled,
+		},
+		{
+			name: "failed to import",
+			stream: &importStream{
+				messages: []*toys.ImportToysIn{
+					header,
+					{Chunk: []byte("шки\n")},
+				},
+			},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					ImportToys(gomock.Any(), userID, rows, true).
+					Return(nil, &customerrors.MasterBlockedError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.PermissionDenied,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := toysServer.ImportToys(tc.stream)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tc.stream.out)
+		})
+	}
+}
+
+// exportStream collects chunks, sent by ExportToys.
+type exportStream struct {
+	grpc.ServerStream
+	chunks  [][]byte
+	sendErr error
+}
+
+func (stream *exportStream) Context() context.Context {
+	return ctx
+}
+
+func (stream *exportStream) Send(out *toys.ExportToysOut) error {
+	if stream.sendErr != nil {
+		return stream.sendErr
+	}
+
+	// gRPC serializes message on send, so chunk's buffer is reused by server:
+	stream.chunks = append(stream.chunks, bytes.Clone(out.GetChunk()))
+
+	return nil
+}
+
+func TestToysServer_ExportToys(t *testing.T) {
+	in := &toys.ExportToysIn{
+		MasterID: pointers.New(masterID),
+		Filters:  &toys.ToysFilters{Search: pointers.New("toy")},
+		Format:   toys.ExportFormat_EXPORT_FORMAT_CSV,
+	}
+
+	categories := []entities.Category{{ID: categoryID, Name: "Игрушки"}}
+
+	// Export doesn't fit in one chunk:
+	largeToy := entities.Toy{ID: toyID, CategoryID: categoryID, Description: strings.Repeat("a", exportChunkSize)}
+
+	writeToys := func(
+		_ context.Context,
+		_ *uint64,
+		_ *entities.ToysFilters,
+		fn func(toys []entities.Toy) error,
+	) error {
+		return fn([]entities.Toy{largeToy})
+	}
+
+	testCases := []struct {
+		name           string
+		in             *toys.ExportToysIn
+		stream         *exportStream
+		setupMocks     func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger)
+		chunksExpected int
+		errorExpected  bool
+		errorCode      codes.Code
+	}{
+		{
+			name:   "success",
+			in:     in,
+			stream: &exportStream{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, _ *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return(categories, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					ExportToys(
+						gomock.Any(),
+						pointers.New(masterID),
+						&entities.ToysFilters{Search: pointers.New("toy")},
+						gomock.Any(),
+					).
+					DoAndReturn(writeToys).
+					Times(1)
+			},
+			chunksExpected: 2,
+		},
+		{
+			name:   "unspecified format",
+			in:     &toys.ExportToysIn{},
+			stream: &exportStream{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return(categories, nil).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.FailedPrecondition,
+		},
+		{
+			name:   "Master not found",
+			in:     in,
+			stream: &exportStream{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return(categories, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					ExportToys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.NotFound,
+		},
+		{
+			name:   "failed to get Categories",
+			in:     in,
+			stream: &exportStream{},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return(nil, errors.New("some error")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Internal,
+		},
+		{
+			name:   "failed to send chunk",
+			in:     in,
+			stream: &exportStream{sendErr: status.Error(codes.Canceled, "canceled")},
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger) {
+				useCases.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return(categories, nil).
+					Times(1)
+
+				useCases.
+					EXPECT().
+					ExportToys(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(writeToys).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			errorCode:     codes.Canceled,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	toysServer := &ServerAPI{
+		logger:   logger,
+		useCases: useCases,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(useCases, logger)
+			}
+
+			err := toysServer.ExportToys(tc.in, tc.stream)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Equal(t, tc.errorCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, tc.stream.chunks, tc.chunksExpected)
+
+			for _, chunk := range tc.stream.chunks {
+				require.LessOrEqual(t, len(chunk), exportChunkSize)
+			}
+
+			file := string(bytes.Join(tc.stream.chunks, nil))
+			require.True(t, strings.HasPrefix(file, "id,masterID,name"))
+			require.Contains(t, file, largeToy.Description+",0,0,Игрушки")
+		})
+	}
+}
@@ -8,3 +8,22 @@ type Category struct {
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
+
+// ToyAttribute is optional Toy data, which can be required by Category rules.
+type ToyAttribute string
+
+const (
+	ToyAttributeTags        ToyAttribute = "tags"
+	ToyAttributeAttachments ToyAttribute = "attachments"
+)
+
+// CategoryRules contains Toy validation rules for Category. Not provided rules fall back to default ones.
+type CategoryRules struct {
+	CategoryID           uint32         `json:"categoryId"`
+	PriceFloor           *float32       `json:"priceFloor,omitempty"`
+	PriceCeil            *float32       `json:"priceCeil,omitempty"`
+	QuantityFloor        *uint32        `json:"quantityFloor,omitempty"`
+	QuantityCeil         *uint32        `json:"quantityCeil,omitempty"`
+	DescriptionMinLength *uint32        `json:"descriptionMinLength,omitempty"`
+	RequiredAttributes   []ToyAttribute `json:"requiredAttributes"`
+}
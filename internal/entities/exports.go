@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+// UserDataExportVersion is a version of UserDataExport format. Should be increased on incompatible changes.
+const UserDataExportVersion = 1
+
+// UserDataExport is an archive of all data, which is stored about User. Previous prices of Toys are provided
+// in ToysPriceHistory.
+type UserDataExport struct {
+	Version             uint32               `json:"version"`
+	UserID              uint64               `json:"userId"`
+	ExportedAt          time.Time            `json:"exportedAt"`
+	Master              *Master              `json:"master,omitempty"`
+	MasterStatusHistory []MasterStatusChange `json:"masterStatusHistory,omitempty"`
+	Toys                []Toy                `json:"toys,omitempty"`
+	ToysPriceHistory    []ToyPriceChange     `json:"toysPriceHistory,omitempty"`
+	Favourites          []Favourite          `json:"favourites,omitempty"`
+	Reviews             []Review             `json:"reviews,omitempty"`
+}
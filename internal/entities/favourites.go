@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+type Favourite struct {
+	ID        uint64    `json:"id"`
+	UserID    uint64    `json:"userId"`
+	ToyID     uint64    `json:"toyId"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
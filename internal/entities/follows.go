@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+type Follow struct {
+	ID        uint64    `json:"id"`
+	UserID    uint64    `json:"userId"`
+	MasterID  uint64    `json:"masterId"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// FeedCursor points to the last Toy, which was returned to User in Feed.
+type FeedCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ToyID     uint64    `json:"toyId"`
+}
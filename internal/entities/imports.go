@@ -0,0 +1,25 @@
+package entities
+
+import customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+
+// ImportToyRow is a Toy, parsed from line of imported file. Category and Tags are referenced by names.
+type ImportToyRow struct {
+	Line         uint64   `json:"line"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Price        float32  `json:"price"`
+	Quantity     uint32   `json:"quantity"`
+	CategoryName string   `json:"categoryName"`
+	TagNames     []string `json:"tagNames,omitempty"`
+	Attachments  []string `json:"attachments,omitempty"`
+}
+
+// ImportToyResult is a result of import of single line. ToyID is set only for imported Toys,
+// so it is never set in dry-run mode. Err is a cause of internal failure of line, which is only logged,
+// while client gets violation with ViolationCodeInternal.
+type ImportToyResult struct {
+	Line       uint64                        `json:"line"`
+	ToyID      *uint64                       `json:"toyId,omitempty"`
+	Violations []customerrors.FieldViolation `json:"violations,omitempty"`
+	Err        error                         `json:"-"`
+}
@@ -2,12 +2,36 @@ package entities
 
 import "time"
 
+type MasterStatus string
+
+const (
+	MasterStatusPending   MasterStatus = "pending"   // registered, but not checked by moderators yet
+	MasterStatusVerified  MasterStatus = "verified"  // checked by moderators
+	MasterStatusSuspended MasterStatus = "suspended" // can't add and update Toys
+	MasterStatusBanned    MasterStatus = "banned"    // can't add and update Toys, Toys are hidden
+)
+
 type Master struct {
-	ID        uint64    `json:"id"`
-	UserID    uint64    `json:"userId"`
-	Info      *string   `json:"info,omitempty"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID              uint64       `json:"id"`
+	UserID          uint64       `json:"userId"`
+	Info            *string      `json:"info,omitempty"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+	AverageRating   float32      `json:"averageRating"`
+	ReviewsCount    uint64       `json:"reviewsCount"`
+	FollowersCount  uint64       `json:"followersCount"`
+	ShopName        *string      `json:"shopName,omitempty"`
+	Slug            *string      `json:"slug,omitempty"`
+	Avatar          *string      `json:"avatar,omitempty"`
+	Banner          *string      `json:"banner,omitempty"`
+	City            *string      `json:"city,omitempty"`
+	Region          *string      `json:"region,omitempty"`
+	Policies        *string      `json:"policies,omitempty"`
+	VacationUntil   *time.Time   `json:"vacationUntil,omitempty"`
+	VacationMessage *string      `json:"vacationMessage,omitempty"`
+	Status          MasterStatus `json:"status"`
+	SocialLinks     []string     `json:"socialLinks,omitempty"`
+	ShipsTo         []string     `json:"shipsTo,omitempty"`
 }
 
 type RegisterMasterDTO struct {
@@ -15,12 +39,71 @@ type RegisterMasterDTO struct {
 	Info   *string `json:"info,omitempty"`
 }
 
+// Fields of Master profile, which can be listed in update mask:
+const (
+	MasterFieldInfo        = "info"
+	MasterFieldShopName    = "shopName"
+	MasterFieldSlug        = "slug"
+	MasterFieldAvatar      = "avatar"
+	MasterFieldBanner      = "banner"
+	MasterFieldCity        = "city"
+	MasterFieldRegion      = "region"
+	MasterFieldPolicies    = "policies"
+	MasterFieldSocialLinks = "socialLinks"
+	MasterFieldShipsTo     = "shipsTo"
+)
+
+// UpdateMasterDTO replaces profile of Master. If UpdateMask is provided, only listed fields are replaced,
+// otherwise only provided fields (non-nil pointers and lists) are replaced and the rest are left untouched.
 type UpdateMasterDTO struct {
-	ID   uint64  `json:"id"`
-	Info *string `json:"info,omitempty"`
+	ID          uint64   `json:"id"`
+	Info        *string  `json:"info,omitempty"`
+	ShopName    *string  `json:"shopName,omitempty"`
+	Slug        *string  `json:"slug,omitempty"`
+	Avatar      *string  `json:"avatar,omitempty"`
+	Banner      *string  `json:"banner,omitempty"`
+	City        *string  `json:"city,omitempty"`
+	Region      *string  `json:"region,omitempty"`
+	Policies    *string  `json:"policies,omitempty"`
+	SocialLinks []string `json:"socialLinks,omitempty"`
+	ShipsTo     []string `json:"shipsTo,omitempty"`
+	UpdateMask  []string `json:"updateMask,omitempty"`
+}
+
+// SetVacationModeDTO enables vacation mode of Master till provided time, if Until is set, or disables it otherwise.
+type SetVacationModeDTO struct {
+	MasterID uint64     `json:"masterId"`
+	Until    *time.Time `json:"until,omitempty"`
+	Message  *string    `json:"message,omitempty"`
+}
+
+// MasterStatusChange is a record of Master's moderation status history.
+type MasterStatusChange struct {
+	ID             uint64       `json:"id"`
+	MasterID       uint64       `json:"masterId"`
+	PreviousStatus MasterStatus `json:"previousStatus"`
+	Status         MasterStatus `json:"status"`
+	Reason         string       `json:"reason"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	UpdatedAt      time.Time    `json:"updatedAt"`
+}
+
+type ChangeMasterStatusDTO struct {
+	MasterID uint64       `json:"masterId"`
+	Status   MasterStatus `json:"status"`
+	Reason   string       `json:"reason"`
 }
 
 type MastersFilters struct {
-	Search              *string `json:"search,omitempty"`
-	CreatedAtOrderByAsc *bool   `json:"createdAtOrderByAsc,omitempty"`
+	Search              *string        `json:"search,omitempty"`
+	Name                *string        `json:"name,omitempty"`
+	City                *string        `json:"city,omitempty"`
+	Statuses            []MasterStatus `json:"statuses,omitempty"`
+	CreatedAtOrderByAsc *bool          `json:"createdAtOrderByAsc,omitempty"`
+
+	// IDAfter is a keyset cursor: only Masters with greater IDs are returned, ordered by ID.
+	IDAfter *uint64 `json:"idAfter,omitempty"`
+
+	// ExcludeBannedForReason excludes banned Masters, whose latest status change has provided reason.
+	ExcludeBannedForReason *string `json:"excludeBannedForReason,omitempty"`
 }
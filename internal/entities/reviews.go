@@ -0,0 +1,47 @@
+package entities
+
+import "time"
+
+type Review struct {
+	ID        uint64        `json:"id"`
+	ToyID     uint64        `json:"toyId"`
+	UserID    uint64        `json:"userId"`
+	Rating    uint32        `json:"rating"`
+	Text      *string       `json:"text,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	Photos    []ReviewPhoto `json:"photos,omitempty"`
+}
+
+type ReviewPhoto struct {
+	ID        uint64    `json:"id"`
+	ReviewID  uint64    `json:"reviewId"`
+	Link      string    `json:"link"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type AddReviewDTO struct {
+	ToyID  uint64   `json:"toyId"`
+	UserID uint64   `json:"userId"`
+	Rating uint32   `json:"rating"`
+	Text   *string  `json:"text,omitempty"`
+	Photos []string `json:"photos,omitempty"`
+}
+
+type UpdateReviewDTO struct {
+	ID               uint64   `json:"id"`
+	Rating           *uint32  `json:"rating,omitempty"`
+	Text             *string  `json:"text,omitempty"`
+	PhotosToAdd      []string `json:"photosToAdd,omitempty"`
+	PhotoIDsToDelete []uint64 `json:"photoIdsToDelete,omitempty"`
+}
+
+// RawUpdateReviewDTO contains only changed fields of Review. Photos are replaced by provided list, so empty list
+// removes all of them, and nil list keeps them untouched.
+type RawUpdateReviewDTO struct {
+	ID     uint64   `json:"id"`
+	Rating *uint32  `json:"rating,omitempty"`
+	Text   *string  `json:"text,omitempty"`
+	Photos []string `json:"photos,omitempty"`
+}
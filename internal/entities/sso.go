@@ -18,3 +18,18 @@ type User struct {
 	CreatedAt         time.Time `json:"createdAt"`
 	UpdatedAt         time.Time `json:"updatedAt"`
 }
+
+type UserEventType string
+
+const (
+	UserEventTypeDeleted UserEventType = "user.deleted" // User deleted account in SSO
+	UserEventTypeBanned  UserEventType = "user.banned"  // User was banned by SSO moderators
+)
+
+// UserEvent is published by SSO, when User's account changes in a way, which affects Masters.
+type UserEvent struct {
+	ID         string        `json:"id"`
+	Type       UserEventType `json:"type"`
+	UserID     uint64        `json:"userId"`
+	OccurredAt time.Time     `json:"occurredAt"`
+}
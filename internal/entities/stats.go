@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+type StatsPeriod struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type MasterStats struct {
+	MasterID            uint64           `json:"masterId"`
+	ToysCount           uint64           `json:"toysCount"`
+	InStockToysCount    uint64           `json:"inStockToysCount"`
+	OutOfStockToysCount uint64           `json:"outOfStockToysCount"`
+	TotalStock          uint64           `json:"totalStock"`
+	StockValue          float64          `json:"stockValue"` // sum of price * quantity of all Toys
+	NewToysPerDay       []DailyToysCount `json:"newToysPerDay,omitempty"`
+	MostFavouritedToys  []Toy            `json:"mostFavouritedToys,omitempty"`
+	HighestRatedToys    []Toy            `json:"highestRatedToys,omitempty"`
+	PriceDistribution   []PriceBucket    `json:"priceDistribution,omitempty"`
+}
+
+type DailyToysCount struct {
+	Date  time.Time `json:"date"`
+	Count uint64    `json:"count"`
+}
+
+type PriceBucket struct {
+	Floor float32 `json:"floor"` // inclusive
+	Ceil  float32 `json:"ceil"`  // exclusive, except for the last bucket
+	Count uint64  `json:"count"`
+}
@@ -1,19 +1,39 @@
 package entities
 
-import "time"
+import (
+	"time"
+
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+)
+
+type ToyModerationStatus string
+
+const (
+	ToyModerationStatusApproved ToyModerationStatus = "approved" // visible to everyone
+	ToyModerationStatusPending  ToyModerationStatus = "pending"  // waiting for moderators decision, hidden
+	ToyModerationStatusRejected ToyModerationStatus = "rejected" // rejected by moderators, can be resubmitted by Master
+)
 
 type Toy struct {
-	ID          uint64       `json:"id"`
-	MasterID    uint64       `json:"masterId"`
-	CategoryID  uint32       `json:"categoryId"`
-	Name        string       `json:"name"`
-	Description string       `json:"description"`
-	Price       float32      `json:"price"`
-	Quantity    uint32       `json:"quantity"`
-	CreatedAt   time.Time    `json:"createdAt"`
-	UpdatedAt   time.Time    `json:"updatedAt"`
-	Tags        []Tag        `json:"tags,omitempty"`
-	Attachments []Attachment `json:"attachments,omitempty"`
+	ID               uint64              `json:"id"`
+	MasterID         uint64              `json:"masterId"`
+	CategoryID       uint32              `json:"categoryId"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description"`
+	Price            float32             `json:"price"`
+	Quantity         uint32              `json:"quantity"`
+	CreatedAt        time.Time           `json:"createdAt"`
+	UpdatedAt        time.Time           `json:"updatedAt"`
+	FavouritesCount  uint64              `json:"favouritesCount"`
+	AverageRating    float32             `json:"averageRating"`
+	ReviewsCount     uint64              `json:"reviewsCount"`
+	Available        bool                `json:"available"` // false, while Master is on vacation
+	ModerationStatus ToyModerationStatus `json:"moderationStatus"`
+	ModerationFlags  *string             `json:"moderationFlags,omitempty"` // triggered moderation heuristics
+	RejectionReason  *string             `json:"rejectionReason,omitempty"`
+	Appeal           *string             `json:"appeal,omitempty"` // Master's comment for resubmitted Toy
+	Tags             []Tag               `json:"tags,omitempty"`
+	Attachments      []Attachment        `json:"attachments,omitempty"`
 }
 
 type Attachment struct {
@@ -24,15 +44,27 @@ type Attachment struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// ToyPriceChange is a change of Toy's price, which is saved to price history.
+type ToyPriceChange struct {
+	ID            uint64    `json:"id"`
+	ToyID         uint64    `json:"toyId"`
+	PreviousPrice float32   `json:"previousPrice"`
+	Price         float32   `json:"price"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
 type AddToyDTO struct {
-	MasterID    uint64   `json:"masterId"`
-	CategoryID  uint32   `json:"categoryId"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Price       float32  `json:"price"`
-	Quantity    uint32   `json:"quantity"`
-	TagIDs      []uint32 `json:"tagIds,omitempty"`
-	Attachments []string `json:"attachments,omitempty"`
+	MasterID         uint64              `json:"masterId"`
+	CategoryID       uint32              `json:"categoryId"`
+	Name             string              `json:"name"`
+	Description      string              `json:"description"`
+	Price            float32             `json:"price"`
+	Quantity         uint32              `json:"quantity"`
+	ModerationStatus ToyModerationStatus `json:"moderationStatus"`
+	ModerationFlags  *string             `json:"moderationFlags,omitempty"`
+	TagIDs           []uint32            `json:"tagIds,omitempty"`
+	Attachments      []string            `json:"attachments,omitempty"`
 }
 
 type RawAddToyDTO struct {
@@ -47,18 +79,22 @@ type RawAddToyDTO struct {
 }
 
 type UpdateToyDTO struct {
-	ID                    uint64   `json:"id"`
-	CategoryID            *uint32  `json:"categoryId,omitempty"`
-	Name                  *string  `json:"name,omitempty"`
-	Description           *string  `json:"description,omitempty"`
-	Price                 *float32 `json:"price,omitempty"`
-	Quantity              *uint32  `json:"quantity,omitempty"`
-	TagIDsToAdd           []uint32 `json:"tagIdsToAdd,omitempty"`
-	TagIDsToDelete        []uint32 `json:"tagIdsToDelete,omitempty"`
-	AttachmentsToAdd      []string `json:"attachmentsToAdd,omitempty"`
-	AttachmentIDsToDelete []uint64 `json:"attachmentIdsToDelete,omitempty"`
+	ID                    uint64               `json:"id"`
+	CategoryID            *uint32              `json:"categoryId,omitempty"`
+	Name                  *string              `json:"name,omitempty"`
+	Description           *string              `json:"description,omitempty"`
+	Price                 *float32             `json:"price,omitempty"`
+	Quantity              *uint32              `json:"quantity,omitempty"`
+	ModerationStatus      *ToyModerationStatus `json:"moderationStatus,omitempty"`
+	ModerationFlags       *string              `json:"moderationFlags,omitempty"` // updated only together with status
+	TagIDsToAdd           []uint32             `json:"tagIdsToAdd,omitempty"`
+	TagIDsToDelete        []uint32             `json:"tagIdsToDelete,omitempty"`
+	AttachmentsToAdd      []string             `json:"attachmentsToAdd,omitempty"`
+	AttachmentIDsToDelete []uint64             `json:"attachmentIdsToDelete,omitempty"`
 }
 
+// RawUpdateToyDTO contains only changed fields of Toy. Tags and attachments are replaced by provided lists,
+// so empty list removes all of them, and nil list keeps them untouched.
 type RawUpdateToyDTO struct {
 	ID          uint64   `json:"id"`
 	CategoryID  *uint32  `json:"categoryId,omitempty"`
@@ -70,12 +106,44 @@ type RawUpdateToyDTO struct {
 	Attachments []string `json:"attachments,omitempty"`
 }
 
+// BatchUpdateToyDTO changes price and quantity of Toy within batch update.
+type BatchUpdateToyDTO struct {
+	ID       uint64   `json:"id"`
+	Price    *float32 `json:"price,omitempty"`
+	Quantity *uint32  `json:"quantity,omitempty"`
+}
+
+// BatchUpdateToyResult contains violations of batch update item. Batch is applied only if no item has violations.
+type BatchUpdateToyResult struct {
+	ID         uint64                        `json:"id"`
+	Violations []customerrors.FieldViolation `json:"violations,omitempty"`
+}
+
 type ToysFilters struct {
-	Search              *string  `json:"search,omitempty"`
-	PriceCeil           *float32 `json:"priceCeil,omitempty"`     // max price
-	PriceFloor          *float32 `json:"priceFloor,omitempty"`    // min price
-	QuantityFloor       *uint32  `json:"quantityFloor,omitempty"` // min quantity
-	CategoryIDs         []uint32 `json:"categoryIds,omitempty"`
-	TagIDs              []uint32 `json:"tagIds,omitempty"`
-	CreatedAtOrderByAsc *bool    `json:"createdAtOrderByAsc,omitempty"`
+	Search              *string               `json:"search,omitempty"`
+	PriceCeil           *float32              `json:"priceCeil,omitempty"`     // max price
+	PriceFloor          *float32              `json:"priceFloor,omitempty"`    // min price
+	QuantityFloor       *uint32               `json:"quantityFloor,omitempty"` // min quantity
+	CategoryIDs         []uint32              `json:"categoryIds,omitempty"`
+	TagIDs              []uint32              `json:"tagIds,omitempty"`
+	CreatedAtOrderByAsc *bool                 `json:"createdAtOrderByAsc,omitempty"`
+	MinRating           *float32              `json:"minRating,omitempty"`          // min average rating
+	RatingOrderByAsc    *bool                 `json:"ratingOrderByAsc,omitempty"`   // sort by average rating, if provided
+	OnlyAvailable       *bool                 `json:"onlyAvailable,omitempty"`      // exclude Toys of Masters on vacation
+	ModerationStatuses  []ToyModerationStatus `json:"moderationStatuses,omitempty"` // only approved Toys, if not provided
+}
+
+// ToyModerationDTO sets moderation decision or resubmission of Toy.
+type ToyModerationDTO struct {
+	ToyID           uint64              `json:"toyId"`
+	Status          ToyModerationStatus `json:"status"`
+	RejectionReason *string             `json:"rejectionReason,omitempty"`
+	Appeal          *string             `json:"appeal,omitempty"`
+}
+
+// CategoryPriceStats is a price statistics of approved Toys of Category, used for price outliers detection.
+type CategoryPriceStats struct {
+	CategoryID   uint32  `json:"categoryId"`
+	ToysCount    uint64  `json:"toysCount"`
+	AveragePrice float64 `json:"averagePrice"`
 }
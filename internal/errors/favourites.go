@@ -0,0 +1,47 @@
+package errors
+
+import "fmt"
+
+type FavouriteNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e FavouriteNotFoundError) Error() string {
+	template := "favourite not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e FavouriteNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+type FavouriteAlreadyExistsError struct {
+	Message string
+	BaseErr error
+}
+
+func (e FavouriteAlreadyExistsError) Error() string {
+	template := "favourite already exists"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e FavouriteAlreadyExistsError) Unwrap() error {
+	return e.BaseErr
+}
@@ -0,0 +1,47 @@
+package errors
+
+import "fmt"
+
+type FollowNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e FollowNotFoundError) Error() string {
+	template := "follow not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e FollowNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+type FollowAlreadyExistsError struct {
+	Message string
+	BaseErr error
+}
+
+func (e FollowAlreadyExistsError) Error() string {
+	template := "follow already exists"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e FollowAlreadyExistsError) Unwrap() error {
+	return e.BaseErr
+}
@@ -0,0 +1,109 @@
+package errors
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFollowNotFoundError_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		message  string
+		baseErr  error
+		expected string
+	}{
+		{
+			name:     "with message",
+			message:  "test message",
+			expected: "test message",
+		},
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: "follow not found. Base error: test error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &FollowNotFoundError{Message: tc.message, BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestFollowNotFoundError_Unwrap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseErr  error
+		expected error
+	}{
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: errors.New("test error"),
+		},
+		{
+			name: "without BaseErr",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &FollowNotFoundError{BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Unwrap())
+		})
+	}
+}
+
+func TestFollowAlreadyExistsError_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		message  string
+		baseErr  error
+		expected string
+	}{
+		{
+			name:     "with message",
+			message:  "test message",
+			expected: "test message",
+		},
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: "follow already exists. Base error: test error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &FollowAlreadyExistsError{Message: tc.message, BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestFollowAlreadyExistsError_Unwrap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseErr  error
+		expected error
+	}{
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: errors.New("test error"),
+		},
+		{
+			name: "without BaseErr",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &FollowAlreadyExistsError{BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Unwrap())
+		})
+	}
+}
@@ -45,3 +45,47 @@ func (e MasterAlreadyExistsError) Error() string {
 func (e MasterAlreadyExistsError) Unwrap() error {
 	return e.BaseErr
 }
+
+type MasterSlugAlreadyExistsError struct {
+	Message string
+	BaseErr error
+}
+
+func (e MasterSlugAlreadyExistsError) Error() string {
+	template := "master slug already exists"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e MasterSlugAlreadyExistsError) Unwrap() error {
+	return e.BaseErr
+}
+
+type MasterBlockedError struct {
+	Message string
+	BaseErr error
+}
+
+func (e MasterBlockedError) Error() string {
+	template := "master is blocked by moderation"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e MasterBlockedError) Unwrap() error {
+	return e.BaseErr
+}
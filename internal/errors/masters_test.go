@@ -107,3 +107,104 @@ func TestMasterAlreadyExistsError_Unwrap(t *testing.T) {
 		})
 	}
 }
+
+func TestMasterSlugAlreadyExistsError_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		message  string
+		baseErr  error
+		expected string
+	}{
+		{
+			name:     "with message",
+			message:  "test message",
+			expected: "test message",
+		},
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: "master slug already exists. Base error: test error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &MasterSlugAlreadyExistsError{Message: tc.message, BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestMasterSlugAlreadyExistsError_Unwrap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseErr  error
+		expected error
+	}{
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: errors.New("test error"),
+		},
+		{
+			name: "without BaseErr",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &MasterSlugAlreadyExistsError{BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Unwrap())
+		})
+	}
+}
+func TestMasterBlockedError_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		message  string
+		baseErr  error
+		expected string
+	}{
+		{
+			name:     "with message",
+			message:  "test message",
+			expected: "test message",
+		},
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: "master is blocked by moderation. Base error: test error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &MasterBlockedError{Message: tc.message, BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestMasterBlockedError_Unwrap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseErr  error
+		expected error
+	}{
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: errors.New("test error"),
+		},
+		{
+			name: "without BaseErr",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &MasterBlockedError{BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Unwrap())
+		})
+	}
+}
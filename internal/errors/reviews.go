@@ -0,0 +1,47 @@
+package errors
+
+import "fmt"
+
+type ReviewNotFoundError struct {
+	Message string
+	BaseErr error
+}
+
+func (e ReviewNotFoundError) Error() string {
+	template := "review not found"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e ReviewNotFoundError) Unwrap() error {
+	return e.BaseErr
+}
+
+type ReviewAlreadyExistsError struct {
+	Message string
+	BaseErr error
+}
+
+func (e ReviewAlreadyExistsError) Error() string {
+	template := "review already exists"
+	if e.Message != "" {
+		template = e.Message
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e ReviewAlreadyExistsError) Unwrap() error {
+	return e.BaseErr
+}
@@ -0,0 +1,58 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Machine-readable codes of failed validation rules, which are returned to clients with field violations:
+const (
+	ViolationCodeInvalidFormat  = "INVALID_FORMAT"
+	ViolationCodeForbiddenWords = "FORBIDDEN_WORDS"
+	ViolationCodeOutOfRange     = "OUT_OF_RANGE"
+	ViolationCodeTooShort       = "TOO_SHORT"
+	ViolationCodeRequired       = "REQUIRED"
+	ViolationCodeNotFound       = "NOT_FOUND"
+	ViolationCodeAlreadyExists  = "ALREADY_EXISTS"
+	ViolationCodeInternal       = "INTERNAL" // not a rule, but server error, so the same request can be retried.
+)
+
+// FieldViolation describes single failed validation rule of request field.
+type FieldViolation struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// ValidationError contains all field violations, which were found during request validation.
+type ValidationError struct {
+	Message    string
+	BaseErr    error
+	Violations []FieldViolation
+}
+
+func (e ValidationError) Error() string {
+	template := "validation failed"
+
+	switch {
+	case e.Message != "":
+		template = e.Message
+	case len(e.Violations) > 0:
+		messages := make([]string, len(e.Violations))
+		for i, violation := range e.Violations {
+			messages[i] = violation.Message
+		}
+
+		template = strings.Join(messages, "; ")
+	}
+
+	if e.BaseErr != nil {
+		return fmt.Sprintf(template+". Base error: %v", e.BaseErr)
+	}
+
+	return template
+}
+
+func (e ValidationError) Unwrap() error {
+	return e.BaseErr
+}
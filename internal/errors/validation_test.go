@@ -0,0 +1,67 @@
+package errors
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidationError_Error(t *testing.T) {
+	testCases := []struct {
+		name       string
+		message    string
+		baseErr    error
+		violations []FieldViolation
+		expected   string
+	}{
+		{
+			name:     "with message",
+			message:  "test message",
+			expected: "test message",
+		},
+		{
+			name: "with violations",
+			violations: []FieldViolation{
+				{Field: "name", Code: ViolationCodeInvalidFormat, Message: "invalid toy name"},
+				{Field: "price", Code: ViolationCodeOutOfRange, Message: "invalid toy price"},
+			},
+			expected: "invalid toy name; invalid toy price",
+		},
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: "validation failed. Base error: test error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &ValidationError{Message: tc.message, BaseErr: tc.baseErr, Violations: tc.violations}
+			require.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestValidationError_Unwrap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseErr  error
+		expected error
+	}{
+		{
+			name:     "with BaseErr",
+			baseErr:  errors.New("test error"),
+			expected: errors.New("test error"),
+		},
+		{
+			name: "without BaseErr",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &ValidationError{BaseErr: tc.baseErr}
+			require.Equal(t, tc.expected, err.Unwrap())
+		})
+	}
+}
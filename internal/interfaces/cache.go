@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=cache.go -destination=../../mocks/cache/cache.go -package=mockcache -exclude_interfaces=
+type Cache interface {
+	// Get returns value by key. Missing and expired keys are reported with found=false and without error.
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+// UserEventHandler processes single event. Event, which failed to be processed, is redelivered.
+type UserEventHandler func(ctx context.Context, event entities.UserEvent) error
+
+//go:generate mockgen -source=consumers.go -destination=../../mocks/consumers/user_events_consumer.go -package=mockconsumers -exclude_interfaces=
+type UserEventsConsumer interface {
+	// Consume passes events to handler one by one till context is canceled. Events are delivered at least once,
+	// so handler should be idempotent.
+	Consume(ctx context.Context, handler UserEventHandler) error
+}
@@ -2,16 +2,18 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
 )
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,TagsRepository,SsoRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,TagsRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 type ToysRepository interface {
 	AddToy(ctx context.Context, toyData entities.AddToyDTO) (toyID uint64, err error)
 	GetToys(ctx context.Context, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error)
 	CountToys(ctx context.Context, filters *entities.ToysFilters) (uint64, error)
 	GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error)
+	GetToysByIDs(ctx context.Context, ids []uint64) ([]entities.Toy, error)
 	GetMasterToys(
 		ctx context.Context,
 		masterID uint64,
@@ -19,11 +21,45 @@ type ToysRepository interface {
 		filters *entities.ToysFilters,
 	) ([]entities.Toy, error)
 	CountMasterToys(ctx context.Context, masterID uint64, filters *entities.ToysFilters) (uint64, error)
+	GetAllMasterToys(ctx context.Context, masterID uint64) ([]entities.Toy, error)
+	GetMasterToysPriceHistory(ctx context.Context, masterID uint64) ([]entities.ToyPriceChange, error)
+	GetToysBatch(
+		ctx context.Context,
+		masterID *uint64,
+		filters *entities.ToysFilters,
+		afterID uint64,
+		limit uint64,
+	) ([]entities.Toy, error)
 	DeleteToy(ctx context.Context, id uint64) error
+	DeleteMasterToys(ctx context.Context, masterID uint64) (toysIDs []uint64, err error)
 	UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error
+	GetUserFavourites(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+		filters *entities.ToysFilters,
+	) ([]entities.Toy, error)
+	CountUserFavourites(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error)
+	GetFavourite(ctx context.Context, userID, toyID uint64) (*entities.Favourite, error)
+	GetUserFavouritesRecords(ctx context.Context, userID uint64) ([]entities.Favourite, error)
+	AddFavourite(ctx context.Context, userID, toyID uint64) (favouriteID uint64, err error)
+	RemoveFavourite(ctx context.Context, userID, toyID uint64) error
+	GetFeed(
+		ctx context.Context,
+		userID uint64,
+		cursor *entities.FeedCursor,
+		limit uint64,
+	) ([]entities.Toy, error)
+	GetMasterToysStats(
+		ctx context.Context,
+		masterID uint64,
+		period entities.StatsPeriod,
+	) (*entities.MasterStats, error)
+	UpdateToyModeration(ctx context.Context, moderationData entities.ToyModerationDTO) error
+	GetCategoryPriceStats(ctx context.Context, categoryID uint32) (*entities.CategoryPriceStats, error)
 }
 
-//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/masters_repository.go -exclude_interfaces=TagsRepository,CategoriesRepository,ToysRepository,SsoRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go  -destination=../../mocks/repositories/masters_repository.go -exclude_interfaces=TagsRepository,CategoriesRepository,ToysRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 type MastersRepository interface {
 	GetMasters(
 		ctx context.Context,
@@ -33,28 +69,59 @@ type MastersRepository interface {
 	CountMasters(ctx context.Context, filters *entities.MastersFilters) (uint64, error)
 	GetMasterByID(ctx context.Context, id uint64) (*entities.Master, error)
 	GetMasterByUserID(ctx context.Context, userID uint64) (*entities.Master, error)
+	GetMasterBySlug(ctx context.Context, slug string) (*entities.Master, error)
 	RegisterMaster(
 		ctx context.Context,
 		masterData entities.RegisterMasterDTO,
 	) (masterID uint64, err error)
 	UpdateMaster(ctx context.Context, masterData entities.UpdateMasterDTO) error
+	GetFollowedMasters(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+	) ([]entities.Master, error)
+	CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error)
+	GetFollow(ctx context.Context, userID, masterID uint64) (*entities.Follow, error)
+	FollowMaster(ctx context.Context, userID, masterID uint64) (followID uint64, err error)
+	UnfollowMaster(ctx context.Context, userID, masterID uint64) error
+	SetVacationMode(ctx context.Context, vacationData entities.SetVacationModeDTO) error
+	EndExpiredVacations(ctx context.Context, now time.Time) (endedCount uint64, err error)
+	ChangeMasterStatus(ctx context.Context, statusData entities.ChangeMasterStatusDTO) error
+	GetMasterStatusHistory(ctx context.Context, masterID uint64) ([]entities.MasterStatusChange, error)
 }
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/categories_repository.go -exclude_interfaces=MastersRepository,TagsRepository,ToysRepository,SsoRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/categories_repository.go -exclude_interfaces=MastersRepository,TagsRepository,ToysRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 type CategoriesRepository interface {
 	GetAllCategories(ctx context.Context) ([]entities.Category, error)
 	GetCategoryByID(ctx context.Context, id uint32) (*entities.Category, error)
+	GetCategoryRules(ctx context.Context, categoryID uint32) (*entities.CategoryRules, error)
 }
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tags_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,SsoRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/tags_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 type TagsRepository interface {
 	CreateTags(ctx context.Context, tagsData []entities.CreateTagDTO) ([]uint32, error)
 	GetAllTags(ctx context.Context) ([]entities.Tag, error)
 	GetTagByID(ctx context.Context, id uint32) (*entities.Tag, error)
 }
 
-//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository -package=mockrepositories
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 type SsoRepository interface {
 	GetUserByID(ctx context.Context, id uint64) (*entities.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entities.User, error)
 }
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/reviews_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository,SsoRepository,ForbiddenWordsRepository -package=mockrepositories
+type ReviewsRepository interface {
+	AddReview(ctx context.Context, reviewData entities.AddReviewDTO) (reviewID uint64, err error)
+	GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error)
+	GetToyReviews(ctx context.Context, toyID uint64, pagination *entities.Pagination) ([]entities.Review, error)
+	CountToyReviews(ctx context.Context, toyID uint64) (uint64, error)
+	GetUserReviews(ctx context.Context, userID uint64) ([]entities.Review, error)
+	UpdateReview(ctx context.Context, reviewData entities.UpdateReviewDTO) error
+	DeleteReview(ctx context.Context, id uint64) error
+}
+
+//go:generate mockgen -source=repositories.go -destination=../../mocks/repositories/forbidden_words_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository,SsoRepository,ReviewsRepository -package=mockrepositories
+type ForbiddenWordsRepository interface {
+	GetForbiddenWords(ctx context.Context) ([]string, error)
+}
@@ -1,26 +1,40 @@
 package interfaces
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/toys_service.go -exclude_interfaces=MastersService,CategoriesService,TagsService,SsoService -package=mockservices
+import "context"
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/toys_service.go -exclude_interfaces=MastersService,CategoriesService,TagsService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 type ToysService interface {
 	ToysRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/tags_service.go -exclude_interfaces=MastersService,CategoriesService,ToysService,SsoService -package=mockservices
+//go:generate mockgen -source=services.go -destination=../../mocks/services/tags_service.go -exclude_interfaces=MastersService,CategoriesService,ToysService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 type TagsService interface {
 	TagsRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/masters_service.go -exclude_interfaces=TagsService,CategoriesService,ToysService,SsoService -package=mockservices
+//go:generate mockgen -source=services.go -destination=../../mocks/services/masters_service.go -exclude_interfaces=TagsService,CategoriesService,ToysService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 type MastersService interface {
 	MastersRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/categories_service.go -exclude_interfaces=TagsService,MastersService,ToysService,SsoService -package=mockservices
+//go:generate mockgen -source=services.go -destination=../../mocks/services/categories_service.go -exclude_interfaces=TagsService,MastersService,ToysService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 type CategoriesService interface {
 	CategoriesRepository
 }
 
-//go:generate mockgen -source=services.go -destination=../../mocks/services/sso_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService -package=mockservices
+//go:generate mockgen -source=services.go -destination=../../mocks/services/sso_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService,ReviewsService,ForbiddenWordsService -package=mockservices
 type SsoService interface {
 	SsoRepository
 }
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/reviews_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService,SsoService,ForbiddenWordsService -package=mockservices
+type ReviewsService interface {
+	ReviewsRepository
+}
+
+//go:generate mockgen -source=services.go -destination=../../mocks/services/forbidden_words_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService,SsoService,ReviewsService -package=mockservices
+type ForbiddenWordsService interface {
+	ForbiddenWordsRepository
+	ReloadForbiddenWords(ctx context.Context) (wordsCount int, err error)
+	ContainsForbiddenWords(value string) bool
+}
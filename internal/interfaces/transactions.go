@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=transactions.go -destination=../../mocks/transactions/transaction_manager.go -package=mocktransactions -exclude_interfaces=
+type TransactionManager interface {
+	// WithinTransaction runs fn atomically. Repositories join transaction through context, passed to fn.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinSnapshot runs fn in read-only transaction, so all reads of fn see the same snapshot of data.
+	WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -23,17 +23,50 @@ type UseCases interface {
 	CountMasters(ctx context.Context, filters *entities.MastersFilters) (uint64, error)
 	GetMasterByID(ctx context.Context, id uint64) (*entities.Master, error)
 	GetMasterByUserID(ctx context.Context, userID uint64) (*entities.Master, error)
+	GetMasterBySlug(ctx context.Context, slug string) (*entities.Master, error)
 	RegisterMaster(
 		ctx context.Context,
 		rawMasterData entities.RegisterMasterDTO,
 	) (masterID uint64, err error)
 	UpdateMaster(ctx context.Context, masterData entities.UpdateMasterDTO) error
+	GetMasterStats(
+		ctx context.Context,
+		masterID uint64,
+		period *entities.StatsPeriod,
+	) (*entities.MasterStats, error)
+	SetVacationMode(ctx context.Context, vacationData entities.SetVacationModeDTO) error
+	EndExpiredVacations(ctx context.Context) (endedCount uint64, err error)
+	ChangeMasterStatus(ctx context.Context, statusData entities.ChangeMasterStatusDTO) error
+	GetMasterStatusHistory(ctx context.Context, masterID uint64) ([]entities.MasterStatusChange, error)
+
+	// Users cases:
+	GetUsersByIDs(ctx context.Context, ids []uint64) (map[uint64]entities.User, error)
+	ProcessUserEvent(ctx context.Context, event entities.UserEvent) error
+	ReconcileMasters(ctx context.Context, dryRun bool) (orphanedMastersIDs []uint64, err error)
+	ExportUserData(ctx context.Context, userID uint64) (*entities.UserDataExport, error)
 
 	// Toys cases:
 	AddToy(ctx context.Context, rawToyData entities.RawAddToyDTO) (toyID uint64, err error)
+	ImportToys(
+		ctx context.Context,
+		userID uint64,
+		rows []entities.ImportToyRow,
+		dryRun bool,
+	) ([]entities.ImportToyResult, error)
+	ExportToys(
+		ctx context.Context,
+		masterID *uint64,
+		filters *entities.ToysFilters,
+		fn func(toys []entities.Toy) error,
+	) error
 	GetToys(ctx context.Context, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error)
 	CountToys(ctx context.Context, filters *entities.ToysFilters) (uint64, error)
 	GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error)
+	BatchGetToys(ctx context.Context, ids []uint64) (toys []entities.Toy, missingIDs []uint64, err error)
+	ListModerationQueue(ctx context.Context, pagination *entities.Pagination) ([]entities.Toy, error)
+	ApproveToy(ctx context.Context, id uint64) error
+	RejectToy(ctx context.Context, id uint64, reason string) error
+	ResubmitToy(ctx context.Context, id uint64, appeal *string) error
 	GetMasterToys(
 		ctx context.Context,
 		masterID uint64,
@@ -50,4 +83,47 @@ type UseCases interface {
 	CountUserToys(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error)
 	DeleteToy(ctx context.Context, id uint64) error
 	UpdateToy(ctx context.Context, rawToyData entities.RawUpdateToyDTO) error
+	BatchUpdateToys(
+		ctx context.Context,
+		userID uint64,
+		items []entities.BatchUpdateToyDTO,
+	) ([]entities.BatchUpdateToyResult, error)
+
+	// Favourites cases:
+	GetUserFavourites(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+		filters *entities.ToysFilters,
+	) ([]entities.Toy, error)
+	CountUserFavourites(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error)
+	AddFavourite(ctx context.Context, userID, toyID uint64) (favouriteID uint64, err error)
+	RemoveFavourite(ctx context.Context, userID, toyID uint64) error
+
+	// Follows cases:
+	GetFollowedMasters(
+		ctx context.Context,
+		userID uint64,
+		pagination *entities.Pagination,
+	) ([]entities.Master, error)
+	CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error)
+	FollowMaster(ctx context.Context, userID, masterID uint64) (followID uint64, err error)
+	UnfollowMaster(ctx context.Context, userID, masterID uint64) error
+	GetFeed(
+		ctx context.Context,
+		userID uint64,
+		cursor *entities.FeedCursor,
+		limit *uint64,
+	) ([]entities.Toy, error)
+
+	// Reviews cases:
+	AddReview(ctx context.Context, reviewData entities.AddReviewDTO) (reviewID uint64, err error)
+	GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error)
+	GetToyReviews(ctx context.Context, toyID uint64, pagination *entities.Pagination) ([]entities.Review, error)
+	CountToyReviews(ctx context.Context, toyID uint64) (uint64, error)
+	UpdateReview(ctx context.Context, rawReviewData entities.RawUpdateReviewDTO) error
+	DeleteReview(ctx context.Context, id uint64) error
+
+	// Forbidden words cases:
+	ReloadForbiddenWords(ctx context.Context) (wordsCount int, err error)
 }
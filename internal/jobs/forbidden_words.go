@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// NewForbiddenWordsJob creates Job, which periodically reloads forbidden words dictionary from its source.
+func NewForbiddenWordsJob(
+	useCases interfaces.UseCases,
+	interval time.Duration,
+	logger logging.Logger,
+) *ForbiddenWordsJob {
+	return &ForbiddenWordsJob{
+		useCases: useCases,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+type ForbiddenWordsJob struct {
+	useCases interfaces.UseCases
+	interval time.Duration
+	logger   logging.Logger
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// Run Job till it is stopped.
+func (job *ForbiddenWordsJob) Run() {
+	defer close(job.done)
+
+	logging.LogInfo(job.logger, fmt.Sprintf("Starting forbidden words Job with interval %s", job.interval))
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-job.stop:
+			logging.LogInfo(job.logger, "Stopped forbidden words Job")
+			return
+		case <-ticker.C:
+			job.reloadForbiddenWords()
+		}
+	}
+}
+
+// Stop Job gracefully, waiting for current iteration to finish.
+func (job *ForbiddenWordsJob) Stop() {
+	close(job.stop)
+	<-job.done
+}
+
+func (job *ForbiddenWordsJob) reloadForbiddenWords() {
+	ctx := context.Background()
+
+	wordsCount, err := job.useCases.ReloadForbiddenWords(ctx)
+	if err != nil {
+		logging.LogErrorContext(ctx, job.logger, "Error occurred while trying to reload forbidden words", err)
+
+		return
+	}
+
+	logging.LogInfo(job.logger, fmt.Sprintf("Reloaded %d forbidden words", wordsCount))
+}
@@ -0,0 +1,96 @@
+package jobs_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/jobs"
+	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
+)
+
+func TestForbiddenWordsJob(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger, called chan struct{})
+	}{
+		{
+			name: "forbidden words reloaded",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger, called chan struct{}) {
+				useCases.
+					EXPECT().
+					ReloadForbiddenWords(gomock.Any()).
+					DoAndReturn(func(_ any) (int, error) {
+						// Job may tick several times before being stopped:
+						select {
+						case called <- struct{}{}:
+						default:
+						}
+
+						return 10, nil
+					}).
+					MinTimes(1)
+
+				logger.
+					EXPECT().
+					Info(gomock.Any()).
+					AnyTimes()
+			},
+		},
+		{
+			name: "error",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger, called chan struct{}) {
+				useCases.
+					EXPECT().
+					ReloadForbiddenWords(gomock.Any()).
+					DoAndReturn(func(_ any) (int, error) {
+						// Job may tick several times before being stopped:
+						select {
+						case called <- struct{}{}:
+						default:
+						}
+
+						return 0, errors.New("test")
+					}).
+					MinTimes(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					MinTimes(1)
+
+				logger.
+					EXPECT().
+					Info(gomock.Any()).
+					AnyTimes()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCases := mockusecases.NewMockUseCases(ctrl)
+			logger := mocklogger.NewMockLogger(ctrl)
+			called := make(chan struct{}, 1)
+
+			tc.setupMocks(useCases, logger, called)
+
+			job := jobs.NewForbiddenWordsJob(useCases, time.Millisecond*10, logger)
+			go job.Run()
+
+			// Dictionary is loaded on server start, so Job reloads it only after interval:
+			select {
+			case <-called:
+			case <-time.After(time.Second):
+				t.Fatal("forbidden words were not reloaded")
+			}
+
+			job.Stop()
+		})
+	}
+}
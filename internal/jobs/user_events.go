@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// NewUserEventsJob creates Job, which applies events about Users' accounts from SSO to their Masters.
+func NewUserEventsJob(
+	useCases interfaces.UseCases,
+	consumer interfaces.UserEventsConsumer,
+	logger logging.Logger,
+) *UserEventsJob {
+	return &UserEventsJob{
+		useCases: useCases,
+		consumer: consumer,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+type UserEventsJob struct {
+	useCases interfaces.UseCases
+	consumer interfaces.UserEventsConsumer
+	logger   logging.Logger
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// Run Job till it is stopped.
+func (job *UserEventsJob) Run() {
+	defer close(job.done)
+
+	logging.LogInfo(job.logger, "Starting User events Job")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-job.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := job.consumer.Consume(ctx, job.processUserEvent); err != nil {
+		logging.LogErrorContext(ctx, job.logger, "User events Job stopped with error", err)
+
+		return
+	}
+
+	logging.LogInfo(job.logger, "Stopped User events Job")
+}
+
+// Stop Job gracefully, waiting for current event to be processed.
+func (job *UserEventsJob) Stop() {
+	close(job.stop)
+	<-job.done
+}
+
+func (job *UserEventsJob) processUserEvent(ctx context.Context, event entities.UserEvent) error {
+	// Event, which processing was started, is processed completely even if Job is stopped:
+	if err := job.useCases.ProcessUserEvent(context.WithoutCancel(ctx), event); err != nil {
+		return err
+	}
+
+	logging.LogInfo(
+		job.logger,
+		fmt.Sprintf("Processed %s event with ID=%s for User with ID=%d", event.Type, event.ID, event.UserID),
+	)
+
+	return nil
+}
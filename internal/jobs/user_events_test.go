@@ -0,0 +1,95 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/consumers"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/jobs"
+	mockconsumers "github.com/DKhorkov/hmtm-toys/mocks/consumers"
+	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
+)
+
+func TestUserEventsJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	processed := make(chan struct{})
+	event := entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: 1}
+
+	gomock.InOrder(
+		useCases.
+			EXPECT().
+			ProcessUserEvent(gomock.Any(), event).
+			Return(errors.New("test")).
+			Times(1),
+		useCases.
+			EXPECT().
+			ProcessUserEvent(gomock.Any(), event).
+			DoAndReturn(func(_ context.Context, _ entities.UserEvent) error {
+				close(processed)
+
+				return nil
+			}).
+			Times(1),
+	)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1) // failed attempt is retried.
+
+	logger.
+		EXPECT().
+		Info(gomock.Any()).
+		AnyTimes()
+
+	consumer := consumers.NewMemoryUserEventsConsumer(time.Millisecond, logger)
+	require.NoError(t, consumer.Publish(context.Background(), event))
+
+	job := jobs.NewUserEventsJob(useCases, consumer, logger)
+	go job.Run()
+
+	select {
+	case <-processed:
+	case <-time.After(time.Second):
+		t.Fatal("User event was not processed")
+	}
+
+	job.Stop()
+}
+
+func TestUserEventsJobWithFailedConsumer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	useCases := mockusecases.NewMockUseCases(ctrl)
+	logger := mocklogger.NewMockLogger(ctrl)
+	consumer := mockconsumers.NewMockUserEventsConsumer(ctrl)
+
+	consumer.
+		EXPECT().
+		Consume(gomock.Any(), gomock.Any()).
+		Return(errors.New("test")).
+		Times(1)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	logger.
+		EXPECT().
+		Info(gomock.Any()).
+		Times(1)
+
+	job := jobs.NewUserEventsJob(useCases, consumer, logger)
+	go job.Run()
+	job.Stop()
+}
@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// NewVacationsJob creates Job, which periodically ends vacations of Masters, whose vacation end date has come.
+func NewVacationsJob(
+	useCases interfaces.UseCases,
+	interval time.Duration,
+	logger logging.Logger,
+) *VacationsJob {
+	return &VacationsJob{
+		useCases: useCases,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+type VacationsJob struct {
+	useCases interfaces.UseCases
+	interval time.Duration
+	logger   logging.Logger
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// Run Job till it is stopped.
+func (job *VacationsJob) Run() {
+	defer close(job.done)
+
+	logging.LogInfo(job.logger, fmt.Sprintf("Starting vacations Job with interval %s", job.interval))
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	// Vacations, which have ended while server was down, are processed immediately:
+	job.endExpiredVacations()
+
+	for {
+		select {
+		case <-job.stop:
+			logging.LogInfo(job.logger, "Stopped vacations Job")
+			return
+		case <-ticker.C:
+			job.endExpiredVacations()
+		}
+	}
+}
+
+// Stop Job gracefully, waiting for current iteration to finish.
+func (job *VacationsJob) Stop() {
+	close(job.stop)
+	<-job.done
+}
+
+func (job *VacationsJob) endExpiredVacations() {
+	ctx := context.Background()
+
+	ended, err := job.useCases.EndExpiredVacations(ctx)
+	if err != nil {
+		logging.LogErrorContext(ctx, job.logger, "Error occurred while trying to end expired vacations", err)
+
+		return
+	}
+
+	if ended > 0 {
+		logging.LogInfo(job.logger, fmt.Sprintf("Ended vacations of %d Masters", ended))
+	}
+}
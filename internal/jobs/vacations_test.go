@@ -0,0 +1,88 @@
+package jobs_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	mocklogger "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/jobs"
+	mockusecases "github.com/DKhorkov/hmtm-toys/mocks/usecases"
+)
+
+func TestVacationsJob(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger, called chan struct{})
+	}{
+		{
+			name: "vacations ended",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger, called chan struct{}) {
+				useCases.
+					EXPECT().
+					EndExpiredVacations(gomock.Any()).
+					DoAndReturn(func(_ any) (uint64, error) {
+						called <- struct{}{}
+
+						return 2, nil
+					}).
+					MinTimes(1)
+
+				logger.
+					EXPECT().
+					Info(gomock.Any()).
+					AnyTimes()
+			},
+		},
+		{
+			name: "error",
+			setupMocks: func(useCases *mockusecases.MockUseCases, logger *mocklogger.MockLogger, called chan struct{}) {
+				useCases.
+					EXPECT().
+					EndExpiredVacations(gomock.Any()).
+					DoAndReturn(func(_ any) (uint64, error) {
+						called <- struct{}{}
+
+						return 0, errors.New("test")
+					}).
+					MinTimes(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					MinTimes(1)
+
+				logger.
+					EXPECT().
+					Info(gomock.Any()).
+					AnyTimes()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			useCases := mockusecases.NewMockUseCases(ctrl)
+			logger := mocklogger.NewMockLogger(ctrl)
+			called := make(chan struct{}, 1)
+
+			tc.setupMocks(useCases, logger, called)
+
+			job := jobs.NewVacationsJob(useCases, time.Hour, logger)
+			go job.Run()
+
+			// Expired vacations are ended right after Job start, without waiting for interval:
+			select {
+			case <-called:
+			case <-time.After(time.Second):
+				t.Fatal("expired vacations were not ended on Job start")
+			}
+
+			job.Stop()
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package loaders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// usersLoaderKey is used to pass request-scoped UsersLoader through context.
+type usersLoaderKey struct{}
+
+// NewUsersLoader creates UsersLoader, which should be used only while handling single request,
+// because loaded Users are memoized without expiration.
+func NewUsersLoader(ssoService interfaces.SsoService, loaderConfig config.UsersLoaderConfig) *UsersLoader {
+	return &UsersLoader{
+		ssoService: ssoService,
+		config:     loaderConfig,
+		results:    make(map[uint64]*userResult),
+	}
+}
+
+// UsersLoader coalesces concurrent lookups of Users into batches. IDs are collected for config.Wait
+// or till config.MaxBatchSize is reached, then each distinct ID is loaded once.
+// SSO has no endpoint to get Users by IDs, so batch is loaded with concurrent calls.
+type UsersLoader struct {
+	ssoService interfaces.SsoService
+	config     config.UsersLoaderConfig
+	mutex      sync.Mutex
+	results    map[uint64]*userResult
+	pending    []uint64 // IDs of batch, which is not loaded yet.
+	timer      *time.Timer
+}
+
+type userResult struct {
+	done chan struct{} // closed, when User is loaded.
+	user *entities.User
+	err  error
+}
+
+func (loader *UsersLoader) GetUserByID(ctx context.Context, id uint64) (*entities.User, error) {
+	result := loader.enqueue(ctx, id)
+
+	select {
+	case <-result.done:
+		return result.user, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (loader *UsersLoader) enqueue(ctx context.Context, id uint64) *userResult {
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+
+	if result, ok := loader.results[id]; ok {
+		return result
+	}
+
+	result := &userResult{done: make(chan struct{})}
+	loader.results[id] = result
+	loader.pending = append(loader.pending, id)
+
+	switch {
+	case len(loader.pending) >= loader.config.MaxBatchSize:
+		if loader.timer != nil {
+			loader.timer.Stop()
+		}
+
+		go loader.load(ctx, loader.takePending())
+	case len(loader.pending) == 1:
+		loader.timer = time.AfterFunc(
+			loader.config.Wait,
+			func() {
+				loader.mutex.Lock()
+				ids := loader.takePending()
+				loader.mutex.Unlock()
+
+				loader.load(ctx, ids)
+			},
+		)
+	}
+
+	return result
+}
+
+// takePending should be called under mutex.
+func (loader *UsersLoader) takePending() []uint64 {
+	ids := loader.pending
+	loader.pending = nil
+	loader.timer = nil
+
+	return ids
+}
+
+func (loader *UsersLoader) load(ctx context.Context, ids []uint64) {
+	semaphore := make(chan struct{}, max(loader.config.MaxConcurrency, 1))
+
+	var wg sync.WaitGroup
+
+	for _, id := range ids {
+		loader.mutex.Lock()
+		result := loader.results[id]
+		loader.mutex.Unlock()
+
+		semaphore <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			result.user, result.err = loader.ssoService.GetUserByID(ctx, id)
+			close(result.done)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// WithUsersLoader returns context, which contains loader.
+func WithUsersLoader(ctx context.Context, loader *UsersLoader) context.Context {
+	return context.WithValue(ctx, usersLoaderKey{}, loader)
+}
+
+// UsersLoaderFromContext returns loader from context, if it exists.
+func UsersLoaderFromContext(ctx context.Context) (*UsersLoader, bool) {
+	loader, ok := ctx.Value(usersLoaderKey{}).(*UsersLoader)
+
+	return loader, ok
+}
+
+// UnaryServerUsersLoaderInterceptor provides new UsersLoader to context of each request.
+func UnaryServerUsersLoaderInterceptor(
+	ssoService interfaces.SsoService,
+	loaderConfig config.UsersLoaderConfig,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(WithUsersLoader(ctx, NewUsersLoader(ssoService, loaderConfig)), req)
+	}
+}
@@ -0,0 +1,125 @@
+package loaders_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/loaders"
+	mockservices "github.com/DKhorkov/hmtm-toys/mocks/services"
+)
+
+func TestUsersLoader_GetUserByID(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config config.UsersLoaderConfig
+	}{
+		{
+			name: "batch is loaded after wait",
+			config: config.UsersLoaderConfig{
+				Wait:           10 * time.Millisecond,
+				MaxBatchSize:   100,
+				MaxConcurrency: 2,
+			},
+		},
+		{
+			name: "batch is loaded, when it reaches max size",
+			config: config.UsersLoaderConfig{
+				Wait:           time.Hour,
+				MaxBatchSize:   2,
+				MaxConcurrency: 1,
+			},
+		},
+	}
+
+	// The same IDs are requested several times, but each of them is loaded once:
+	ids := []uint64{1, 2, 1, 3, 2, 4, 4, 1}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			ssoService := mockservices.NewMockSsoService(mockController)
+			loader := loaders.NewUsersLoader(ssoService, tc.config)
+			ctx := context.Background()
+
+			for _, id := range []uint64{1, 2, 3} {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), id).
+					Return(&entities.User{ID: id}, nil).
+					Times(1)
+			}
+
+			ssoService.
+				EXPECT().
+				GetUserByID(gomock.Any(), uint64(4)).
+				Return(nil, &customerrors.UserNotFoundError{}).
+				Times(1)
+
+			var wg sync.WaitGroup
+
+			for _, id := range ids {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					user, err := loader.GetUserByID(ctx, id)
+					if id == 4 {
+						require.IsType(t, &customerrors.UserNotFoundError{}, err)
+
+						return
+					}
+
+					require.NoError(t, err)
+					require.Equal(t, &entities.User{ID: id}, user)
+				}()
+			}
+
+			wg.Wait()
+
+			// Loaded Users are memoized till the end of request:
+			user, err := loader.GetUserByID(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, &entities.User{ID: 1}, user)
+		})
+	}
+}
+
+func TestUsersLoader_CanceledContext(t *testing.T) {
+	mockController := gomock.NewController(t)
+	ssoService := mockservices.NewMockSsoService(mockController)
+	loader := loaders.NewUsersLoader(
+		ssoService,
+		config.UsersLoaderConfig{
+			Wait:           time.Hour,
+			MaxBatchSize:   100,
+			MaxConcurrency: 1,
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := loader.GetUserByID(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestUsersLoaderFromContext(t *testing.T) {
+	_, ok := loaders.UsersLoaderFromContext(context.Background())
+	require.False(t, ok)
+
+	loader := loaders.NewUsersLoader(nil, config.UsersLoaderConfig{})
+	ctx := loaders.WithUsersLoader(context.Background(), loader)
+
+	loaderFromContext, ok := loaders.UsersLoaderFromContext(ctx)
+	require.True(t, ok)
+	require.Same(t, loader, loaderFromContext)
+}
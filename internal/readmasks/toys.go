@@ -0,0 +1,33 @@
+package readmasks
+
+import "context"
+
+// toyRelationsKey is used to pass requested relations of Toys through context.
+type toyRelationsKey struct{}
+
+// ToyRelations describes, which relations of Toys should be read. Each relation is read by separate query,
+// so relations, which are not requested by client, are skipped.
+type ToyRelations struct {
+	Tags        bool
+	Attachments bool
+}
+
+// AllToyRelations returns all relations of Toys, which are read, if client has not provided read mask.
+func AllToyRelations() ToyRelations {
+	return ToyRelations{Tags: true, Attachments: true}
+}
+
+// WithToyRelations returns context, which contains relations of Toys to read.
+func WithToyRelations(ctx context.Context, relations ToyRelations) context.Context {
+	return context.WithValue(ctx, toyRelationsKey{}, relations)
+}
+
+// ToyRelationsFromContext returns relations of Toys to read. All relations are read, if context doesn't
+// contain them, so only handlers of read requests with mask should narrow them.
+func ToyRelationsFromContext(ctx context.Context) ToyRelations {
+	if relations, ok := ctx.Value(toyRelationsKey{}).(ToyRelations); ok {
+		return relations
+	}
+
+	return AllToyRelations()
+}
@@ -0,0 +1,18 @@
+package readmasks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
+)
+
+func TestToyRelationsFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, readmasks.AllToyRelations(), readmasks.ToyRelationsFromContext(ctx))
+
+	relations := readmasks.ToyRelations{Tags: true}
+	require.Equal(t, relations, readmasks.ToyRelationsFromContext(readmasks.WithToyRelations(ctx, relations)))
+}
@@ -0,0 +1,178 @@
+package replicas
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+)
+
+// Connect opens connections pool for each replica from config. Replicas share credentials with primary.
+func Connect(
+	primaryConfig db.Config,
+	replicasConfig config.ReplicasConfig,
+	logger logging.Logger,
+) ([]db.Connector, error) {
+	connectors := make([]db.Connector, 0, len(replicasConfig.Hosts))
+	for _, address := range replicasConfig.Hosts {
+		connector, err := connect(primaryConfig, replicasConfig.Pool, address, logger)
+		if err != nil {
+			for _, openedConnector := range connectors {
+				if closeErr := openedConnector.Close(); closeErr != nil {
+					logging.LogError(logger, "Failed to close replica connections pool", closeErr)
+				}
+			}
+
+			return nil, fmt.Errorf("failed to connect to replica %s: %w", address, err)
+		}
+
+		connectors = append(connectors, connector)
+	}
+
+	return connectors, nil
+}
+
+// New creates Connector, which uses primary for writes and transactions and routes read-only queries
+// to replicas in round-robin order. Replica is skipped, while its lag exceeds config.MaxLag or can't be checked.
+func New(
+	primary db.Connector,
+	replicas []db.Connector,
+	replicasConfig config.ReplicasConfig,
+	logger logging.Logger,
+) *Connector {
+	connector := &Connector{
+		Connector: primary,
+		replicas:  make([]*replica, len(replicas)),
+		config:    replicasConfig,
+		logger:    logger,
+	}
+
+	for i, replicaConnector := range replicas {
+		connector.replicas[i] = &replica{connector: replicaConnector}
+	}
+
+	return connector
+}
+
+// Connector implements db.Connector with primary and transactions.ReadConnector with replicas.
+type Connector struct {
+	db.Connector
+
+	replicas []*replica
+	next     atomic.Uint64
+	config   config.ReplicasConfig
+	logger   logging.Logger
+}
+
+type replica struct {
+	connector db.Connector
+	mutex     sync.Mutex
+	checkedAt time.Time
+	available bool
+}
+
+// ReadConnection returns connection to available replica or to primary, if there are no available replicas.
+func (connector *Connector) ReadConnection(ctx context.Context) (*sql.Conn, error) {
+	for range connector.replicas {
+		replica := connector.replicas[(connector.next.Add(1)-1)%uint64(len(connector.replicas))]
+		if !connector.isAvailable(ctx, replica) {
+			continue
+		}
+
+		connection, err := replica.connector.Connection(ctx)
+		if err == nil {
+			return connection, nil
+		}
+
+		logging.LogErrorContext(ctx, connector.logger, "Failed to get replica connection", err)
+		replica.mutex.Lock()
+		replica.available = false
+		replica.mutex.Unlock()
+	}
+
+	return connector.Connection(ctx)
+}
+
+// Close closes connections pools of replicas and primary.
+func (connector *Connector) Close() error {
+	errs := make([]error, 0, len(connector.replicas)+1)
+	for _, replica := range connector.replicas {
+		errs = append(errs, replica.connector.Close())
+	}
+
+	errs = append(errs, connector.Connector.Close())
+
+	return errors.Join(errs...)
+}
+
+// isAvailable checks replica lag not more often than once per config.LagCheckInterval.
+func (connector *Connector) isAvailable(ctx context.Context, replica *replica) bool {
+	replica.mutex.Lock()
+	defer replica.mutex.Unlock()
+
+	if time.Since(replica.checkedAt) < connector.config.LagCheckInterval {
+		return replica.available
+	}
+
+	replica.checkedAt = time.Now()
+
+	var lagSeconds float64
+	if err := replica.connector.Pool().QueryRowContext(ctx, connector.config.LagQuery).Scan(&lagSeconds); err != nil {
+		logging.LogErrorContext(ctx, connector.logger, "Failed to check replica lag", err)
+		replica.available = false
+
+		return false
+	}
+
+	replica.available = time.Duration(lagSeconds*float64(time.Second)) <= connector.config.MaxLag
+	if !replica.available {
+		logging.LogInfo(
+			connector.logger,
+			fmt.Sprintf("Replica lag %.1fs exceeds %s, reading from primary", lagSeconds, connector.config.MaxLag),
+		)
+	}
+
+	return replica.available
+}
+
+func connect(
+	primaryConfig db.Config,
+	pool db.PoolConfig,
+	address string,
+	logger logging.Logger,
+) (db.Connector, error) {
+	host, rawPort, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.Atoi(rawPort)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaConfig := primaryConfig
+	replicaConfig.Host = host
+	replicaConfig.Port = port
+	replicaConfig.Pool = pool
+
+	return db.New(
+		db.BuildDsn(replicaConfig),
+		replicaConfig.Driver,
+		logger,
+		db.WithMaxOpenConnections(pool.MaxOpenConnections),
+		db.WithMaxIdleConnections(pool.MaxIdleConnections),
+		db.WithMaxConnectionLifetime(pool.MaxConnectionLifetime),
+		db.WithMaxConnectionIdleTime(pool.MaxConnectionIdleTime),
+	)
+}
@@ -0,0 +1,186 @@
+//go:build integration
+
+package replicas_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/replicas"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+)
+
+const driver = "sqlite3"
+
+// newDatabase creates in-memory database, which contains its name to detect, where query was routed.
+func newDatabase(t *testing.T, name string) db.Connector {
+	t.Helper()
+
+	connector, err := db.New("file:"+name+"?mode=memory&cache=shared", driver, nil)
+	require.NoError(t, err)
+
+	_, err = connector.Pool().Exec("CREATE TABLE IF NOT EXISTS database_name (name TEXT)")
+	require.NoError(t, err)
+
+	_, err = connector.Pool().Exec("INSERT INTO database_name (name) VALUES (?)", name)
+	require.NoError(t, err)
+
+	return connector
+}
+
+func readDatabaseName(t *testing.T, ctx context.Context, executor transactions.Executor) string {
+	t.Helper()
+
+	var name string
+	require.NoError(t, executor.QueryRowContext(ctx, "SELECT name FROM database_name").Scan(&name))
+
+	return name
+}
+
+func TestConnector_ReadConnection(t *testing.T) {
+	testCases := []struct {
+		name      string
+		replicas  []string
+		lagQuery  string
+		expected  []string // database names of consecutive reads.
+		setupMock func(logger *mocklogging.MockLogger)
+	}{
+		{
+			name:     "without replicas",
+			expected: []string{"primary", "primary"},
+		},
+		{
+			name:     "replica with acceptable lag",
+			replicas: []string{"replica"},
+			lagQuery: "SELECT 1",
+			expected: []string{"replica", "replica"},
+		},
+		{
+			name:     "round robin between replicas",
+			replicas: []string{"first_replica", "second_replica"},
+			lagQuery: "SELECT 0",
+			expected: []string{"first_replica", "second_replica", "first_replica"},
+		},
+		{
+			name:     "replica with exceeded lag",
+			replicas: []string{"lagging_replica"},
+			lagQuery: "SELECT 10.5",
+			expected: []string{"primary", "primary"},
+			setupMock: func(logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					Info(gomock.Any()).
+					Times(1) // lag is checked once per interval.
+			},
+		},
+		{
+			name:     "failed to check replica lag",
+			replicas: []string{"broken_replica"},
+			lagQuery: "SELECT lag FROM replication",
+			expected: []string{"primary"},
+			setupMock: func(logger *mocklogging.MockLogger) {
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logger := mocklogging.NewMockLogger(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(logger)
+			}
+
+			replicasConnectors := make([]db.Connector, len(tc.replicas))
+			for i, replicaName := range tc.replicas {
+				replicasConnectors[i] = newDatabase(t, replicaName)
+			}
+
+			connector := replicas.New(
+				newDatabase(t, "primary"),
+				replicasConnectors,
+				config.ReplicasConfig{
+					MaxLag:           5 * time.Second,
+					LagCheckInterval: time.Minute,
+					LagQuery:         tc.lagQuery,
+				},
+				logger,
+			)
+
+			defer func() {
+				require.NoError(t, connector.Close())
+			}()
+
+			for _, expected := range tc.expected {
+				connection, err := connector.ReadConnection(ctx)
+				require.NoError(t, err)
+				require.Equal(t, expected, readDatabaseName(t, ctx, connection))
+				require.NoError(t, connection.Close())
+			}
+		})
+	}
+}
+
+func TestReadConnectionWithinTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	connector := replicas.New(
+		newDatabase(t, "transaction_primary"),
+		[]db.Connector{newDatabase(t, "transaction_replica")},
+		config.ReplicasConfig{
+			MaxLag:           5 * time.Second,
+			LagCheckInterval: time.Minute,
+			LagQuery:         "SELECT 0",
+		},
+		logger,
+	)
+
+	defer func() {
+		require.NoError(t, connector.Close())
+	}()
+
+	ctx := context.Background()
+
+	// Replica is used outside of transaction:
+	executor, release, err := transactions.ReadConnection(ctx, connector, logger)
+	require.NoError(t, err)
+	require.Equal(t, "transaction_replica", readDatabaseName(t, ctx, executor))
+	require.NoError(t, executor.(*sql.Conn).Close())
+	release()
+
+	// Primary is used inside transaction to read own writes:
+	transactionManager := transactions.NewManager(connector, sql.LevelDefault, logger)
+	err = transactionManager.WithinTransaction(
+		ctx,
+		func(ctx context.Context) error {
+			executor, release, err := transactions.ReadConnection(ctx, connector, logger)
+			if err != nil {
+				return err
+			}
+
+			defer release()
+
+			require.Equal(t, "transaction_primary", readDatabaseName(t, ctx, executor))
+
+			return nil
+		},
+	)
+	require.NoError(t, err)
+}
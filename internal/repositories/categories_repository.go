@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/db"
@@ -11,10 +13,20 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
 )
 
 const (
-	categoriesTableName = "categories"
+	categoriesTableName                   = "categories"
+	categoriesRulesTableName              = "categories_rules"
+	categoriesRequiredAttributesTableName = "categories_required_attributes"
+	priceFloorColumnName                  = "price_floor"
+	priceCeilColumnName                   = "price_ceil"
+	quantityFloorColumnName               = "quantity_floor"
+	quantityCeilColumnName                = "quantity_ceil"
+	descriptionMinLengthColumnName        = "description_min_length"
+	attributeColumnName                   = "attribute"
 )
 
 type CategoriesRepository struct {
@@ -47,12 +59,12 @@ func (repo *CategoriesRepository) GetAllCategories(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	stmt, params, err := sq.
 		Select(selectAllColumns).
@@ -115,12 +127,12 @@ func (repo *CategoriesRepository) GetCategoryByID(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	stmt, params, err := sq.
 		Select(selectAllColumns).
@@ -136,8 +148,140 @@ func (repo *CategoriesRepository) GetCategoryByID(
 
 	columns := db.GetEntityColumns(category)
 	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.CategoryNotFoundError{}
+		}
+
 		return nil, err
 	}
 
 	return category, nil
 }
+
+// GetCategoryRules returns rules of existing Category. Rules, which are not provided for Category, are nil.
+func (repo *CategoriesRepository) GetCategoryRules(
+	ctx context.Context,
+	categoryID uint32,
+) (*entities.CategoryRules, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	// Categories are joined to differ Category without rules from non-existing Category:
+	stmt, params, err := sq.
+		Select(
+			fmt.Sprintf("%s.%s", categoriesTableName, idColumnName),
+			fmt.Sprintf("%s.%s", categoriesRulesTableName, priceFloorColumnName),
+			fmt.Sprintf("%s.%s", categoriesRulesTableName, priceCeilColumnName),
+			fmt.Sprintf("%s.%s", categoriesRulesTableName, quantityFloorColumnName),
+			fmt.Sprintf("%s.%s", categoriesRulesTableName, quantityCeilColumnName),
+			fmt.Sprintf("%s.%s", categoriesRulesTableName, descriptionMinLengthColumnName),
+		).
+		From(categoriesTableName).
+		LeftJoin(
+			fmt.Sprintf(
+				"%s ON %s.%s = %s.%s",
+				categoriesRulesTableName,
+				categoriesRulesTableName,
+				categoryIDColumnName,
+				categoriesTableName,
+				idColumnName,
+			),
+		).
+		Where(sq.Eq{fmt.Sprintf("%s.%s", categoriesTableName, idColumnName): categoryID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rules := &entities.CategoryRules{}
+
+	columns := db.GetEntityColumns(rules)
+	columns = columns[:len(columns)-1] // Not to paste RequiredAttributes field to Scan function.
+
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.CategoryNotFoundError{}
+		}
+
+		return nil, err
+	}
+
+	rules.RequiredAttributes, err = repo.getCategoryRequiredAttributes(ctx, categoryID, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (repo *CategoriesRepository) getCategoryRequiredAttributes(
+	ctx context.Context,
+	categoryID uint32,
+	connection transactions.Executor,
+) ([]entities.ToyAttribute, error) {
+	stmt, params, err := sq.
+		Select(attributeColumnName).
+		From(categoriesRequiredAttributesTableName).
+		Where(sq.Eq{categoryIDColumnName: categoryID}).
+		OrderBy(idColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var attributes []entities.ToyAttribute
+
+	for rows.Next() {
+		var attribute entities.ToyAttribute
+		if err = rows.Scan(&attribute); err != nil {
+			return nil, err
+		}
+
+		// Unknown attribute can't be checked, so rules are rejected instead of silently ignoring it:
+		switch attribute {
+		case entities.ToyAttributeTags, entities.ToyAttributeAttachments:
+		default:
+			return nil, fmt.Errorf("unknown required attribute %q of Category with ID=%d", attribute, categoryID)
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
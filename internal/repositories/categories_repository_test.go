@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/suite"
@@ -16,9 +17,12 @@ import (
 
 	"github.com/DKhorkov/libs/db"
 	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
 	"github.com/DKhorkov/libs/tracing"
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/repositories"
 )
 
@@ -135,5 +139,96 @@ func (s *CategoriesRepositoryTestSuite) TestGetCategoryByIDNonExisting() {
 
 	category, err := s.categoriesRepository.GetCategoryByID(s.ctx, 999)
 	s.Error(err)
+	s.IsType(&customerrors.CategoryNotFoundError{}, err)
 	s.Nil(category)
 }
+
+func (s *CategoriesRepositoryTestSuite) TestGetCategoryRulesWithExistingRules() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO categories_rules "+
+			"(id, category_id, price_floor, quantity_ceil, description_min_length, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 500, 10, 50, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO categories_required_attributes (id, category_id, attribute, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, "attachments", createdAt, createdAt,
+		2, 1, "tags", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	rules, err := s.categoriesRepository.GetCategoryRules(s.ctx, 1)
+	s.NoError(err)
+	s.Equal(
+		&entities.CategoryRules{
+			CategoryID:           1,
+			PriceFloor:           pointers.New[float32](500),
+			QuantityCeil:         pointers.New[uint32](10),
+			DescriptionMinLength: pointers.New[uint32](50),
+			RequiredAttributes: []entities.ToyAttribute{
+				entities.ToyAttributeAttachments,
+				entities.ToyAttributeTags,
+			},
+		},
+		rules,
+	)
+}
+
+func (s *CategoriesRepositoryTestSuite) TestGetCategoryRulesWithUnknownAttribute() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO categories_required_attributes (id, category_id, attribute, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, "tags", createdAt, createdAt,
+		2, 1, "photos", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	rules, err := s.categoriesRepository.GetCategoryRules(s.ctx, 1)
+	s.ErrorContains(err, `unknown required attribute "photos"`)
+	s.Nil(rules)
+}
+
+func (s *CategoriesRepositoryTestSuite) TestGetCategoryRulesWithoutRules() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	rules, err := s.categoriesRepository.GetCategoryRules(s.ctx, 1)
+	s.NoError(err)
+	s.Equal(&entities.CategoryRules{CategoryID: 1}, rules)
+}
+
+func (s *CategoriesRepositoryTestSuite) TestGetCategoryRulesNonExistingCategory() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	rules, err := s.categoriesRepository.GetCategoryRules(s.ctx, 999)
+	s.Error(err)
+	s.IsType(&customerrors.CategoryNotFoundError{}, err)
+	s.Nil(rules)
+}
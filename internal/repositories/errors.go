@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"errors"
+	"strings"
+)
+
+// uniqueViolationSQLState is a Postgres SQLSTATE code of unique constraint violation.
+const uniqueViolationSQLState = "23505"
+
+// sqlStateError is implemented by errors of Postgres drivers, which expose SQLSTATE code of failed statement.
+type sqlStateError interface {
+	error
+	SQLState() string
+}
+
+// isUniqueViolation checks, if error is a violation of unique constraint or index with provided name. Name of
+// constraint is a part of Postgres error message, so it is checked without depending on driver error type.
+func isUniqueViolation(err error, constraint string) bool {
+	var stateErr sqlStateError
+	if !errors.As(err, &stateErr) {
+		return false
+	}
+
+	return stateErr.SQLState() == uniqueViolationSQLState && strings.Contains(stateErr.Error(), constraint)
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testSQLStateError struct {
+	state   string
+	message string
+}
+
+func (e testSQLStateError) Error() string {
+	return e.message
+}
+
+func (e testSQLStateError) SQLState() string {
+	return e.state
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "unique violation of constraint",
+			err: testSQLStateError{
+				state:   uniqueViolationSQLState,
+				message: `pq: duplicate key value violates unique constraint "masters_slug_idx"`,
+			},
+			expected: true,
+		},
+		{
+			name: "wrapped unique violation of constraint",
+			err: fmt.Errorf("update failed: %w", testSQLStateError{
+				state:   uniqueViolationSQLState,
+				message: `pq: duplicate key value violates unique constraint "masters_slug_idx"`,
+			}),
+			expected: true,
+		},
+		{
+			name: "unique violation of other constraint",
+			err: testSQLStateError{
+				state:   uniqueViolationSQLState,
+				message: `pq: duplicate key value violates unique constraint "masters_user_id_key"`,
+			},
+		},
+		{
+			name: "other SQL state",
+			err: testSQLStateError{
+				state:   "23503",
+				message: `pq: insert or update violates foreign key constraint "masters_slug_idx"`,
+			},
+		},
+		{
+			name: "error without SQL state",
+			err:  errors.New("masters_slug_idx"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isUniqueViolation(tc.err, mastersSlugIndexName))
+		})
+	}
+}
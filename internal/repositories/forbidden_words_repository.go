@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+)
+
+const (
+	forbiddenWordsTableName      = "forbidden_words"
+	forbiddenWordColumnName      = "word"
+	forbiddenWordsCommentPrefix  = "#"
+	forbiddenWordsFileSourceName = "forbidden words file"
+)
+
+type ForbiddenWordsRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+}
+
+func NewForbiddenWordsRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *ForbiddenWordsRepository {
+	return &ForbiddenWordsRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+	}
+}
+
+func (repo *ForbiddenWordsRepository) GetForbiddenWords(ctx context.Context) ([]string, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(forbiddenWordColumnName).
+		From(forbiddenWordsTableName).
+		OrderBy(idColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var words []string
+
+	for rows.Next() {
+		var word string
+		if err = rows.Scan(&word); err != nil {
+			return nil, err
+		}
+
+		words = append(words, word)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return words, nil
+}
+
+// NewForbiddenWordsFileRepository creates repository, which reads forbidden words from file with one word per line.
+// Empty lines and lines, starting with "#", are skipped.
+func NewForbiddenWordsFileRepository(filePath string) *ForbiddenWordsFileRepository {
+	return &ForbiddenWordsFileRepository{filePath: filePath}
+}
+
+type ForbiddenWordsFileRepository struct {
+	filePath string
+}
+
+// GetForbiddenWords reads file on each call, so changes of file are applied on next reload.
+func (repo *ForbiddenWordsFileRepository) GetForbiddenWords(_ context.Context) ([]string, error) {
+	file, err := os.Open(filepath.Clean(repo.filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", forbiddenWordsFileSourceName, err)
+	}
+
+	defer file.Close()
+
+	var words []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, forbiddenWordsCommentPrefix) {
+			continue
+		}
+
+		words = append(words, word)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", forbiddenWordsFileSourceName, err)
+	}
+
+	return words, nil
+}
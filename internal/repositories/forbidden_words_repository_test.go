@@ -0,0 +1,159 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/repositories"
+)
+
+func TestForbiddenWordsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ForbiddenWordsRepositoryTestSuite))
+}
+
+type ForbiddenWordsRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd                      string
+	ctx                      context.Context
+	dbConnector              db.Connector
+	connection               *sql.Conn
+	forbiddenWordsRepository *repositories.ForbiddenWordsRepository
+	logger                   *mocklogging.MockLogger
+	traceProvider            *mocktracing.MockProvider
+	spanConfig               tracing.SpanConfig
+}
+
+func (s *ForbiddenWordsRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.forbiddenWordsRepository = repositories.NewForbiddenWordsRepository(
+		s.dbConnector,
+		s.logger,
+		s.traceProvider,
+		s.spanConfig,
+	)
+}
+
+func (s *ForbiddenWordsRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+}
+
+func (s *ForbiddenWordsRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *ForbiddenWordsRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *ForbiddenWordsRepositoryTestSuite) TestGetForbiddenWordsWithExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO forbidden_words (id, word, created_at, updated_at) VALUES (?, ?, ?, ?), (?, ?, ?, ?)",
+		1, "жаба", createdAt, createdAt,
+		2, "ёжик", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	words, err := s.forbiddenWordsRepository.GetForbiddenWords(s.ctx)
+	s.NoError(err)
+	s.Equal([]string{"жаба", "ёжик"}, words)
+}
+
+func (s *ForbiddenWordsRepositoryTestSuite) TestGetForbiddenWordsWithoutExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	words, err := s.forbiddenWordsRepository.GetForbiddenWords(s.ctx)
+	s.NoError(err)
+	s.Empty(words)
+}
+
+func TestForbiddenWordsFileRepository_GetForbiddenWords(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "forbidden_words.txt")
+	require.NoError(
+		t,
+		os.WriteFile(filePath, []byte("# Сленг\nжаба\n\n  ёжик  \n"), 0o600),
+	)
+
+	forbiddenWordsRepository := repositories.NewForbiddenWordsFileRepository(filePath)
+
+	words, err := forbiddenWordsRepository.GetForbiddenWords(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"жаба", "ёжик"}, words)
+
+	// File is read on each call, so changes are applied on next reload:
+	require.NoError(t, os.WriteFile(filePath, []byte("жаба\n"), 0o600))
+
+	words, err = forbiddenWordsRepository.GetForbiddenWords(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"жаба"}, words)
+
+	_, err = repositories.NewForbiddenWordsFileRepository(filePath + ".missing").GetForbiddenWords(context.Background())
+	require.Error(t, err)
+}
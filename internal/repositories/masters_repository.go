@@ -2,8 +2,12 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/DKhorkov/libs/db"
 	"github.com/DKhorkov/libs/logging"
@@ -12,12 +16,34 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
 )
 
 const (
-	mastersTableName     = "masters"
-	userIDColumnName     = "user_id"
-	masterInfoColumnName = "info"
+	mastersTableName          = "masters"
+	userIDColumnName          = "user_id"
+	masterInfoColumnName      = "info"
+	followsTableName          = "follows"
+	followersCountColumnName  = "followers_count"
+	shopNameColumnName        = "shop_name"
+	slugColumnName            = "slug"
+	avatarColumnName          = "avatar"
+	bannerColumnName          = "banner"
+	cityColumnName            = "city"
+	regionColumnName          = "region"
+	policiesColumnName        = "policies"
+	socialLinksTableName      = "masters_social_links"
+	socialLinkColumnName      = "link"
+	shipsToTableName          = "masters_ships_to"
+	vacationUntilColumnName   = "vacation_until"
+	vacationMessageColumnName = "vacation_message"
+	masterStatusColumnName    = "status"
+	previousStatusColumnName  = "previous_status"
+	reasonColumnName          = "reason"
+	statusHistoryTableName    = "masters_status_history"
+	followsOnConflictSuffix   = "ON CONFLICT (user_id, master_id) DO NOTHING"
+	mastersSlugIndexName      = "masters_slug_idx"
 )
 
 type MastersRepository struct {
@@ -52,48 +78,39 @@ func (repo *MastersRepository) GetMasters(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.ReadConnection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	builder := sq.
 		Select(selectAllColumns).
 		From(mastersTableName).
 		PlaceholderFormat(sq.Dollar)
 
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							mastersTableName,
-							masterInfoColumnName,
-						): searchTerm,
-					},
-				},
-			)
-	}
+	builder = applyMastersFilters(builder, filters)
 
 	createdAtOrder := desc
 	if filters != nil && filters.CreatedAtOrderByAsc != nil && *filters.CreatedAtOrderByAsc {
 		createdAtOrder = asc
 	}
 
-	builder = builder.
-		OrderBy(
-			fmt.Sprintf(
-				"%s.%s %s",
-				mastersTableName,
-				createdAtColumnName,
-				createdAtOrder,
-			),
-		)
+	if filters != nil && filters.IDAfter != nil {
+		// Keyset pagination relies on order of IDs, which is not affected by changes of scanned Masters:
+		builder = builder.OrderBy(fmt.Sprintf("%s.%s %s", mastersTableName, idColumnName, asc))
+	} else {
+		builder = builder.
+			OrderBy(
+				fmt.Sprintf(
+					"%s.%s %s",
+					mastersTableName,
+					createdAtColumnName,
+					createdAtOrder,
+				),
+			)
+	}
 
 	if pagination != nil && pagination.Limit != nil {
 		builder = builder.Limit(*pagination.Limit)
@@ -103,50 +120,7 @@ func (repo *MastersRepository) GetMasters(
 		builder = builder.Offset(*pagination.Offset)
 	}
 
-	stmt, params, err := builder.ToSql()
-	if err != nil {
-		return nil, err
-	}
-
-	rows, err := connection.QueryContext(
-		ctx,
-		stmt,
-		params...,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		if err = rows.Close(); err != nil {
-			logging.LogErrorContext(
-				ctx,
-				repo.logger,
-				"error during closing SQL rows",
-				err,
-			)
-		}
-	}()
-
-	var masters []entities.Master
-
-	for rows.Next() {
-		master := entities.Master{}
-		columns := db.GetEntityColumns(&master) // Only pointer to use rows.Scan() successfully
-
-		err = rows.Scan(columns...)
-		if err != nil {
-			return nil, err
-		}
-
-		masters = append(masters, master)
-	}
-
-	if err = rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return masters, nil
+	return repo.selectMasters(ctx, builder, connection)
 }
 
 func (repo *MastersRepository) CountMasters(ctx context.Context, filters *entities.MastersFilters) (uint64, error) {
@@ -156,33 +130,19 @@ func (repo *MastersRepository) CountMasters(ctx context.Context, filters *entiti
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.ReadConnection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return 0, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	builder := sq.
 		Select(selectCount).
 		From(mastersTableName).
 		PlaceholderFormat(sq.Dollar)
 
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							mastersTableName,
-							masterInfoColumnName,
-						): searchTerm,
-					},
-				},
-			)
-	}
+	builder = applyMastersFilters(builder, filters)
 
 	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
 	stmt, params, err := builder.ToSql()
@@ -208,31 +168,20 @@ func (repo *MastersRepository) GetMasterByUserID(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
-	stmt, params, err := sq.
+	builder := sq.
 		Select(selectAllColumns).
 		From(mastersTableName).
 		Where(sq.Eq{userIDColumnName: userID}).
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
-	if err != nil {
-		return nil, err
-	}
-
-	master := &entities.Master{}
-
-	columns := db.GetEntityColumns(master)
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
-		return nil, err
-	}
+		PlaceholderFormat(sq.Dollar)
 
-	return master, nil
+	return repo.selectMaster(ctx, builder, connection)
 }
 
 func (repo *MastersRepository) GetMasterByID(
@@ -245,31 +194,46 @@ func (repo *MastersRepository) GetMasterByID(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
-	stmt, params, err := sq.
+	builder := sq.
 		Select(selectAllColumns).
 		From(mastersTableName).
 		Where(sq.Eq{idColumnName: id}).
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
+		PlaceholderFormat(sq.Dollar)
+
+	return repo.selectMaster(ctx, builder, connection)
+}
+
+func (repo *MastersRepository) GetMasterBySlug(
+	ctx context.Context,
+	slug string,
+) (*entities.Master, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	master := &entities.Master{}
+	defer release()
 
-	columns := db.GetEntityColumns(master)
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
-		return nil, err
-	}
+	builder := sq.
+		Select(selectAllColumns).
+		From(mastersTableName).
+		Where(sq.Eq{slugColumnName: slug}).
+		PlaceholderFormat(sq.Dollar)
 
-	return master, nil
+	return repo.selectMaster(ctx, builder, connection)
 }
 
 func (repo *MastersRepository) RegisterMaster(
@@ -282,12 +246,12 @@ func (repo *MastersRepository) RegisterMaster(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return 0, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	stmt, params, err := sq.
 		Insert(mastersTableName).
@@ -324,28 +288,995 @@ func (repo *MastersRepository) UpdateMaster(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
 	if err != nil {
 		return err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	profileFields := []struct {
+		field  string
+		column string
+		value  *string
+	}{
+		{field: entities.MasterFieldInfo, column: masterInfoColumnName, value: masterData.Info},
+		{field: entities.MasterFieldShopName, column: shopNameColumnName, value: masterData.ShopName},
+		{field: entities.MasterFieldSlug, column: slugColumnName, value: masterData.Slug},
+		{field: entities.MasterFieldAvatar, column: avatarColumnName, value: masterData.Avatar},
+		{field: entities.MasterFieldBanner, column: bannerColumnName, value: masterData.Banner},
+		{field: entities.MasterFieldCity, column: cityColumnName, value: masterData.City},
+		{field: entities.MasterFieldRegion, column: regionColumnName, value: masterData.Region},
+		{field: entities.MasterFieldPolicies, column: policiesColumnName, value: masterData.Policies},
+	}
+
+	setMap := make(map[string]any, len(profileFields))
+
+	for _, profileField := range profileFields {
+		if updatesMasterField(masterData, profileField.field, profileField.value != nil) {
+			setMap[profileField.column] = profileField.value
+		}
+	}
+
+	// Slug is checked by service before update, but concurrent updates with the same slug are resolved only by
+	// unique index:
+	if err = updateMasterColumns(ctx, transaction, masterData.ID, setMap); err != nil {
+		if isUniqueViolation(err, mastersSlugIndexName) {
+			return &customerrors.MasterSlugAlreadyExistsError{BaseErr: err}
+		}
+
+		return err
+	}
+
+	// Social links and "ships to" regions are fully replaced by provided lists:
+	if updatesMasterField(masterData, entities.MasterFieldSocialLinks, masterData.SocialLinks != nil) {
+		if err = replaceMasterValues(
+			ctx,
+			transaction,
+			socialLinksTableName,
+			socialLinkColumnName,
+			masterData.ID,
+			masterData.SocialLinks,
+		); err != nil {
+			return err
+		}
+	}
+
+	if updatesMasterField(masterData, entities.MasterFieldShipsTo, masterData.ShipsTo != nil) {
+		if err = replaceMasterValues(
+			ctx,
+			transaction,
+			shipsToTableName,
+			regionColumnName,
+			masterData.ID,
+			masterData.ShipsTo,
+		); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+func (repo *MastersRepository) GetFollowedMasters(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Master, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(mastersTableName).
+		Where(userFollowsCondition(userID)).
+		OrderBy(
+			fmt.Sprintf(
+				"%s.%s %s",
+				mastersTableName,
+				createdAtColumnName,
+				desc,
+			),
+		).
+		PlaceholderFormat(sq.Dollar)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	return repo.selectMasters(ctx, builder, connection)
+}
+
+func (repo *MastersRepository) CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	defer release()
 
 	stmt, params, err := sq.
-		Update(mastersTableName).
-		Where(sq.Eq{idColumnName: masterData.ID}).
-		Set(masterInfoColumnName, masterData.Info).
-		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		Select(selectCount).
+		From(followsTableName).
+		Where(sq.Eq{userIDColumnName: userID}).
+		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	_, err = connection.ExecContext(
-		ctx,
-		stmt,
-		params...,
-	)
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
 
-	return err
+	return count, nil
+}
+
+func (repo *MastersRepository) GetFollow(
+	ctx context.Context,
+	userID uint64,
+	masterID uint64,
+) (*entities.Follow, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(followsTableName).
+		Where(
+			sq.And{
+				sq.Eq{userIDColumnName: userID},
+				sq.Eq{masterIDColumnName: masterID},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	follow := &entities.Follow{}
+	columns := db.GetEntityColumns(follow)
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.FollowNotFoundError{}
+		}
+
+		return nil, err
+	}
+
+	return follow, nil
+}
+
+func (repo *MastersRepository) FollowMaster(
+	ctx context.Context,
+	userID uint64,
+	masterID uint64,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	// Concurrent follows of the same Master are resolved by unique constraint, so no row is returned for
+	// already existing Follow and followers count is incremented only for inserted one:
+	stmt, params, err := sq.
+		Insert(followsTableName).
+		Columns(userIDColumnName, masterIDColumnName).
+		Values(userID, masterID).
+		Suffix(followsOnConflictSuffix + " " + returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var followID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&followID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &customerrors.FollowAlreadyExistsError{}
+		}
+
+		return 0, err
+	}
+
+	stmt, params, err = sq.
+		Update(mastersTableName).
+		Set(followersCountColumnName, sq.Expr(followersCountColumnName+" + 1")).
+		Where(sq.Eq{idColumnName: masterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return 0, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, err
+	}
+
+	return followID, nil
+}
+
+func (repo *MastersRepository) UnfollowMaster(ctx context.Context, userID, masterID uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Delete(followsTableName).
+		Where(
+			sq.And{
+				sq.Eq{userIDColumnName: userID},
+				sq.Eq{masterIDColumnName: masterID},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// Counter is decremented only if Follow really existed to keep it consistent:
+	if deleted > 0 {
+		stmt, params, err = sq.
+			Update(mastersTableName).
+			Set(followersCountColumnName, sq.Expr(followersCountColumnName+" - ?", deleted)).
+			Where(sq.Eq{idColumnName: masterID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+// SetVacationMode enables or disables vacation mode of Master and updates availability of all Master's Toys.
+func (repo *MastersRepository) SetVacationMode(ctx context.Context, vacationData entities.SetVacationModeDTO) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	// Message is not kept after vacation ends:
+	message := vacationData.Message
+	if vacationData.Until == nil {
+		message = nil
+	}
+
+	stmt, params, err := sq.
+		Update(mastersTableName).
+		Set(vacationUntilColumnName, vacationData.Until).
+		Set(vacationMessageColumnName, message).
+		Where(sq.Eq{idColumnName: vacationData.MasterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	stmt, params, err = sq.
+		Update(toysTableName).
+		Set(toyAvailableColumnName, vacationData.Until == nil).
+		Where(sq.Eq{masterIDColumnName: vacationData.MasterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// EndExpiredVacations disables vacation mode of all Masters, whose vacation ended before provided time,
+// and makes their Toys available again. Returns count of Masters, whose vacation was ended.
+func (repo *MastersRepository) EndExpiredVacations(ctx context.Context, now time.Time) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	// Toys are updated first, while expired vacations are still stored in Masters table:
+	stmt, params, err := sq.
+		Update(toysTableName).
+		Set(toyAvailableColumnName, true).
+		Where(
+			sq.Expr(
+				fmt.Sprintf(
+					"%s IN (SELECT %s FROM %s WHERE %s <= ?)",
+					masterIDColumnName,
+					idColumnName,
+					mastersTableName,
+					vacationUntilColumnName,
+				),
+				now,
+			),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return 0, err
+	}
+
+	stmt, params, err = sq.
+		Update(mastersTableName).
+		Set(vacationUntilColumnName, nil).
+		Set(vacationMessageColumnName, nil).
+		Where(sq.LtOrEq{vacationUntilColumnName: now}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return 0, err
+	}
+
+	ended, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, err
+	}
+
+	return uint64(ended), nil
+}
+
+// ChangeMasterStatus changes moderation status of Master and saves change to status history.
+func (repo *MastersRepository) ChangeMasterStatus(ctx context.Context, statusData entities.ChangeMasterStatusDTO) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Select(masterStatusColumnName).
+		From(mastersTableName).
+		Where(sq.Eq{idColumnName: statusData.MasterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var previousStatus entities.MasterStatus
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&previousStatus); err != nil {
+		return err
+	}
+
+	stmt, params, err = sq.
+		Update(mastersTableName).
+		Set(masterStatusColumnName, statusData.Status).
+		Where(sq.Eq{idColumnName: statusData.MasterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	stmt, params, err = sq.
+		Insert(statusHistoryTableName).
+		Columns(
+			masterIDColumnName,
+			previousStatusColumnName,
+			masterStatusColumnName,
+			reasonColumnName,
+		).
+		Values(
+			statusData.MasterID,
+			previousStatus,
+			statusData.Status,
+			statusData.Reason,
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// GetMasterStatusHistory returns all moderation status changes of Master, starting from the newest one.
+func (repo *MastersRepository) GetMasterStatusHistory(
+	ctx context.Context,
+	masterID uint64,
+) ([]entities.MasterStatusChange, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(statusHistoryTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		OrderBy(
+			fmt.Sprintf("%s %s", createdAtColumnName, desc),
+			fmt.Sprintf("%s %s", idColumnName, desc),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var history []entities.MasterStatusChange
+
+	for rows.Next() {
+		statusChange := entities.MasterStatusChange{}
+		columns := db.GetEntityColumns(&statusChange) // Only pointer to use rows.Scan() successfully
+
+		if err = rows.Scan(columns...); err != nil {
+			return nil, err
+		}
+
+		history = append(history, statusChange)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// selectMasters executes provided Masters select query and scans found Masters.
+func (repo *MastersRepository) selectMasters(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+	connection transactions.Executor,
+) ([]entities.Master, error) {
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var masters []entities.Master
+
+	for rows.Next() {
+		master := entities.Master{}
+		columns := db.GetEntityColumns(&master) // Only pointer to use rows.Scan() successfully
+		columns = columns[:len(columns)-2]      // Not to paste SocialLinks and ShipsTo fields to Scan function.
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		masters = append(masters, master)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Reading SocialLinks and ShipsTo for each Master in new circle due
+	// to next error: https://github.com/lib/pq/issues/635
+	// Using master index to avoid range iter semantics error, via using copied variable.
+	for i, master := range masters {
+		socialLinks, err := repo.getMasterSocialLinks(ctx, master.ID, connection)
+		if err != nil {
+			return nil, err
+		}
+
+		masters[i].SocialLinks = socialLinks
+
+		shipsTo, err := repo.getMasterShipsTo(ctx, master.ID, connection)
+		if err != nil {
+			return nil, err
+		}
+
+		masters[i].ShipsTo = shipsTo
+	}
+
+	return masters, nil
+}
+
+// selectMaster executes provided single Master select query and loads SocialLinks and ShipsTo for found Master.
+func (repo *MastersRepository) selectMaster(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+	connection transactions.Executor,
+) (*entities.Master, error) {
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	master := &entities.Master{}
+	columns := db.GetEntityColumns(master)
+	columns = columns[:len(columns)-2] // Not to paste SocialLinks and ShipsTo fields to Scan function.
+
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.MasterNotFoundError{}
+		}
+
+		return nil, err
+	}
+
+	socialLinks, err := repo.getMasterSocialLinks(ctx, master.ID, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	master.SocialLinks = socialLinks
+
+	shipsTo, err := repo.getMasterShipsTo(ctx, master.ID, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	master.ShipsTo = shipsTo
+
+	return master, nil
+}
+
+func (repo *MastersRepository) getMasterSocialLinks(
+	ctx context.Context,
+	masterID uint64,
+	connection transactions.Executor,
+) ([]string, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	return repo.getMasterValues(ctx, socialLinksTableName, socialLinkColumnName, masterID, connection)
+}
+
+func (repo *MastersRepository) getMasterShipsTo(
+	ctx context.Context,
+	masterID uint64,
+	connection transactions.Executor,
+) ([]string, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	return repo.getMasterValues(ctx, shipsToTableName, regionColumnName, masterID, connection)
+}
+
+// getMasterValues reads single column values of Master's list table in order of their creation.
+func (repo *MastersRepository) getMasterValues(
+	ctx context.Context,
+	tableName string,
+	columnName string,
+	masterID uint64,
+	connection transactions.Executor,
+) ([]string, error) {
+	stmt, params, err := sq.
+		Select(columnName).
+		From(tableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		OrderBy(idColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var values []string
+
+	for rows.Next() {
+		var value string
+		if err = rows.Scan(&value); err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// userFollowsCondition returns condition for selecting only Masters, which are followed by User.
+func userFollowsCondition(userID uint64) sq.Sqlizer {
+	return sq.Expr(
+		fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
+			followsTableName,
+			followsTableName,
+			masterIDColumnName,
+			mastersTableName,
+			idColumnName,
+			followsTableName,
+			userIDColumnName,
+		),
+		userID,
+	)
+}
+
+// replaceMasterValues replaces all values of Master's list table with provided values.
+// updateMasterColumns sets new values of Master columns. Nothing is updated, if update mask lists
+// only social links and "ships to" regions, which are stored in other tables.
+func updateMasterColumns(
+	ctx context.Context,
+	transaction transactions.Executor,
+	masterID uint64,
+	setMap map[string]any,
+) error {
+	if len(setMap) == 0 {
+		return nil
+	}
+
+	stmt, params, err := sq.
+		Update(mastersTableName).
+		Where(sq.Eq{idColumnName: masterID}).
+		SetMap(setMap).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.ExecContext(ctx, stmt, params...)
+
+	return err
+}
+
+// updatesMasterField checks, if field of Master is replaced by update. Without mask only provided fields are
+// replaced, so callers, which don't know about all fields of profile, don't clear the rest of them.
+func updatesMasterField(masterData entities.UpdateMasterDTO, field string, provided bool) bool {
+	if masterData.UpdateMask == nil {
+		return provided
+	}
+
+	return slices.Contains(masterData.UpdateMask, field)
+}
+
+func replaceMasterValues(
+	ctx context.Context,
+	transaction transactions.Executor,
+	tableName string,
+	columnName string,
+	masterID uint64,
+	values []string,
+) error {
+	stmt, params, err := sq.
+		Delete(tableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	builder := sq.
+		Insert(tableName).
+		Columns(masterIDColumnName, columnName).
+		PlaceholderFormat(sq.Dollar) // pq postgres driver works only with $ placeholders
+
+	for _, value := range values {
+		builder = builder.Values(masterID, value)
+	}
+
+	stmt, params, err = builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.ExecContext(ctx, stmt, params...)
+
+	return err
+}
+
+func applyMastersFilters(builder sq.SelectBuilder, filters *entities.MastersFilters) sq.SelectBuilder {
+	if filters != nil && filters.Search != nil && *filters.Search != "" {
+		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
+		builder = builder.
+			Where(
+				sq.Or{
+					sq.ILike{
+						fmt.Sprintf(
+							"%s.%s",
+							mastersTableName,
+							masterInfoColumnName,
+						): searchTerm,
+					},
+				},
+			)
+	}
+
+	if filters != nil && filters.Name != nil && *filters.Name != "" {
+		builder = builder.
+			Where(
+				sq.ILike{
+					fmt.Sprintf(
+						"%s.%s",
+						mastersTableName,
+						shopNameColumnName,
+					): "%" + strings.ToLower(*filters.Name) + "%",
+				},
+			)
+	}
+
+	if filters != nil && len(filters.Statuses) > 0 {
+		builder = builder.
+			Where(
+				sq.Eq{
+					fmt.Sprintf(
+						"%s.%s",
+						mastersTableName,
+						masterStatusColumnName,
+					): filters.Statuses,
+				},
+			)
+	}
+
+	if filters != nil && filters.IDAfter != nil {
+		builder = builder.Where(sq.Gt{fmt.Sprintf("%s.%s", mastersTableName, idColumnName): *filters.IDAfter})
+	}
+
+	if filters != nil && filters.ExcludeBannedForReason != nil {
+		builder = builder.Where(sq.Expr("NOT ?", bannedForReasonCondition(*filters.ExcludeBannedForReason)))
+	}
+
+	if filters != nil && filters.City != nil && *filters.City != "" {
+		builder = builder.
+			Where(
+				sq.Eq{
+					fmt.Sprintf(
+						"LOWER(%s.%s)",
+						mastersTableName,
+						cityColumnName,
+					): strings.ToLower(*filters.City),
+				},
+			)
+	}
+
+	return builder
+}
+
+// bannedForReasonCondition matches banned Masters, whose latest status change has provided reason.
+func bannedForReasonCondition(reason string) sq.Sqlizer {
+	return sq.Expr(
+		fmt.Sprintf(
+			"(%s.%s = ? AND COALESCE((SELECT %s FROM %s WHERE %s.%s = %s.%s "+
+				"ORDER BY %s.%s DESC, %s.%s DESC LIMIT 1), '') = ?)",
+			mastersTableName,
+			masterStatusColumnName,
+			reasonColumnName,
+			statusHistoryTableName,
+			statusHistoryTableName,
+			masterIDColumnName,
+			mastersTableName,
+			idColumnName,
+			statusHistoryTableName,
+			createdAtColumnName,
+			statusHistoryTableName,
+			idColumnName,
+		),
+		entities.MasterStatusBanned,
+		reason,
+	)
 }
@@ -22,6 +22,7 @@ import (
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/repositories"
 	"github.com/DKhorkov/libs/pointers"
 )
@@ -101,7 +102,7 @@ func (s *MastersRepositoryTestSuite) TestGetMastersWithExisting() {
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(1)
+		Times(5) // Основной + 2x(getMasterSocialLinks + getMasterShipsTo)
 
 	createdAt := time.Now().UTC()
 	info1 := pointers.New("Master Info 1")
@@ -179,7 +180,7 @@ func (s *MastersRepositoryTestSuite) TestGetMastersWithExistingMastersAndFilters
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(1)
+		Times(5) // Основной + 2x(getMasterSocialLinks + getMasterShipsTo)
 
 	createdAt := time.Now().UTC()
 	info1 := pointers.New("Master Info 1")
@@ -272,7 +273,7 @@ func (s *MastersRepositoryTestSuite) TestGetMasterByIDExisting() {
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(1)
+		Times(3) // Основной + getMasterSocialLinks + getMasterShipsTo
 
 	createdAt := time.Now().UTC()
 	info := pointers.New("Test Master Info")
@@ -303,6 +304,7 @@ func (s *MastersRepositoryTestSuite) TestGetMasterByIDNonExisting() {
 
 	master, err := s.mastersRepository.GetMasterByID(s.ctx, 999)
 	s.Error(err)
+	s.IsType(&customerrors.MasterNotFoundError{}, err)
 	s.Nil(master)
 }
 
@@ -311,7 +313,7 @@ func (s *MastersRepositoryTestSuite) TestGetMasterByUserIDExisting() {
 		EXPECT().
 		Span(gomock.Any(), gomock.Any()).
 		Return(context.Background(), mocktracing.NewMockSpan()).
-		Times(1)
+		Times(3) // Основной + getMasterSocialLinks + getMasterShipsTo
 
 	createdAt := time.Now().UTC()
 	info := pointers.New("Test Master Info")
@@ -344,6 +346,7 @@ func (s *MastersRepositoryTestSuite) TestGetMasterByUserIDNonExisting() {
 
 	master, err := s.mastersRepository.GetMasterByUserID(s.ctx, 999)
 	s.Error(err)
+	s.IsType(&customerrors.MasterNotFoundError{}, err)
 	s.Nil(master)
 }
 
@@ -374,6 +377,11 @@ func (s *MastersRepositoryTestSuite) TestUpdateMasterSuccess() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -414,6 +422,11 @@ func (s *MastersRepositoryTestSuite) TestUpdateMasterNullInfo() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -423,9 +436,11 @@ func (s *MastersRepositoryTestSuite) TestUpdateMasterNullInfo() {
 	)
 	s.NoError(err)
 
+	// Info is cleared only if it is listed in mask, because not provided fields are left untouched without mask:
 	masterData := entities.UpdateMasterDTO{
-		ID:   1,
-		Info: nil,
+		ID:         1,
+		Info:       nil,
+		UpdateMask: []string{entities.MasterFieldInfo},
 	}
 
 	err = s.mastersRepository.UpdateMaster(s.ctx, masterData)
@@ -444,3 +459,636 @@ func (s *MastersRepositoryTestSuite) TestUpdateMasterNullInfo() {
 	s.NoError(rows.Scan(&infoVal))
 	s.False(infoVal.Valid)
 }
+
+func (s *MastersRepositoryTestSuite) TestGetFollowedMastersWithExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getMasterSocialLinks + getMasterShipsTo
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt,
+		2, 2, "Master Info 2", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO follows (id, user_id, master_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 3, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	masters, err := s.mastersRepository.GetFollowedMasters(s.ctx, 3, nil)
+	s.NoError(err)
+	s.Len(masters, 1)
+	s.Equal(uint64(2), masters[0].ID)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetFollowedMastersWithoutExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	masters, err := s.mastersRepository.GetFollowedMasters(s.ctx, 3, nil)
+	s.NoError(err)
+	s.Empty(masters)
+}
+
+func (s *MastersRepositoryTestSuite) TestCountFollowedMasters() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO follows (id, user_id, master_id, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 3, 1, createdAt, createdAt,
+		2, 3, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	count, err := s.mastersRepository.CountFollowedMasters(s.ctx, 3)
+	s.NoError(err)
+	s.Equal(uint64(2), count)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetFollowExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO follows (id, user_id, master_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 3, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	follow, err := s.mastersRepository.GetFollow(s.ctx, 3, 1)
+	s.NoError(err)
+	s.NotNil(follow)
+	s.Equal(uint64(1), follow.ID)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetFollowNonExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	follow, err := s.mastersRepository.GetFollow(s.ctx, 3, 1)
+	s.Error(err)
+	s.IsType(&customerrors.FollowNotFoundError{}, err)
+	s.Nil(follow)
+}
+
+func (s *MastersRepositoryTestSuite) TestFollowMasterAlreadyExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, followers_count) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info", createdAt, createdAt, 1,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO follows (id, user_id, master_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 3, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Conflict is resolved by insert itself, so followers count is not incremented for existing Follow:
+	followID, err := s.mastersRepository.FollowMaster(s.ctx, 3, 1)
+	s.IsType(&customerrors.FollowAlreadyExistsError{}, err)
+	s.Zero(followID)
+
+	var followersCount uint64
+	err = s.connection.QueryRowContext(s.ctx, "SELECT followers_count FROM masters WHERE id = ?", 1).Scan(&followersCount)
+	s.NoError(err)
+	s.Equal(uint64(1), followersCount)
+}
+
+func (s *MastersRepositoryTestSuite) TestUnfollowMasterSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, followers_count) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info", createdAt, createdAt, 1,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO follows (id, user_id, master_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 3, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.mastersRepository.UnfollowMaster(s.ctx, 3, 1)
+	s.NoError(err)
+
+	var followersCount uint64
+	err = s.connection.QueryRowContext(s.ctx, "SELECT followers_count FROM masters WHERE id = ?", 1).Scan(&followersCount)
+	s.NoError(err)
+	s.Zero(followersCount)
+}
+
+func (s *MastersRepositoryTestSuite) TestUpdateMasterProfile() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(4) // UpdateMaster + GetMasterBySlug + getMasterSocialLinks + getMasterShipsTo
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 100, "Old Info", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters_social_links (id, master_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "https://old.example.com", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	masterData := entities.UpdateMasterDTO{
+		ID:          1,
+		Info:        pointers.New("Updated Master Info"),
+		ShopName:    pointers.New("Вязаные игрушки"),
+		Slug:        pointers.New("knitted-toys"),
+		Avatar:      pointers.New("https://example.com/avatar.png"),
+		Banner:      pointers.New("https://example.com/banner.png"),
+		City:        pointers.New("Москва"),
+		Region:      pointers.New("Московская область"),
+		Policies:    pointers.New("Возврат в течение недели"),
+		SocialLinks: []string{"https://vk.com/knitted-toys", "https://t.me/knitted-toys"},
+		ShipsTo:     []string{"Москва", "Санкт-Петербург"},
+	}
+
+	err = s.mastersRepository.UpdateMaster(s.ctx, masterData)
+	s.NoError(err)
+
+	master, err := s.mastersRepository.GetMasterBySlug(s.ctx, "knitted-toys")
+	s.NoError(err)
+	s.NotNil(master)
+	s.Equal(uint64(1), master.ID)
+	s.Equal(masterData.ShopName, master.ShopName)
+	s.Equal(masterData.Avatar, master.Avatar)
+	s.Equal(masterData.Banner, master.Banner)
+	s.Equal(masterData.City, master.City)
+	s.Equal(masterData.Region, master.Region)
+	s.Equal(masterData.Policies, master.Policies)
+	s.ElementsMatch(masterData.SocialLinks, master.SocialLinks)
+	s.ElementsMatch(masterData.ShipsTo, master.ShipsTo)
+}
+
+func (s *MastersRepositoryTestSuite) TestUpdateMasterWithUpdateMask() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(4) // UpdateMaster + GetMasterByID + getMasterSocialLinks + getMasterShipsTo
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, city, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 100, "Old Info", "Москва", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters_social_links (id, master_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "https://old.example.com", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// City is cleared, because it is listed in mask, but not provided. Info and social links are untouched:
+	masterData := entities.UpdateMasterDTO{
+		ID:         1,
+		ShopName:   pointers.New("Вязаные игрушки"),
+		Info:       pointers.New("Ignored Info"),
+		ShipsTo:    []string{"Москва"},
+		UpdateMask: []string{entities.MasterFieldShopName, entities.MasterFieldCity, entities.MasterFieldShipsTo},
+	}
+
+	err = s.mastersRepository.UpdateMaster(s.ctx, masterData)
+	s.NoError(err)
+
+	master, err := s.mastersRepository.GetMasterByID(s.ctx, 1)
+	s.NoError(err)
+	s.NotNil(master)
+	s.Equal(pointers.New("Old Info"), master.Info)
+	s.Equal(masterData.ShopName, master.ShopName)
+	s.Nil(master.City)
+	s.Equal([]string{"https://old.example.com"}, master.SocialLinks)
+	s.Equal(masterData.ShipsTo, master.ShipsTo)
+}
+
+func (s *MastersRepositoryTestSuite) TestUpdateMasterWithoutUpdateMask() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(4) // UpdateMaster + GetMasterByID + getMasterSocialLinks + getMasterShipsTo
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, shop_name, slug, city, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 100, "Old Info", "Вязаные игрушки", "knitted-toys", "Москва", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters_social_links (id, master_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "https://old.example.com", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters_ships_to (id, master_id, region, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "Москва", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Only provided fields are changed, so callers, which know only about info, don't clear the rest of profile:
+	masterData := entities.UpdateMasterDTO{
+		ID:   1,
+		Info: pointers.New("Updated Master Info"),
+	}
+
+	err = s.mastersRepository.UpdateMaster(s.ctx, masterData)
+	s.NoError(err)
+
+	master, err := s.mastersRepository.GetMasterByID(s.ctx, 1)
+	s.NoError(err)
+	s.NotNil(master)
+	s.Equal(masterData.Info, master.Info)
+	s.Equal(pointers.New("Вязаные игрушки"), master.ShopName)
+	s.Equal(pointers.New("knitted-toys"), master.Slug)
+	s.Equal(pointers.New("Москва"), master.City)
+	s.Equal([]string{"https://old.example.com"}, master.SocialLinks)
+	s.Equal([]string{"Москва"}, master.ShipsTo)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetMasterBySlugNonExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	master, err := s.mastersRepository.GetMasterBySlug(s.ctx, "non-existing")
+	s.Error(err)
+	s.IsType(&customerrors.MasterNotFoundError{}, err)
+	s.Nil(master)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetMastersWithCityFilter() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getMasterSocialLinks + getMasterShipsTo
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, city) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt, "Moscow",
+		2, 2, "Master Info 2", createdAt, createdAt, "Kazan",
+	)
+	s.NoError(err)
+
+	// Latin city is used, because SQLite LOWER function folds only ASCII symbols:
+	masters, err := s.mastersRepository.GetMasters(
+		s.ctx,
+		nil,
+		&entities.MastersFilters{City: pointers.New("KAZAN")},
+	)
+	s.NoError(err)
+	s.Len(masters, 1)
+	s.Equal(uint64(2), masters[0].ID)
+}
+
+func (s *MastersRepositoryTestSuite) TestSetVacationMode() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(5) // 2x SetVacationMode + GetMasterByID + getMasterSocialLinks + getMasterShipsTo
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "Master Info", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	until := createdAt.Add(24 * time.Hour)
+	err = s.mastersRepository.SetVacationMode(
+		s.ctx,
+		entities.SetVacationModeDTO{
+			MasterID: 1,
+			Until:    &until,
+			Message:  pointers.New[string]("В отпуске"),
+		},
+	)
+	s.NoError(err)
+
+	master, err := s.mastersRepository.GetMasterByID(s.ctx, 1)
+	s.NoError(err)
+	s.NotNil(master.VacationUntil)
+	s.Equal(until.Unix(), master.VacationUntil.Unix())
+	s.Equal(pointers.New[string]("В отпуске"), master.VacationMessage)
+
+	var available bool
+	err = s.connection.QueryRowContext(s.ctx, "SELECT available FROM toys WHERE id = ?", 1).Scan(&available)
+	s.NoError(err)
+	s.False(available)
+
+	// Toys of other Masters are not affected:
+	err = s.connection.QueryRowContext(s.ctx, "SELECT available FROM toys WHERE id = ?", 2).Scan(&available)
+	s.NoError(err)
+	s.True(available)
+
+	err = s.mastersRepository.SetVacationMode(s.ctx, entities.SetVacationModeDTO{MasterID: 1})
+	s.NoError(err)
+
+	err = s.connection.QueryRowContext(s.ctx, "SELECT available FROM toys WHERE id = ?", 1).Scan(&available)
+	s.NoError(err)
+	s.True(available)
+}
+
+func (s *MastersRepositoryTestSuite) TestEndExpiredVacations() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	now := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, vacation_until, vacation_message) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info", now, now, now.Add(-time.Hour), "В отпуске",
+		2, 2, "Master Info", now, now, now.Add(time.Hour), "В отпуске",
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, available) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, now, now, false,
+		2, 2, 2, "Toy 2", "Desc 2", 49.99, 3, now, now, false,
+	)
+	s.NoError(err)
+
+	ended, err := s.mastersRepository.EndExpiredVacations(s.ctx, now)
+	s.NoError(err)
+	s.Equal(uint64(1), ended)
+
+	var available bool
+	err = s.connection.QueryRowContext(s.ctx, "SELECT available FROM toys WHERE id = ?", 1).Scan(&available)
+	s.NoError(err)
+	s.True(available)
+
+	err = s.connection.QueryRowContext(s.ctx, "SELECT available FROM toys WHERE id = ?", 2).Scan(&available)
+	s.NoError(err)
+	s.False(available)
+
+	var vacationUntil *time.Time
+	err = s.connection.QueryRowContext(s.ctx, "SELECT vacation_until FROM masters WHERE id = ?", 1).Scan(&vacationUntil)
+	s.NoError(err)
+	s.Nil(vacationUntil)
+}
+
+func (s *MastersRepositoryTestSuite) TestChangeMasterStatus() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // 2x ChangeMasterStatus + GetMasterStatusHistory
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "Master Info", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.mastersRepository.ChangeMasterStatus(
+		s.ctx,
+		entities.ChangeMasterStatusDTO{
+			MasterID: 1,
+			Status:   entities.MasterStatusVerified,
+			Reason:   "Проверка пройдена",
+		},
+	)
+	s.NoError(err)
+
+	err = s.mastersRepository.ChangeMasterStatus(
+		s.ctx,
+		entities.ChangeMasterStatusDTO{
+			MasterID: 1,
+			Status:   entities.MasterStatusSuspended,
+			Reason:   "Нарушение правил площадки",
+		},
+	)
+	s.NoError(err)
+
+	var status entities.MasterStatus
+	err = s.connection.QueryRowContext(s.ctx, "SELECT status FROM masters WHERE id = ?", 1).Scan(&status)
+	s.NoError(err)
+	s.Equal(entities.MasterStatusSuspended, status)
+
+	// SQLite does not generate values for SERIAL columns, so IDs are filled manually:
+	_, err = s.connection.ExecContext(s.ctx, "UPDATE masters_status_history SET id = rowid")
+	s.NoError(err)
+
+	history, err := s.mastersRepository.GetMasterStatusHistory(s.ctx, 1)
+	s.NoError(err)
+	s.Len(history, 2)
+	s.Equal(entities.MasterStatusVerified, history[0].PreviousStatus)
+	s.Equal(entities.MasterStatusSuspended, history[0].Status)
+	s.Equal("Нарушение правил площадки", history[0].Reason)
+	s.Equal(entities.MasterStatusPending, history[1].PreviousStatus)
+	s.Equal(entities.MasterStatusVerified, history[1].Status)
+}
+
+func (s *MastersRepositoryTestSuite) TestChangeMasterStatusNonExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	err := s.mastersRepository.ChangeMasterStatus(
+		s.ctx,
+		entities.ChangeMasterStatusDTO{
+			MasterID: 1,
+			Status:   entities.MasterStatusBanned,
+			Reason:   "Нарушение правил площадки",
+		},
+	)
+	s.Error(err)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetMastersWithStatusesFilter() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getMasterSocialLinks + getMasterShipsTo
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt, entities.MasterStatusVerified,
+		2, 2, "Master Info 2", createdAt, createdAt, entities.MasterStatusBanned,
+	)
+	s.NoError(err)
+
+	masters, err := s.mastersRepository.GetMasters(
+		s.ctx,
+		nil,
+		&entities.MastersFilters{Statuses: []entities.MasterStatus{entities.MasterStatusBanned}},
+	)
+	s.NoError(err)
+	s.Len(masters, 1)
+	s.Equal(uint64(2), masters[0].ID)
+	s.Equal(entities.MasterStatusBanned, masters[0].Status)
+}
+
+func (s *MastersRepositoryTestSuite) TestGetMastersWithIDAfterAndExcludedBanReason() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(5) // Основной + getMasterSocialLinks + getMasterShipsTo для двух Мастеров
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt, entities.MasterStatusVerified,
+		2, 2, "Master Info 2", createdAt, createdAt, entities.MasterStatusBanned,
+		3, 3, "Master Info 3", createdAt, createdAt, entities.MasterStatusBanned,
+		4, 4, "Master Info 4", createdAt.Add(-time.Hour), createdAt, entities.MasterStatusVerified,
+	)
+	s.NoError(err)
+
+	// Master with ID=2 was banned by moderator and then forgotten, Master with ID=3 is banned by moderator only:
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters_status_history (id, master_id, previous_status, status, reason, created_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 2, entities.MasterStatusVerified, entities.MasterStatusBanned, "Нарушение правил площадки", createdAt,
+		2, 2, entities.MasterStatusBanned, entities.MasterStatusBanned, "Пользователь удален в SSO", createdAt,
+		3, 3, entities.MasterStatusVerified, entities.MasterStatusBanned, "Нарушение правил площадки", createdAt,
+	)
+	s.NoError(err)
+
+	masters, err := s.mastersRepository.GetMasters(
+		s.ctx,
+		nil,
+		&entities.MastersFilters{
+			IDAfter:                pointers.New[uint64](1),
+			ExcludeBannedForReason: pointers.New("Пользователь удален в SSO"),
+		},
+	)
+	s.NoError(err)
+	s.Len(masters, 2)
+	s.Equal(uint64(3), masters[0].ID)
+	s.Equal(uint64(4), masters[1].ID)
+}
@@ -0,0 +1,617 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+	"github.com/DKhorkov/libs/tracing"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+)
+
+const (
+	reviewsTableName        = "reviews"
+	reviewsPhotosTableName  = "reviews_photos"
+	reviewIDColumnName      = "review_id"
+	reviewRatingColumnName  = "rating"
+	reviewTextColumnName    = "text"
+	photoLinkColumnName     = "link"
+	reviewsOnConflictSuffix = "ON CONFLICT (toy_id, user_id) DO NOTHING"
+)
+
+type ReviewsRepository struct {
+	dbConnector   db.Connector
+	logger        logging.Logger
+	traceProvider tracing.Provider
+	spanConfig    tracing.SpanConfig
+}
+
+func NewReviewsRepository(
+	dbConnector db.Connector,
+	logger logging.Logger,
+	traceProvider tracing.Provider,
+	spanConfig tracing.SpanConfig,
+) *ReviewsRepository {
+	return &ReviewsRepository{
+		dbConnector:   dbConnector,
+		logger:        logger,
+		traceProvider: traceProvider,
+		spanConfig:    spanConfig,
+	}
+}
+
+func (repo *ReviewsRepository) AddReview(
+	ctx context.Context,
+	reviewData entities.AddReviewDTO,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	// Concurrent adds of Review of the same Toy by the same User are resolved by unique constraint, so no row
+	// is returned for already existing Review instead of unique violation error:
+	stmt, params, err := sq.
+		Insert(reviewsTableName).
+		Columns(
+			toyIDColumnName,
+			userIDColumnName,
+			reviewRatingColumnName,
+			reviewTextColumnName,
+		).
+		Values(
+			reviewData.ToyID,
+			reviewData.UserID,
+			reviewData.Rating,
+			reviewData.Text,
+		).
+		Suffix(reviewsOnConflictSuffix + " " + returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var reviewID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&reviewID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &customerrors.ReviewAlreadyExistsError{}
+		}
+
+		return 0, err
+	}
+
+	if len(reviewData.Photos) > 0 {
+		builder := sq.Insert(reviewsPhotosTableName).
+			Columns(reviewIDColumnName, photoLinkColumnName)
+		for _, photo := range reviewData.Photos {
+			builder = builder.Values(reviewID, photo)
+		}
+
+		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+			return 0, err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = recalculateRatings(ctx, transaction, reviewData.ToyID); err != nil {
+		return 0, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, err
+	}
+
+	return reviewID, nil
+}
+
+func (repo *ReviewsRepository) GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(reviewsTableName).
+		Where(sq.Eq{idColumnName: id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	review := &entities.Review{}
+	columns := db.GetEntityColumns(review)
+	columns = columns[:len(columns)-1] // Not to paste Photos field to Scan function.
+
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.ReviewNotFoundError{}
+		}
+
+		return nil, err
+	}
+
+	photos, err := repo.getReviewPhotos(ctx, review.ID, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Photos = photos
+
+	return review, nil
+}
+
+func (repo *ReviewsRepository) GetToyReviews(
+	ctx context.Context,
+	toyID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Review, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(reviewsTableName).
+		Where(sq.Eq{toyIDColumnName: toyID}).
+		OrderBy(
+			fmt.Sprintf(
+				"%s.%s %s",
+				reviewsTableName,
+				createdAtColumnName,
+				desc,
+			),
+		).
+		PlaceholderFormat(sq.Dollar)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	return repo.selectReviews(ctx, builder, connection)
+}
+
+// GetUserReviews returns all Reviews, written by User.
+func (repo *ReviewsRepository) GetUserReviews(ctx context.Context, userID uint64) ([]entities.Review, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(reviewsTableName).
+		Where(sq.Eq{userIDColumnName: userID}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
+		PlaceholderFormat(sq.Dollar)
+
+	return repo.selectReviews(ctx, builder, connection)
+}
+
+func (repo *ReviewsRepository) CountToyReviews(ctx context.Context, toyID uint64) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(selectCount).
+		From(reviewsTableName).
+		Where(sq.Eq{toyIDColumnName: toyID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo *ReviewsRepository) UpdateReview(ctx context.Context, reviewData entities.UpdateReviewDTO) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	builder := sq.
+		Update(reviewsTableName).
+		Where(sq.Eq{idColumnName: reviewData.ID}).
+		Set(updatedAtColumnName, sq.Expr("CURRENT_TIMESTAMP")).
+		Suffix("RETURNING " + toyIDColumnName).
+		PlaceholderFormat(sq.Dollar) // pq postgres driver works only with $ placeholders
+
+	if reviewData.Rating != nil {
+		builder = builder.Set(reviewRatingColumnName, reviewData.Rating)
+	}
+
+	if reviewData.Text != nil {
+		builder = builder.Set(reviewTextColumnName, reviewData.Text)
+	}
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	var toyID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&toyID); err != nil {
+		return err
+	}
+
+	if len(reviewData.PhotosToAdd) > 0 {
+		builder := sq.Insert(reviewsPhotosTableName).
+			Columns(reviewIDColumnName, photoLinkColumnName)
+		for _, photo := range reviewData.PhotosToAdd {
+			builder = builder.Values(reviewData.ID, photo)
+		}
+
+		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	if len(reviewData.PhotoIDsToDelete) > 0 {
+		stmt, params, err = sq.
+			Delete(reviewsPhotosTableName).
+			Where(sq.Eq{idColumnName: reviewData.PhotoIDsToDelete}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	if reviewData.Rating != nil {
+		if err = recalculateRatings(ctx, transaction, toyID); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+func (repo *ReviewsRepository) DeleteReview(ctx context.Context, id uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Delete(reviewsTableName).
+		Where(sq.Eq{idColumnName: id}).
+		Suffix("RETURNING " + toyIDColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var toyID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&toyID); err != nil {
+		return err
+	}
+
+	if err = recalculateRatings(ctx, transaction, toyID); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// selectReviews executes provided Reviews select query and loads Photos for each found Review.
+func (repo *ReviewsRepository) selectReviews(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+	connection transactions.Executor,
+) ([]entities.Review, error) {
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var reviews []entities.Review
+
+	for rows.Next() {
+		review := entities.Review{}
+		columns := db.GetEntityColumns(&review) // Only pointer to use rows.Scan() successfully
+		columns = columns[:len(columns)-1]      // Not to paste Photos field to Scan function.
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Reading Photos for each Review in new circle due
+	// to next error: https://github.com/lib/pq/issues/635
+	// Using review index to avoid range iter semantics error, via using copied variable.
+	for i, review := range reviews {
+		photos, err := repo.getReviewPhotos(ctx, review.ID, connection)
+		if err != nil {
+			return nil, err
+		}
+
+		reviews[i].Photos = photos
+	}
+
+	return reviews, nil
+}
+
+func (repo *ReviewsRepository) getReviewPhotos(
+	ctx context.Context,
+	reviewID uint64,
+	connection transactions.Executor,
+) ([]entities.ReviewPhoto, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(reviewsPhotosTableName).
+		Where(sq.Eq{reviewIDColumnName: reviewID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
+
+	var photos []entities.ReviewPhoto
+
+	for rows.Next() {
+		var photo entities.ReviewPhoto
+		columns := db.GetEntityColumns(&photo) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		photos = append(photos, photo)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return photos, nil
+}
+
+// recalculateRatings updates denormalized average rating and reviews count
+// for Toy with provided ID and for Master, who owns this Toy.
+func recalculateRatings(ctx context.Context, transaction transactions.Executor, toyID uint64) error {
+	stmt, params, err := sq.
+		Update(toysTableName).
+		Set(
+			averageRatingColumnName,
+			sq.Expr(
+				fmt.Sprintf(
+					"COALESCE((SELECT AVG(%s) FROM %s WHERE %s = ?), 0)",
+					reviewRatingColumnName,
+					reviewsTableName,
+					toyIDColumnName,
+				),
+				toyID,
+			),
+		).
+		Set(
+			reviewsCountColumnName,
+			sq.Expr(
+				fmt.Sprintf(
+					"(SELECT COUNT(*) FROM %s WHERE %s = ?)",
+					reviewsTableName,
+					toyIDColumnName,
+				),
+				toyID,
+			),
+		).
+		Where(sq.Eq{idColumnName: toyID}).
+		Suffix("RETURNING " + masterIDColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var masterID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&masterID); err != nil {
+		return err
+	}
+
+	return recalculateMasterRating(ctx, transaction, masterID)
+}
+
+// recalculateMasterRating updates denormalized average rating and reviews count
+// for Master with provided ID, using Reviews of all Master's Toys.
+func recalculateMasterRating(ctx context.Context, transaction transactions.Executor, masterID uint64) error {
+	masterReviewsSubQuery := fmt.Sprintf(
+		"FROM %s JOIN %s ON %s.%s = %s.%s WHERE %s.%s = ?",
+		reviewsTableName,
+		toysTableName,
+		toysTableName,
+		idColumnName,
+		reviewsTableName,
+		toyIDColumnName,
+		toysTableName,
+		masterIDColumnName,
+	)
+
+	stmt, params, err := sq.
+		Update(mastersTableName).
+		Set(
+			averageRatingColumnName,
+			sq.Expr(
+				fmt.Sprintf(
+					"COALESCE((SELECT AVG(%s.%s) %s), 0)",
+					reviewsTableName,
+					reviewRatingColumnName,
+					masterReviewsSubQuery,
+				),
+				masterID,
+			),
+		).
+		Set(
+			reviewsCountColumnName,
+			sq.Expr("(SELECT COUNT(*) "+masterReviewsSubQuery+")", masterID),
+		).
+		Where(sq.Eq{idColumnName: masterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = transaction.ExecContext(ctx, stmt, params...)
+
+	return err
+}
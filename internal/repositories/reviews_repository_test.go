@@ -0,0 +1,414 @@
+//go:build integration
+
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+	"github.com/DKhorkov/libs/pointers"
+	"github.com/DKhorkov/libs/tracing"
+	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/repositories"
+)
+
+func TestReviewsRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ReviewsRepositoryTestSuite))
+}
+
+type ReviewsRepositoryTestSuite struct {
+	suite.Suite
+
+	cwd               string
+	ctx               context.Context
+	dbConnector       db.Connector
+	connection        *sql.Conn
+	reviewsRepository *repositories.ReviewsRepository
+	logger            *mocklogging.MockLogger
+	traceProvider     *mocktracing.MockProvider
+	spanConfig        tracing.SpanConfig
+}
+
+func (s *ReviewsRepositoryTestSuite) SetupSuite() {
+	s.NoError(goose.SetDialect(driver))
+
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.logger = mocklogging.NewMockLogger(ctrl)
+	dbConnector, err := db.New(dsn, driver, s.logger)
+	s.NoError(err)
+
+	cwd, err := os.Getwd()
+	s.NoError(err)
+
+	s.cwd = cwd
+	s.dbConnector = dbConnector
+	s.traceProvider = mocktracing.NewMockProvider(ctrl)
+	s.spanConfig = tracing.SpanConfig{}
+	s.reviewsRepository = repositories.NewReviewsRepository(s.dbConnector, s.logger, s.traceProvider, s.spanConfig)
+}
+
+func (s *ReviewsRepositoryTestSuite) SetupTest() {
+	s.NoError(
+		goose.Up(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+		),
+	)
+
+	connection, err := s.dbConnector.Connection(s.ctx)
+	s.NoError(err)
+
+	s.connection = connection
+
+	createdAt := time.Now().UTC()
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "test", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 1, 3, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+}
+
+func (s *ReviewsRepositoryTestSuite) TearDownTest() {
+	s.NoError(
+		goose.DownTo(
+			s.dbConnector.Pool(),
+			path.Dir(
+				path.Dir(s.cwd),
+			)+migrationsDir,
+			gooseZeroVersion,
+		),
+	)
+
+	s.NoError(s.connection.Close())
+}
+
+func (s *ReviewsRepositoryTestSuite) TearDownSuite() {
+	s.NoError(s.dbConnector.Close())
+}
+
+func (s *ReviewsRepositoryTestSuite) TestGetToyReviewsWithExistingReviews() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + 2x getReviewPhotos
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, text, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, "Отлично", createdAt, createdAt,
+		2, 1, 2, 4, nil, createdAt, createdAt,
+		3, 2, 1, 3, nil, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews_photos (id, review_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "photo1.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	reviews, err := s.reviewsRepository.GetToyReviews(s.ctx, 1, nil)
+	s.NoError(err)
+	s.Len(reviews, 2)
+
+	for _, review := range reviews {
+		if review.ID == 1 {
+			s.Len(review.Photos, 1)
+			s.Equal("Отлично", *review.Text)
+		}
+	}
+}
+
+func (s *ReviewsRepositoryTestSuite) TestGetToyReviewsWithPagination() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2) // Основной + getReviewPhotos
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, createdAt, createdAt,
+		2, 1, 2, 4, createdAt.Add(time.Hour), createdAt.Add(time.Hour),
+	)
+	s.NoError(err)
+
+	reviews, err := s.reviewsRepository.GetToyReviews(
+		s.ctx,
+		1,
+		&entities.Pagination{Limit: pointers.New[uint64](1)},
+	)
+	s.NoError(err)
+	s.Len(reviews, 1)
+	s.Equal(uint64(2), reviews[0].ID)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestGetUserReviews() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + 2x getReviewPhotos
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, text, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, "Отлично", createdAt, createdAt,
+		2, 1, 2, 4, nil, createdAt, createdAt,
+		3, 2, 1, 3, nil, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews_photos (id, review_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "photo1.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	reviews, err := s.reviewsRepository.GetUserReviews(s.ctx, 1)
+	s.NoError(err)
+	s.Len(reviews, 2)
+	s.Equal(uint64(1), reviews[0].ID)
+	s.Len(reviews[0].Photos, 1)
+	s.Equal(uint64(3), reviews[1].ID)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestCountToyReviews() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, createdAt, createdAt,
+		2, 2, 2, 4, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	count, err := s.reviewsRepository.CountToyReviews(s.ctx, 1)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestGetReviewByIDExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2) // Основной + getReviewPhotos
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	review, err := s.reviewsRepository.GetReviewByID(s.ctx, 1)
+	s.NoError(err)
+	s.NotNil(review)
+	s.Equal(uint32(5), review.Rating)
+	s.Nil(review.Text)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestAddReviewAlreadyExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Conflict is resolved by insert itself, so concurrent adds don't fail with unique violation:
+	reviewID, err := s.reviewsRepository.AddReview(
+		s.ctx,
+		entities.AddReviewDTO{ToyID: 1, UserID: 1, Rating: 3},
+	)
+	s.IsType(&customerrors.ReviewAlreadyExistsError{}, err)
+	s.Zero(reviewID)
+
+	var rating uint32
+	err = s.connection.QueryRowContext(s.ctx, "SELECT rating FROM reviews WHERE id = 1").Scan(&rating)
+	s.NoError(err)
+	s.Equal(uint32(5), rating)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestGetReviewByIDNonExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	review, err := s.reviewsRepository.GetReviewByID(s.ctx, 999)
+	s.Error(err)
+	s.IsType(&customerrors.ReviewNotFoundError{}, err)
+	s.Nil(review)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestUpdateReviewRecalculatesRatings() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, createdAt, createdAt,
+		2, 1, 2, 4, createdAt, createdAt,
+		3, 2, 1, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.reviewsRepository.UpdateReview(
+		s.ctx,
+		entities.UpdateReviewDTO{
+			ID:          2,
+			Rating:      pointers.New[uint32](3),
+			Text:        pointers.New("Хорошо"),
+			PhotosToAdd: []string{"photo1.jpg"},
+		},
+	)
+	s.NoError(err)
+
+	var (
+		toyAverageRating    float64
+		toyReviewsCount     uint64
+		masterAverageRating float64
+		masterReviewsCount  uint64
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT average_rating, reviews_count FROM toys WHERE id = ?",
+		1,
+	).Scan(&toyAverageRating, &toyReviewsCount)
+	s.NoError(err)
+	s.InDelta(4, toyAverageRating, 0.001)
+	s.Equal(uint64(2), toyReviewsCount)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT average_rating, reviews_count FROM masters WHERE id = ?",
+		1,
+	).Scan(&masterAverageRating, &masterReviewsCount)
+	s.NoError(err)
+	s.InDelta(10.0/3.0, masterAverageRating, 0.001)
+	s.Equal(uint64(3), masterReviewsCount)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestDeleteReviewRecalculatesRatings() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO reviews (id, toy_id, user_id, rating, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, 1, 5, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"UPDATE toys SET average_rating = ?, reviews_count = ? WHERE id = ?",
+		5, 1, 1,
+	)
+	s.NoError(err)
+
+	err = s.reviewsRepository.DeleteReview(s.ctx, 1)
+	s.NoError(err)
+
+	var (
+		toyAverageRating float64
+		toyReviewsCount  uint64
+	)
+
+	err = s.connection.QueryRowContext(
+		s.ctx,
+		"SELECT average_rating, reviews_count FROM toys WHERE id = ?",
+		1,
+	).Scan(&toyAverageRating, &toyReviewsCount)
+	s.NoError(err)
+	s.Zero(toyAverageRating)
+	s.Zero(toyReviewsCount)
+}
+
+func (s *ReviewsRepositoryTestSuite) TestDeleteReviewNonExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	err := s.reviewsRepository.DeleteReview(s.ctx, 999)
+	s.Error(err)
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"github.com/DKhorkov/hmtm-sso/api/protobuf/generated/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
 )
 
@@ -24,6 +27,10 @@ func (repo *SsoRepository) GetUserByID(ctx context.Context, id uint64) (*entitie
 			ID: id,
 		},
 	)
+	if status.Code(err) == codes.NotFound {
+		return nil, &customerrors.UserNotFoundError{}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +48,10 @@ func (repo *SsoRepository) GetUserByEmail(
 			Email: email,
 		},
 	)
+	if status.Code(err) == codes.NotFound {
+		return nil, &customerrors.UserNotFoundError{}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -8,10 +8,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/DKhorkov/hmtm-sso/api/protobuf/generated/go/sso"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	mockclients "github.com/DKhorkov/hmtm-toys/mocks/clients"
 )
 
@@ -28,6 +31,7 @@ func TestSsoRepository_GetUserByID(t *testing.T) {
 		setupMocks    func(ssoClient *mockclients.MockSsoClient)
 		expectedUser  *entities.User
 		errorExpected bool
+		err           error
 	}{
 		{
 			name: "success",
@@ -72,6 +76,22 @@ func TestSsoRepository_GetUserByID(t *testing.T) {
 			},
 			errorExpected: true,
 		},
+		{
+			name: "User not found",
+			id:   1,
+			setupMocks: func(ssoClient *mockclients.MockSsoClient) {
+				ssoClient.
+					EXPECT().
+					GetUser(
+						gomock.Any(),
+						&sso.GetUserIn{ID: 1},
+					).
+					Return(nil, status.Error(codes.NotFound, "user not found")).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.UserNotFoundError{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -84,6 +104,10 @@ func TestSsoRepository_GetUserByID(t *testing.T) {
 			if tc.errorExpected {
 				require.Error(t, err)
 				require.Nil(t, user)
+
+				if tc.err != nil {
+					require.IsType(t, tc.err, err)
+				}
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedUser, user)
@@ -105,6 +129,7 @@ func TestSsoRepository_GetUserByEmail(t *testing.T) {
 		setupMocks    func(ssoClient *mockclients.MockSsoClient)
 		expectedUser  *entities.User
 		errorExpected bool
+		err           error
 	}{
 		{
 			name:  "success",
@@ -149,6 +174,22 @@ func TestSsoRepository_GetUserByEmail(t *testing.T) {
 			},
 			errorExpected: true,
 		},
+		{
+			name:  "User not found",
+			email: "test@example.com",
+			setupMocks: func(ssoClient *mockclients.MockSsoClient) {
+				ssoClient.
+					EXPECT().
+					GetUserByEmail(
+						gomock.Any(),
+						&sso.GetUserByEmailIn{Email: "test@example.com"},
+					).
+					Return(nil, status.Error(codes.NotFound, "user not found")).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.UserNotFoundError{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -161,6 +202,10 @@ func TestSsoRepository_GetUserByEmail(t *testing.T) {
 			if tc.errorExpected {
 				require.Error(t, err)
 				require.Nil(t, user)
+
+				if tc.err != nil {
+					require.IsType(t, tc.err, err)
+				}
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedUser, user)
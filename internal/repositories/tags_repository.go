@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/db"
@@ -11,6 +13,8 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
 )
 
 const (
@@ -46,12 +50,12 @@ func (repo *TagsRepository) GetAllTags(ctx context.Context) ([]entities.Tag, err
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	stmt, params, err := sq.
 		Select(selectAllColumns).
@@ -111,12 +115,12 @@ func (repo *TagsRepository) GetTagByID(ctx context.Context, id uint32) (*entitie
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	stmt, params, err := sq.
 		Select(selectAllColumns).
@@ -132,6 +136,10 @@ func (repo *TagsRepository) GetTagByID(ctx context.Context, id uint32) (*entitie
 
 	columns := db.GetEntityColumns(tag)
 	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.TagNotFoundError{}
+		}
+
 		return nil, err
 	}
 
@@ -148,7 +156,7 @@ func (repo *TagsRepository) CreateTags(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	transaction, err := repo.dbConnector.Transaction(ctx)
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
 	if err != nil {
 		return nil, err
 	}
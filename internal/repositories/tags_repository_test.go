@@ -22,6 +22,7 @@ import (
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/repositories"
 )
 
@@ -170,6 +171,7 @@ func (s *TagsRepositoryTestSuite) TestGetTagByIDNonExisting() {
 
 	tag, err := s.tagsRepository.GetTagByID(s.ctx, 999)
 	s.Error(err)
+	s.IsType(&customerrors.TagNotFoundError{}, err)
 	s.Nil(tag)
 }
 
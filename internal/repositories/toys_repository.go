@@ -3,8 +3,11 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/DKhorkov/libs/db"
 	"github.com/DKhorkov/libs/logging"
@@ -13,6 +16,10 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
 )
 
 const (
@@ -21,21 +28,39 @@ const (
 	toysTableName                   = "toys"
 	toysAndTagsAssociationTableName = "toys_tags_associations"
 	toysAttachmentsTableName        = "toys_attachments"
+	priceHistoryTableName           = "toys_price_history"
 	idColumnName                    = "id"
 	categoryIDColumnName            = "category_id"
 	toyNameColumnName               = "name"
 	toyDescriptionColumnName        = "description"
 	toyPriceColumnName              = "price"
+	previousPriceColumnName         = "previous_price"
 	toyQuantityColumnName           = "quantity"
 	toyIDColumnName                 = "toy_id"
 	tagIDColumnName                 = "tag_id"
 	masterIDColumnName              = "master_id"
 	attachmentLinkColumnName        = "link"
 	returningIDSuffix               = "RETURNING id"
+	favouritesOnConflictSuffix      = "ON CONFLICT (user_id, toy_id) DO NOTHING"
+	feedToysAlias                   = "feed_toys"
 	createdAtColumnName             = "created_at"
 	updatedAtColumnName             = "updated_at"
+	favouritesTableName             = "favourites"
+	favouritesCountColumnName       = "favourites_count"
+	averageRatingColumnName         = "average_rating"
+	reviewsCountColumnName          = "reviews_count"
+	toyAvailableColumnName          = "available"
+	toyModerationStatusColumnName   = "moderation_status"
+	toyModerationFlagsColumnName    = "moderation_flags"
+	toyRejectionReasonColumnName    = "rejection_reason"
+	toyAppealColumnName             = "appeal"
+	averagePriceAlias               = "average_price"
 	desc                            = "DESC"
 	asc                             = "ASC"
+	statsDayAlias                   = "day"
+	statsBucketAlias                = "bucket"
+	statsTopToysLimit               = 5
+	statsPriceBucketsCount          = 5
 )
 
 type ToysRepository struct {
@@ -70,133 +95,94 @@ func (repo *ToysRepository) GetToys(
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.ReadConnection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	builder := sq.
 		Select(selectAllColumns).
 		From(toysTableName).
 		PlaceholderFormat(sq.Dollar)
 
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
+	builder = applyToysFilters(builder, filters)
+	builder = applyToysOrder(builder, filters)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
 	}
 
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
 
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
-		}
+	return repo.selectToys(ctx, builder, connection)
+}
 
-		builder = builder.Where(priceConditions)
-	}
+func (repo *ToysRepository) CountToys(ctx context.Context, filters *entities.ToysFilters) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
 
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.ReadConnection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return 0, err
 	}
 
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
+	defer release()
+
+	builder := sq.
+		Select(selectCount).
+		From(toysTableName).
+		PlaceholderFormat(sq.Dollar)
+
+	builder = applyToysFilters(builder, filters)
+
+	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return 0, err
 	}
 
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							toysAndTagsAssociationTableName,
-							toysAndTagsAssociationTableName,
-							toyIDColumnName,
-							toysTableName,
-							idColumnName,
-							toysAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
-		}
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
 	}
 
-	createdAtOrder := desc
-	if filters != nil && filters.CreatedAtOrderByAsc != nil && *filters.CreatedAtOrderByAsc {
-		createdAtOrder = asc
+	return count, nil
+}
+
+func (repo *ToysRepository) GetMasterToys(
+	ctx context.Context,
+	masterID uint64,
+	pagination *entities.Pagination,
+	filters *entities.ToysFilters,
+) ([]entities.Toy, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
 	}
 
-	builder = builder.
-		OrderBy(
-			fmt.Sprintf(
-				"%s.%s %s",
-				toysTableName,
-				createdAtColumnName,
-				createdAtOrder,
-			),
-		)
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(toysTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		PlaceholderFormat(sq.Dollar)
+
+	builder = applyToysFilters(builder, filters)
+	builder = applyToysOrder(builder, filters)
 
 	if pagination != nil && pagination.Limit != nil {
 		builder = builder.Limit(*pagination.Limit)
@@ -206,7 +192,112 @@ func (repo *ToysRepository) GetToys(
 		builder = builder.Offset(*pagination.Offset)
 	}
 
+	return repo.selectToys(ctx, builder, connection)
+}
+
+func (repo *ToysRepository) CountMasterToys(
+	ctx context.Context,
+	masterID uint64,
+	filters *entities.ToysFilters,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectCount).
+		From(toysTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		PlaceholderFormat(sq.Dollar)
+
+	builder = applyToysFilters(builder, filters)
+
+	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
 	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetAllMasterToys returns all Toys of Master regardless of their moderation status and visibility.
+func (repo *ToysRepository) GetAllMasterToys(ctx context.Context, masterID uint64) ([]entities.Toy, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(toysTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
+		PlaceholderFormat(sq.Dollar)
+
+	return repo.selectToys(ctx, builder, connection)
+}
+
+// GetMasterToysPriceHistory returns all price changes of all Toys of Master, starting from the newest one.
+func (repo *ToysRepository) GetMasterToysPriceHistory(
+	ctx context.Context,
+	masterID uint64,
+) ([]entities.ToyPriceChange, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(fmt.Sprintf("%s.%s", priceHistoryTableName, selectAllColumns)).
+		From(priceHistoryTableName).
+		InnerJoin(
+			fmt.Sprintf(
+				"%s ON %s.%s = %s.%s",
+				toysTableName,
+				toysTableName,
+				idColumnName,
+				priceHistoryTableName,
+				toyIDColumnName,
+			),
+		).
+		Where(sq.Eq{fmt.Sprintf("%s.%s", toysTableName, masterIDColumnName): masterID}).
+		OrderBy(
+			fmt.Sprintf("%s.%s %s", priceHistoryTableName, createdAtColumnName, desc),
+			fmt.Sprintf("%s.%s %s", priceHistoryTableName, idColumnName, desc),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -231,330 +322,1222 @@ func (repo *ToysRepository) GetToys(
 		}
 	}()
 
-	var toys []entities.Toy
+	var history []entities.ToyPriceChange
 
 	for rows.Next() {
-		toy := entities.Toy{}
-		columns := db.GetEntityColumns(&toy) // Only pointer to use rows.Scan() successfully
-		columns = columns[:len(columns)-2]   // Not to paste Tags and Attachments fields to Scan function.
+		priceChange := entities.ToyPriceChange{}
+		columns := db.GetEntityColumns(&priceChange) // Only pointer to use rows.Scan() successfully
 
-		err = rows.Scan(columns...)
-		if err != nil {
+		if err = rows.Scan(columns...); err != nil {
 			return nil, err
 		}
 
-		toys = append(toys, toy)
+		history = append(history, priceChange)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	// Reading Tags and Attachments for each Toy in new circle due
-	// to next error: https://github.com/lib/pq/issues/635
-	// Using toy index to avoid range iter semantics error, via using copied variable.
-	for i, toy := range toys {
-		tags, err := repo.getToyTags(ctx, toy.ID, connection)
-		if err != nil {
-			return nil, err
-		}
+	return history, nil
+}
 
-		toys[i].Tags = tags
+// GetToysBatch returns next batch of Toys with ID greater than afterID, selected by filters and, if provided,
+// owned by Master. Toys are ordered by ID, so sorting filters are ignored and batches can be read one by one
+// without offset.
+func (repo *ToysRepository) GetToysBatch(
+	ctx context.Context,
+	masterID *uint64,
+	filters *entities.ToysFilters,
+	afterID uint64,
+	limit uint64,
+) ([]entities.Toy, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
 
-		attachments, err := repo.getToyAttachments(ctx, toy.ID, connection)
-		if err != nil {
-			return nil, err
-		}
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-		toys[i].Attachments = attachments
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
 	}
 
-	return toys, nil
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(toysTableName).
+		Where(sq.Gt{fmt.Sprintf("%s.%s", toysTableName, idColumnName): afterID}).
+		OrderBy(fmt.Sprintf("%s.%s %s", toysTableName, idColumnName, asc)).
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	if masterID != nil {
+		builder = builder.Where(sq.Eq{fmt.Sprintf("%s.%s", toysTableName, masterIDColumnName): *masterID})
+	}
+
+	builder = applyToysFilters(builder, filters)
+
+	// Relations are loaded for whole batch, so batch costs the same count of queries regardless of its size:
+	return repo.selectToysBatched(ctx, builder, connection)
 }
 
-func (repo *ToysRepository) CountToys(ctx context.Context, filters *entities.ToysFilters) (uint64, error) {
+func (repo *ToysRepository) GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
-	builder := sq.
-		Select(selectCount).
+	// Toys of banned Masters and not approved Toys are hidden by ID in the same way as in lists, but owners
+	// and moderators can read Toys with other moderation statuses:
+	stmt, params, err := sq.
+		Select(selectAllColumns).
 		From(toysTableName).
-		PlaceholderFormat(sq.Dollar)
-
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
+		Where(sq.Eq{idColumnName: id}).
+		Where(visibleToysCondition()).
+		Where(sq.Eq{toyModerationStatusColumnName: visibility.ToyModerationStatusesFromContext(ctx)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
 	}
 
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
+	toy := &entities.Toy{}
+	columns := db.GetEntityColumns(toy)
+	columns = columns[:len(columns)-2] // Not to paste Tags and Attachments fields to Scan function.
 
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.ToyNotFoundError{}
 		}
 
-		builder = builder.Where(priceConditions)
-	}
-
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
+		return nil, err
 	}
 
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
-	}
+	relations := readmasks.ToyRelationsFromContext(ctx)
 
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							toysAndTagsAssociationTableName,
-							toysAndTagsAssociationTableName,
-							toyIDColumnName,
-							toysTableName,
-							idColumnName,
-							toysAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
+	if relations.Tags {
+		if toy.Tags, err = repo.getToyTags(ctx, toy.ID, connection); err != nil {
+			return nil, err
 		}
 	}
 
-	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
-	stmt, params, err := builder.ToSql()
-	if err != nil {
-		return 0, err
-	}
-
-	var count uint64
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
-		return 0, err
+	if relations.Attachments {
+		if toy.Attachments, err = repo.getToyAttachments(ctx, toy.ID, connection); err != nil {
+			return nil, err
+		}
 	}
 
-	return count, nil
+	return toy, nil
 }
 
-func (repo *ToysRepository) GetMasterToys(
-	ctx context.Context,
-	masterID uint64,
-	pagination *entities.Pagination,
-	filters *entities.ToysFilters,
-) ([]entities.Toy, error) {
+// GetToysByIDs returns found Toys with provided IDs ordered by ID. Toys of banned Masters are not found, and
+// only approved Toys are found, unless other moderation statuses are provided through context.
+// Tags and Attachments of all Toys are read by one query each, so count of queries doesn't depend on count of Toys.
+func (repo *ToysRepository) GetToysByIDs(ctx context.Context, ids []uint64) ([]entities.Toy, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
 	if err != nil {
 		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
+	defer release()
 
 	builder := sq.
 		Select(selectAllColumns).
 		From(toysTableName).
-		Where(sq.Eq{masterIDColumnName: masterID}).
+		Where(sq.Eq{idColumnName: ids}).
+		Where(visibleToysCondition()).
+		Where(sq.Eq{toyModerationStatusColumnName: visibility.ToyModerationStatusesFromContext(ctx)}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
 		PlaceholderFormat(sq.Dollar)
 
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
-	}
+	return repo.selectToysBatched(ctx, builder, connection)
+}
 
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
+func (repo *ToysRepository) AddToy(
+	ctx context.Context,
+	toyData entities.AddToyDTO,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
 
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceCeil,
-				},
-			)
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
 		}
+	}()
 
-		builder = builder.Where(priceConditions)
+	stmt, params, err := sq.
+		Insert(toysTableName).
+		Columns(
+			masterIDColumnName,
+			categoryIDColumnName,
+			toyNameColumnName,
+			toyDescriptionColumnName,
+			toyPriceColumnName,
+			toyQuantityColumnName,
+			toyModerationStatusColumnName,
+			toyModerationFlagsColumnName,
+			toyAvailableColumnName,
+		).
+		Values(
+			toyData.MasterID,
+			toyData.CategoryID,
+			toyData.Name,
+			toyData.Description,
+			toyData.Price,
+			toyData.Quantity,
+			toyData.ModerationStatus,
+			toyData.ModerationFlags,
+			// Toys, added by Master on vacation, are unavailable till vacation ends:
+			sq.Expr(
+				fmt.Sprintf(
+					"NOT EXISTS (SELECT 1 FROM %s WHERE %s = ? AND %s > ?)",
+					mastersTableName,
+					idColumnName,
+					vacationUntilColumnName,
+				),
+				toyData.MasterID,
+				time.Now().UTC(),
+			),
+		).
+		Suffix(returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
 	}
 
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
+	var toyID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&toyID); err != nil {
+		return 0, err
 	}
 
-	if filters != nil && filters.CategoryIDs != nil {
+	if err != nil {
+		return 0, err
+	}
+
+	if len(toyData.TagIDs) > 0 {
+		builder := sq.Insert(toysAndTagsAssociationTableName).
+			Columns(toyIDColumnName, tagIDColumnName)
+		for _, tagID := range toyData.TagIDs {
+			builder = builder.Values(toyID, tagID)
+		}
+
+		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+			return 0, err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(toyData.Attachments) > 0 {
+		builder := sq.Insert(toysAttachmentsTableName).
+			Columns(toyIDColumnName, attachmentLinkColumnName)
+		for _, attachment := range toyData.Attachments {
+			builder = builder.Values(toyID, attachment)
+		}
+
+		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+			return 0, err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return 0, err
+		}
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return toyID, nil
+}
+
+func (repo *ToysRepository) DeleteToy(ctx context.Context, id uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Delete(toysTableName).
+		Where(sq.Eq{idColumnName: id}).
+		Suffix("RETURNING " + masterIDColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var masterID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&masterID); err != nil {
+		return err
+	}
+
+	// Toy Reviews are deleted by cascade, so Master rating should be recalculated:
+	if err = recalculateMasterRating(ctx, transaction, masterID); err != nil {
+		return err
+	}
+
+	return transaction.Commit()
+}
+
+// DeleteMasterToys deletes all Toys of Master and returns IDs of deleted Toys.
+func (repo *ToysRepository) DeleteMasterToys(ctx context.Context, masterID uint64) ([]uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Delete(toysTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		Suffix("RETURNING " + idColumnName).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := transaction.QueryContext(ctx, stmt, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "error during closing SQL rows", err)
+		}
+	}()
+
+	var toysIDs []uint64
+
+	for rows.Next() {
+		var toyID uint64
+		if err = rows.Scan(&toyID); err != nil {
+			return nil, err
+		}
+
+		toysIDs = append(toysIDs, toyID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Toys Reviews are deleted by cascade, so Master rating should be recalculated:
+	if err = recalculateMasterRating(ctx, transaction, masterID); err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toysIDs, nil
+}
+
+func (repo *ToysRepository) UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	builder := sq.
+		Update(toysTableName).
+		Where(sq.Eq{idColumnName: toyData.ID}).
+		PlaceholderFormat(sq.Dollar) // pq postgres driver works only with $ placeholders
+
+	if toyData.CategoryID != nil {
+		builder = builder.Set(categoryIDColumnName, toyData.CategoryID)
+	}
+
+	if toyData.Name != nil {
+		builder = builder.Set(toyNameColumnName, toyData.Name)
+	}
+
+	if toyData.Description != nil {
+		builder = builder.Set(toyDescriptionColumnName, toyData.Description)
+	}
+
+	if toyData.Price != nil {
+		builder = builder.Set(toyPriceColumnName, toyData.Price)
+
+		if err = repo.saveToyPriceChange(ctx, transaction, toyData.ID, *toyData.Price); err != nil {
+			return err
+		}
+	}
+
+	if toyData.Quantity != nil {
+		builder = builder.Set(toyQuantityColumnName, toyData.Quantity)
+	}
+
+	if toyData.ModerationStatus != nil {
 		builder = builder.
+			Set(toyModerationStatusColumnName, toyData.ModerationStatus).
+			Set(toyModerationFlagsColumnName, toyData.ModerationFlags)
+	}
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return err
+	}
+
+	if len(toyData.TagIDsToAdd) > 0 {
+		builder := sq.Insert(toysAndTagsAssociationTableName).
+			Columns(toyIDColumnName, tagIDColumnName)
+		for _, tagID := range toyData.TagIDsToAdd {
+			builder = builder.Values(toyData.ID, tagID)
+		}
+
+		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	if len(toyData.TagIDsToDelete) > 0 {
+		stmt, params, err = sq.
+			Delete(toysAndTagsAssociationTableName).
 			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
+				sq.And{
+					sq.Eq{toyIDColumnName: toyData.ID},
+					sq.Eq{tagIDColumnName: toyData.TagIDsToDelete},
 				},
-			)
+			).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	if len(toyData.AttachmentsToAdd) > 0 {
+		builder := sq.Insert(toysAttachmentsTableName).
+			Columns(toyIDColumnName, attachmentLinkColumnName)
+		for _, attachment := range toyData.AttachmentsToAdd {
+			builder = builder.Values(toyData.ID, attachment)
+		}
+
+		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
 	}
 
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							toysAndTagsAssociationTableName,
-							toysAndTagsAssociationTableName,
-							toyIDColumnName,
-							toysTableName,
-							idColumnName,
-							toysAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
+	if len(toyData.AttachmentIDsToDelete) > 0 {
+		stmt, params, err = sq.
+			Delete(toysAttachmentsTableName).
+			Where(sq.Eq{idColumnName: toyData.AttachmentIDsToDelete}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+func (repo *ToysRepository) GetUserFavourites(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+	filters *entities.ToysFilters,
+) ([]entities.Toy, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectAllColumns).
+		From(toysTableName).
+		Where(userFavouritesCondition(userID)).
+		PlaceholderFormat(sq.Dollar)
+
+	builder = applyToysFilters(builder, filters)
+	builder = applyToysOrder(builder, filters)
+
+	if pagination != nil && pagination.Limit != nil {
+		builder = builder.Limit(*pagination.Limit)
+	}
+
+	if pagination != nil && pagination.Offset != nil {
+		builder = builder.Offset(*pagination.Offset)
+	}
+
+	return repo.selectToys(ctx, builder, connection)
+}
+
+func (repo *ToysRepository) CountUserFavourites(
+	ctx context.Context,
+	userID uint64,
+	filters *entities.ToysFilters,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	defer release()
+
+	builder := sq.
+		Select(selectCount).
+		From(toysTableName).
+		Where(userFavouritesCondition(userID)).
+		PlaceholderFormat(sq.Dollar)
+
+	builder = applyToysFilters(builder, filters)
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo *ToysRepository) GetFavourite(
+	ctx context.Context,
+	userID uint64,
+	toyID uint64,
+) (*entities.Favourite, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(favouritesTableName).
+		Where(
+			sq.And{
+				sq.Eq{userIDColumnName: userID},
+				sq.Eq{toyIDColumnName: toyID},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	favourite := &entities.Favourite{}
+	columns := db.GetEntityColumns(favourite)
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &customerrors.FavouriteNotFoundError{}
+		}
+
+		return nil, err
+	}
+
+	return favourite, nil
+}
+
+// GetUserFavouritesRecords returns all Favourites of User, including Favourites of hidden Toys.
+func (repo *ToysRepository) GetUserFavouritesRecords(
+	ctx context.Context,
+	userID uint64,
+) ([]entities.Favourite, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(favouritesTableName).
+		Where(sq.Eq{userIDColumnName: userID}).
+		OrderBy(fmt.Sprintf("%s %s", idColumnName, asc)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := connection.QueryContext(ctx, stmt, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "error during closing SQL rows", err)
+		}
+	}()
+
+	var favourites []entities.Favourite
+
+	for rows.Next() {
+		favourite := entities.Favourite{}
+		columns := db.GetEntityColumns(&favourite) // Only pointer to use rows.Scan() successfully
+
+		if err = rows.Scan(columns...); err != nil {
+			return nil, err
+		}
+
+		favourites = append(favourites, favourite)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return favourites, nil
+}
+
+func (repo *ToysRepository) AddFavourite(
+	ctx context.Context,
+	userID uint64,
+	toyID uint64,
+) (uint64, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return 0, err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	// Concurrent adds of the same Favourite are resolved by unique constraint, so no row is returned for
+	// already existing Favourite instead of unique violation error:
+	stmt, params, err := sq.
+		Insert(favouritesTableName).
+		Columns(userIDColumnName, toyIDColumnName).
+		Values(userID, toyID).
+		Suffix(favouritesOnConflictSuffix + " " + returningIDSuffix).
+		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var favouriteID uint64
+	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&favouriteID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &customerrors.FavouriteAlreadyExistsError{}
+		}
+
+		return 0, err
+	}
+
+	stmt, params, err = sq.
+		Update(toysTableName).
+		Set(favouritesCountColumnName, sq.Expr(favouritesCountColumnName+" + 1")).
+		Where(sq.Eq{idColumnName: toyID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+		return 0, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, err
+	}
+
+	return favouriteID, nil
+}
+
+func (repo *ToysRepository) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	transaction, err := transactions.Begin(ctx, repo.dbConnector)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil {
+			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	stmt, params, err := sq.
+		Delete(favouritesTableName).
+		Where(
+			sq.And{
+				sq.Eq{userIDColumnName: userID},
+				sq.Eq{toyIDColumnName: toyID},
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := transaction.ExecContext(ctx, stmt, params...)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// Counter is decremented only if Favourite really existed to keep it consistent:
+	if deleted > 0 {
+		stmt, params, err = sq.
+			Update(toysTableName).
+			Set(favouritesCountColumnName, sq.Expr(favouritesCountColumnName+" - ?", deleted)).
+			Where(sq.Eq{idColumnName: toyID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Commit()
+}
+
+func (repo *ToysRepository) GetFeed(
+	ctx context.Context,
+	userID uint64,
+	cursor *entities.FeedCursor,
+	limit uint64,
+) ([]entities.Toy, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	// Toys of each followed Master are read by lateral subquery via (master_id, created_at DESC, id DESC) index,
+	// so at most limit Toys per Master are read and merged instead of sorting all Toys of followed Masters:
+	masterToys := sq.
+		Select(selectAllColumns).
+		From(toysTableName).
+		Where(
+			sq.Expr(
+				fmt.Sprintf(
+					"%s.%s = %s.%s",
+					toysTableName,
+					masterIDColumnName,
+					followsTableName,
+					masterIDColumnName,
+				),
+			),
+		).
+		Where(visibleToysCondition()).
+		Where(
+			sq.Eq{
+				fmt.Sprintf(
+					"%s.%s",
+					toysTableName,
+					toyModerationStatusColumnName,
+				): entities.ToyModerationStatusApproved,
+			},
+		).
+		OrderBy(
+			fmt.Sprintf("%s.%s %s", toysTableName, createdAtColumnName, desc),
+			fmt.Sprintf("%s.%s %s", toysTableName, idColumnName, desc),
+		).
+		Limit(limit).
+		Prefix("CROSS JOIN LATERAL (").
+		Suffix(") AS " + feedToysAlias)
+
+	// Keyset pagination is used instead of offset to keep Feed stable, when new Toys are published:
+	if cursor != nil {
+		masterToys = masterToys.Where(
+			sq.Expr(
+				fmt.Sprintf(
+					"(%s.%s, %s.%s) < (?, ?)",
+					toysTableName,
+					createdAtColumnName,
+					toysTableName,
+					idColumnName,
+				),
+				cursor.CreatedAt,
+				cursor.ToyID,
+			),
+		)
+	}
+
+	builder := sq.
+		Select(feedToysAlias+"."+selectAllColumns).
+		From(followsTableName).
+		JoinClause(masterToys).
+		Where(sq.Eq{fmt.Sprintf("%s.%s", followsTableName, userIDColumnName): userID}).
+		OrderBy(
+			fmt.Sprintf("%s.%s %s", feedToysAlias, createdAtColumnName, desc),
+			fmt.Sprintf("%s.%s %s", feedToysAlias, idColumnName, desc),
+		).
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar)
+
+	return repo.selectToys(ctx, builder, connection)
+}
+
+// GetMasterToysStats returns aggregated statistics of Toys of Master with provided ID. Only new Toys per day
+// are calculated for provided period, other statistics are calculated for all current Toys of Master.
+func (repo *ToysRepository) GetMasterToysStats(
+	ctx context.Context,
+	masterID uint64,
+	period entities.StatsPeriod,
+) (*entities.MasterStats, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stats := &entities.MasterStats{MasterID: masterID}
+
+	var minPrice, maxPrice float64
+	stmt, params, err := sq.
+		Select(
+			selectCount,
+			fmt.Sprintf(
+				"COALESCE(SUM(CASE WHEN %s > 0 THEN 1 ELSE 0 END), 0)",
+				toyQuantityColumnName,
+			),
+			fmt.Sprintf("COALESCE(SUM(%s), 0)", toyQuantityColumnName),
+			fmt.Sprintf("COALESCE(SUM(%s * %s), 0)", toyPriceColumnName, toyQuantityColumnName),
+			fmt.Sprintf("COALESCE(MIN(%s), 0)", toyPriceColumnName),
+			fmt.Sprintf("COALESCE(MAX(%s), 0)", toyPriceColumnName),
+		).
+		From(toysTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	err = connection.QueryRowContext(ctx, stmt, params...).Scan(
+		&stats.ToysCount,
+		&stats.InStockToysCount,
+		&stats.TotalStock,
+		&stats.StockValue,
+		&minPrice,
+		&maxPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.OutOfStockToysCount = stats.ToysCount - stats.InStockToysCount
+
+	if stats.NewToysPerDay, err = repo.getMasterNewToysPerDay(ctx, masterID, period, connection); err != nil {
+		return nil, err
+	}
+
+	// Nothing to rank and distribute, if Master has no Toys:
+	if stats.ToysCount == 0 {
+		return stats, nil
+	}
+
+	stats.MostFavouritedToys, err = repo.getMasterTopToys(
+		ctx,
+		masterID,
+		sq.Gt{favouritesCountColumnName: 0},
+		[]string{
+			fmt.Sprintf("%s %s", favouritesCountColumnName, desc),
+			fmt.Sprintf("%s %s", idColumnName, asc),
+		},
+		connection,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.HighestRatedToys, err = repo.getMasterTopToys(
+		ctx,
+		masterID,
+		sq.Gt{reviewsCountColumnName: 0},
+		[]string{
+			fmt.Sprintf("%s %s", averageRatingColumnName, desc),
+			fmt.Sprintf("%s %s", reviewsCountColumnName, desc),
+			fmt.Sprintf("%s %s", idColumnName, asc),
+		},
+		connection,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.PriceDistribution, err = repo.getMasterPriceDistribution(
+		ctx,
+		masterID,
+		minPrice,
+		maxPrice,
+		connection,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// UpdateToyModeration saves moderation decision or resubmission of Toy.
+func (repo *ToysRepository) UpdateToyModeration(ctx context.Context, moderationData entities.ToyModerationDTO) error {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	builder := sq.
+		Update(toysTableName).
+		Set(toyModerationStatusColumnName, moderationData.Status).
+		Set(toyRejectionReasonColumnName, moderationData.RejectionReason).
+		Where(sq.Eq{idColumnName: moderationData.ToyID}).
+		PlaceholderFormat(sq.Dollar)
+
+	// Appeal is kept for moderators till next resubmission:
+	if moderationData.Appeal != nil {
+		builder = builder.Set(toyAppealColumnName, moderationData.Appeal)
+	}
+
+	stmt, params, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = connection.ExecContext(
+		ctx,
+		stmt,
+		params...,
+	)
+
+	return err
+}
+
+// GetCategoryPriceStats returns price statistics of approved Toys of Category with provided ID.
+func (repo *ToysRepository) GetCategoryPriceStats(
+	ctx context.Context,
+	categoryID uint32,
+) (*entities.CategoryPriceStats, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
+
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
+
+	connection, release, err := transactions.Connection(ctx, repo.dbConnector, repo.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	stmt, params, err := sq.
+		Select(
+			selectCount,
+			fmt.Sprintf("COALESCE(AVG(%s), 0) AS %s", toyPriceColumnName, averagePriceAlias),
+		).
+		From(toysTableName).
+		Where(
+			sq.Eq{
+				categoryIDColumnName:          categoryID,
+				toyModerationStatusColumnName: entities.ToyModerationStatusApproved,
+			},
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &entities.CategoryPriceStats{CategoryID: categoryID}
+	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&stats.ToysCount, &stats.AveragePrice); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// saveToyPriceChange saves change of Toy's price to price history, if new price differs from current one.
+// Should be called before price is updated.
+func (repo *ToysRepository) saveToyPriceChange(
+	ctx context.Context,
+	executor transactions.Executor,
+	toyID uint64,
+	price float32,
+) error {
+	stmt, params, err := sq.
+		Insert(priceHistoryTableName).
+		Columns(toyIDColumnName, previousPriceColumnName, toyPriceColumnName).
+		Select(
+			sq.
+				Select(idColumnName, toyPriceColumnName).
+				Column("CAST(? AS FLOAT)", price).
+				From(toysTableName).
+				Where(
+					sq.And{
+						sq.Eq{idColumnName: toyID},
+						sq.NotEq{toyPriceColumnName: price},
+					},
+				),
+		).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = executor.ExecContext(ctx, stmt, params...)
+
+	return err
+}
+
+// selectToys executes provided Toys select query and loads Tags and Attachments for each found Toy,
+// if they are requested by read mask.
+func (repo *ToysRepository) selectToys(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+	connection transactions.Executor,
+) ([]entities.Toy, error) {
+	toys, err := repo.scanToys(ctx, builder, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := readmasks.ToyRelationsFromContext(ctx)
+
+	// Reading Tags and Attachments for each Toy in new circle due
+	// to next error: https://github.com/lib/pq/issues/635
+	// Using toy index to avoid range iter semantics error, via using copied variable.
+	for i, toy := range toys {
+		if relations.Tags {
+			if toys[i].Tags, err = repo.getToyTags(ctx, toy.ID, connection); err != nil {
+				return nil, err
+			}
+		}
+
+		if relations.Attachments {
+			if toys[i].Attachments, err = repo.getToyAttachments(ctx, toy.ID, connection); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	createdAtOrder := desc
-	if filters != nil && filters.CreatedAtOrderByAsc != nil && *filters.CreatedAtOrderByAsc {
-		createdAtOrder = asc
+	return toys, nil
+}
+
+// selectToysBatched executes provided Toys select query and loads Tags and Attachments of all found Toys
+// with one query per relation, if they are requested by read mask.
+func (repo *ToysRepository) selectToysBatched(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+	connection transactions.Executor,
+) ([]entities.Toy, error) {
+	toys, err := repo.scanToys(ctx, builder, connection)
+	if err != nil || len(toys) == 0 {
+		return toys, err
 	}
 
-	builder = builder.
-		OrderBy(
-			fmt.Sprintf(
-				"%s.%s %s",
-				toysTableName,
-				createdAtColumnName,
-				createdAtOrder,
-			),
-		)
+	toysIDs := make([]uint64, len(toys))
+	for i, toy := range toys {
+		toysIDs[i] = toy.ID
+	}
 
-	if pagination != nil && pagination.Limit != nil {
-		builder = builder.Limit(*pagination.Limit)
+	relations := readmasks.ToyRelationsFromContext(ctx)
+
+	if relations.Tags {
+		tags, err := repo.getToysTags(ctx, toysIDs, connection)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, toy := range toys {
+			toys[i].Tags = tags[toy.ID]
+		}
 	}
 
-	if pagination != nil && pagination.Offset != nil {
-		builder = builder.Offset(*pagination.Offset)
+	if relations.Attachments {
+		attachments, err := repo.getToysAttachments(ctx, toysIDs, connection)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, toy := range toys {
+			toys[i].Attachments = attachments[toy.ID]
+		}
 	}
 
+	return toys, nil
+}
+
+// scanToys selects Toys without their Tags and Attachments.
+func (repo *ToysRepository) scanToys(
+	ctx context.Context,
+	builder sq.SelectBuilder,
+	connection transactions.Executor,
+) ([]entities.Toy, error) {
 	stmt, params, err := builder.ToSql()
 	if err != nil {
 		return nil, err
@@ -599,472 +1582,389 @@ func (repo *ToysRepository) GetMasterToys(
 		return nil, err
 	}
 
-	// Reading Tags and Attachments for each Toy in new circle due
-	// to next error: https://github.com/lib/pq/issues/635
-	// Using toy index to avoid range iter semantics error, via using copied variable.
-	for i, toy := range toys {
-		tags, err := repo.getToyTags(ctx, toy.ID, connection)
-		if err != nil {
-			return nil, err
-		}
-
-		toys[i].Tags = tags
-
-		attachments, err := repo.getToyAttachments(ctx, toy.ID, connection)
-		if err != nil {
-			return nil, err
-		}
-
-		toys[i].Attachments = attachments
-	}
-
 	return toys, nil
 }
 
-func (repo *ToysRepository) CountMasterToys(
+func (repo *ToysRepository) getToyAttachments(
 	ctx context.Context,
-	masterID uint64,
-	filters *entities.ToysFilters,
-) (uint64, error) {
+	toyID uint64,
+	connection transactions.Executor,
+) ([]entities.Attachment, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
+	stmt, params, err := sq.
+		Select(selectAllColumns).
+		From(toysAttachmentsTableName).
+		Where(sq.Eq{toyIDColumnName: toyID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
-
-	builder := sq.
-		Select(selectCount).
-		From(toysTableName).
-		Where(sq.Eq{masterIDColumnName: masterID}).
-		PlaceholderFormat(sq.Dollar)
-
-	if filters != nil && filters.Search != nil && *filters.Search != "" {
-		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
-		builder = builder.
-			Where(
-				sq.Or{
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyNameColumnName,
-						): searchTerm,
-					},
-					sq.ILike{
-						fmt.Sprintf(
-							"%s.%s",
-							toysTableName,
-							toyDescriptionColumnName,
-						): searchTerm,
-					},
-				},
-			)
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
-		priceConditions := sq.And{}
-		if filters.PriceFloor != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceFloor,
-				},
-			)
-		}
-
-		if filters.PriceCeil != nil {
-			priceConditions = append(
-				priceConditions,
-				sq.LtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyPriceColumnName,
-					): *filters.PriceCeil,
-				},
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
 			)
 		}
+	}()
 
-		builder = builder.Where(priceConditions)
-	}
-
-	if filters != nil && filters.QuantityFloor != nil {
-		builder = builder.
-			Where(
-				sq.GtOrEq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						toyQuantityColumnName,
-					): *filters.QuantityFloor,
-				},
-			)
-	}
+	var attachments []entities.Attachment
 
-	if filters != nil && filters.CategoryIDs != nil {
-		builder = builder.
-			Where(
-				sq.Eq{
-					fmt.Sprintf(
-						"%s.%s",
-						toysTableName,
-						categoryIDColumnName,
-					): filters.CategoryIDs,
-				},
-			)
-	}
+	for rows.Next() {
+		var attachment entities.Attachment
+		columns := db.GetEntityColumns(&attachment) // Only pointer to use rows.Scan() successfully
 
-	if filters != nil && len(filters.TagIDs) > 0 {
-		for _, tagID := range filters.TagIDs {
-			builder = builder.
-				Where(
-					sq.Expr(
-						fmt.Sprintf(
-							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
-							toysAndTagsAssociationTableName,
-							toysAndTagsAssociationTableName,
-							toyIDColumnName,
-							toysTableName,
-							idColumnName,
-							toysAndTagsAssociationTableName,
-							tagIDColumnName,
-						),
-						tagID,
-					),
-				)
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	// Для запросов COUNT сортировка не нужна, поэтому параметр CreatedAtOrderByAsc не используется
-	stmt, params, err := builder.ToSql()
-	if err != nil {
-		return 0, err
+		attachments = append(attachments, attachment)
 	}
 
-	var count uint64
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(&count); err != nil {
-		return 0, err
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return count, nil
+	return attachments, nil
 }
 
-func (repo *ToysRepository) GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error) {
+func (repo *ToysRepository) getToyTags(
+	ctx context.Context,
+	toyID uint64,
+	connection transactions.Executor,
+) ([]entities.Tag, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
-
 	stmt, params, err := sq.
 		Select(selectAllColumns).
-		From(toysTableName).
-		Where(sq.Eq{idColumnName: id}).
+		From(tagsTableName).
+		Where(
+			sq.Expr(
+				idColumnName+" IN (?)",
+				sq.Select(tagIDColumnName).
+					From(toysAndTagsAssociationTableName).
+					Where(sq.Eq{toyIDColumnName: toyID}),
+			),
+		).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	toy := &entities.Toy{}
-	columns := db.GetEntityColumns(toy)
-	columns = columns[:len(columns)-2] // Not to paste Tags and Attachments fields to Scan function.
-
-	if err = connection.QueryRowContext(ctx, stmt, params...).Scan(columns...); err != nil {
-		return nil, err
-	}
-
-	tags, err := repo.getToyTags(ctx, toy.ID, connection)
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	toy.Tags = tags
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
+		}
+	}()
 
-	attachments, err := repo.getToyAttachments(ctx, toy.ID, connection)
-	if err != nil {
-		return nil, err
+	var tags []entities.Tag
+
+	for rows.Next() {
+		var tag entities.Tag
+		columns := db.GetEntityColumns(&tag) // Only pointer to use rows.Scan() successfully
+
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tag)
 	}
 
-	toy.Attachments = attachments
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
 
-	return toy, nil
+	return tags, nil
 }
 
-func (repo *ToysRepository) AddToy(
+// getToysTags returns Tags of Toys with provided IDs grouped by Toy ID.
+func (repo *ToysRepository) getToysTags(
 	ctx context.Context,
-	toyData entities.AddToyDTO,
-) (uint64, error) {
+	toysIDs []uint64,
+	connection transactions.Executor,
+) (map[uint64][]entities.Tag, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	transaction, err := repo.dbConnector.Transaction(ctx)
-	if err != nil {
-		return 0, err
-	}
-
-	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
-	defer func() {
-		if err = transaction.Rollback(); err != nil {
-			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
-		}
-	}()
-
 	stmt, params, err := sq.
-		Insert(toysTableName).
-		Columns(
-			masterIDColumnName,
-			categoryIDColumnName,
-			toyNameColumnName,
-			toyDescriptionColumnName,
-			toyPriceColumnName,
-			toyQuantityColumnName,
+		Select(
+			fmt.Sprintf("%s.%s", toysAndTagsAssociationTableName, toyIDColumnName),
+			fmt.Sprintf("%s.%s", tagsTableName, selectAllColumns),
 		).
-		Values(
-			toyData.MasterID,
-			toyData.CategoryID,
-			toyData.Name,
-			toyData.Description,
-			toyData.Price,
-			toyData.Quantity,
+		From(tagsTableName).
+		Join(
+			fmt.Sprintf(
+				"%s ON %s.%s = %s.%s",
+				toysAndTagsAssociationTableName,
+				toysAndTagsAssociationTableName,
+				tagIDColumnName,
+				tagsTableName,
+				idColumnName,
+			),
 		).
-		Suffix(returningIDSuffix).
-		PlaceholderFormat(sq.Dollar). // pq postgres driver works only with $ placeholders
+		Where(sq.Eq{fmt.Sprintf("%s.%s", toysAndTagsAssociationTableName, toyIDColumnName): toysIDs}).
+		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return 0, err
-	}
-
-	var toyID uint64
-	if err = transaction.QueryRowContext(ctx, stmt, params...).Scan(&toyID); err != nil {
-		return 0, err
+		return nil, err
 	}
 
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	if len(toyData.TagIDs) > 0 {
-		builder := sq.Insert(toysAndTagsAssociationTableName).
-			Columns(toyIDColumnName, tagIDColumnName)
-		for _, tagID := range toyData.TagIDs {
-			builder = builder.Values(toyID, tagID)
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
 		}
+	}()
 
-		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
-			return 0, err
-		}
+	tags := make(map[uint64][]entities.Tag, len(toysIDs))
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return 0, err
-		}
-	}
+	for rows.Next() {
+		var (
+			toyID uint64
+			tag   entities.Tag
+		)
 
-	if len(toyData.Attachments) > 0 {
-		builder := sq.Insert(toysAttachmentsTableName).
-			Columns(toyIDColumnName, attachmentLinkColumnName)
-		for _, attachment := range toyData.Attachments {
-			builder = builder.Values(toyID, attachment)
-		}
+		columns := append([]any{&toyID}, db.GetEntityColumns(&tag)...) // Only pointer to use rows.Scan() successfully
 
-		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
-			return 0, err
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
 		}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return 0, err
-		}
+		tags[toyID] = append(tags[toyID], tag)
 	}
 
-	err = transaction.Commit()
-	if err != nil {
-		return 0, err
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return toyID, nil
+	return tags, nil
 }
 
-func (repo *ToysRepository) DeleteToy(ctx context.Context, id uint64) error {
+// getToysAttachments returns Attachments of Toys with provided IDs grouped by Toy ID.
+func (repo *ToysRepository) getToysAttachments(
+	ctx context.Context,
+	toysIDs []uint64,
+	connection transactions.Executor,
+) (map[uint64][]entities.Attachment, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-	connection, err := repo.dbConnector.Connection(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer db.CloseConnectionContext(ctx, connection, repo.logger)
-
 	stmt, params, err := sq.
-		Delete(toysTableName).
-		Where(sq.Eq{idColumnName: id}).
+		Select(selectAllColumns).
+		From(toysAttachmentsTableName).
+		Where(sq.Eq{toyIDColumnName: toysIDs}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = connection.ExecContext(
+	rows, err := connection.QueryContext(
 		ctx,
 		stmt,
 		params...,
 	)
-
-	return err
-}
-
-func (repo *ToysRepository) UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error {
-	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
-	defer span.End()
-
-	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
-	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
-
-	transaction, err := repo.dbConnector.Transaction(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
 	defer func() {
-		if err = transaction.Rollback(); err != nil {
-			logging.LogErrorContext(ctx, repo.logger, "failed to rollback db transaction", err)
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
 		}
 	}()
 
-	builder := sq.
-		Update(toysTableName).
-		Where(sq.Eq{idColumnName: toyData.ID}).
-		PlaceholderFormat(sq.Dollar) // pq postgres driver works only with $ placeholders
-
-	if toyData.CategoryID != nil {
-		builder = builder.Set(categoryIDColumnName, toyData.CategoryID)
-	}
+	attachments := make(map[uint64][]entities.Attachment, len(toysIDs))
 
-	if toyData.Name != nil {
-		builder = builder.Set(toyNameColumnName, toyData.Name)
-	}
-
-	if toyData.Description != nil {
-		builder = builder.Set(toyDescriptionColumnName, toyData.Description)
-	}
+	for rows.Next() {
+		var attachment entities.Attachment
+		columns := db.GetEntityColumns(&attachment) // Only pointer to use rows.Scan() successfully
 
-	if toyData.Price != nil {
-		builder = builder.Set(toyPriceColumnName, toyData.Price)
-	}
+		err = rows.Scan(columns...)
+		if err != nil {
+			return nil, err
+		}
 
-	if toyData.Quantity != nil {
-		builder = builder.Set(toyQuantityColumnName, toyData.Quantity)
+		attachments[attachment.ToyID] = append(attachments[attachment.ToyID], attachment)
 	}
 
-	stmt, params, err := builder.ToSql()
-	if err != nil {
-		return err
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-		return err
-	}
+	return attachments, nil
+}
 
-	if len(toyData.TagIDsToAdd) > 0 {
-		builder := sq.Insert(toysAndTagsAssociationTableName).
-			Columns(toyIDColumnName, tagIDColumnName)
-		for _, tagID := range toyData.TagIDsToAdd {
-			builder = builder.Values(toyData.ID, tagID)
-		}
+// getMasterNewToysPerDay returns count of Toys, created by Master for each day of provided period.
+// Days without new Toys are also returned with zero count to simplify building charts on client side.
+func (repo *ToysRepository) getMasterNewToysPerDay(
+	ctx context.Context,
+	masterID uint64,
+	period entities.StatsPeriod,
+	connection transactions.Executor,
+) ([]entities.DailyToysCount, error) {
+	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
+	defer span.End()
 
-		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
-			return err
-		}
+	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
+	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return err
-		}
+	stmt, params, err := sq.
+		Select(
+			fmt.Sprintf("DATE(%s) AS %s", createdAtColumnName, statsDayAlias),
+			selectCount,
+		).
+		From(toysTableName).
+		Where(
+			sq.And{
+				sq.Eq{masterIDColumnName: masterID},
+				sq.GtOrEq{createdAtColumnName: period.From},
+				sq.Lt{createdAtColumnName: period.To},
+			},
+		).
+		GroupBy(statsDayAlias).
+		OrderBy(statsDayAlias).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
 	}
 
-	if len(toyData.TagIDsToDelete) > 0 {
-		stmt, params, err = sq.
-			Delete(toysAndTagsAssociationTableName).
-			Where(
-				sq.And{
-					sq.Eq{toyIDColumnName: toyData.ID},
-					sq.Eq{tagIDColumnName: toyData.TagIDsToDelete},
-				},
-			).
-			PlaceholderFormat(sq.Dollar).
-			ToSql()
-		if err != nil {
-			return err
-		}
+	rows, err := connection.QueryContext(
+		ctx,
+		stmt,
+		params...,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return err
+	defer func() {
+		if err = rows.Close(); err != nil {
+			logging.LogErrorContext(
+				ctx,
+				repo.logger,
+				"error during closing SQL rows",
+				err,
+			)
 		}
-	}
+	}()
 
-	if len(toyData.AttachmentsToAdd) > 0 {
-		builder := sq.Insert(toysAttachmentsTableName).
-			Columns(toyIDColumnName, attachmentLinkColumnName)
-		for _, attachment := range toyData.AttachmentsToAdd {
-			builder = builder.Values(toyData.ID, attachment)
-		}
+	counts := make(map[string]uint64)
 
-		if stmt, params, err = builder.PlaceholderFormat(sq.Dollar).ToSql(); err != nil {
-			return err
-		}
+	for rows.Next() {
+		var (
+			day   any // Postgres returns DATE as time.Time, while SQLite returns it as string
+			count uint64
+		)
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return err
+		if err = rows.Scan(&day, &count); err != nil {
+			return nil, err
 		}
-	}
 
-	if len(toyData.AttachmentIDsToDelete) > 0 {
-		stmt, params, err = sq.
-			Delete(toysAttachmentsTableName).
-			Where(sq.Eq{idColumnName: toyData.AttachmentIDsToDelete}).
-			PlaceholderFormat(sq.Dollar).
-			ToSql()
+		date, err := parseStatsDay(day)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if _, err = transaction.ExecContext(ctx, stmt, params...); err != nil {
-			return err
-		}
+		counts[date.Format(time.DateOnly)] = count
 	}
 
-	return transaction.Commit()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var newToysPerDay []entities.DailyToysCount
+
+	from := period.From.UTC()
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	for ; day.Before(period.To); day = day.AddDate(0, 0, 1) {
+		newToysPerDay = append(
+			newToysPerDay,
+			entities.DailyToysCount{
+				Date:  day,
+				Count: counts[day.Format(time.DateOnly)],
+			},
+		)
+	}
+
+	return newToysPerDay, nil
 }
 
-func (repo *ToysRepository) getToyAttachments(
+// getMasterTopToys returns limited list of Toys of Master, satisfying provided condition and sorted by provided order.
+// Only Toy columns are read, because Tags and Attachments are not needed for statistics.
+func (repo *ToysRepository) getMasterTopToys(
 	ctx context.Context,
-	toyID uint64,
-	connection *sql.Conn,
-) ([]entities.Attachment, error) {
+	masterID uint64,
+	condition sq.Sqlizer,
+	orderBy []string,
+	connection transactions.Executor,
+) ([]entities.Toy, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
@@ -1073,8 +1973,15 @@ func (repo *ToysRepository) getToyAttachments(
 
 	stmt, params, err := sq.
 		Select(selectAllColumns).
-		From(toysAttachmentsTableName).
-		Where(sq.Eq{toyIDColumnName: toyID}).
+		From(toysTableName).
+		Where(
+			sq.And{
+				sq.Eq{masterIDColumnName: masterID},
+				condition,
+			},
+		).
+		OrderBy(orderBy...).
+		Limit(statsTopToysLimit).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -1101,49 +2008,70 @@ func (repo *ToysRepository) getToyAttachments(
 		}
 	}()
 
-	var attachments []entities.Attachment
+	var toys []entities.Toy
 
 	for rows.Next() {
-		var attachment entities.Attachment
-		columns := db.GetEntityColumns(&attachment) // Only pointer to use rows.Scan() successfully
+		toy := entities.Toy{}
+		columns := db.GetEntityColumns(&toy) // Only pointer to use rows.Scan() successfully
+		columns = columns[:len(columns)-2]   // Not to paste Tags and Attachments fields to Scan function.
 
-		err = rows.Scan(columns...)
-		if err != nil {
+		if err = rows.Scan(columns...); err != nil {
 			return nil, err
 		}
 
-		attachments = append(attachments, attachment)
+		toys = append(toys, toy)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return attachments, nil
+	return toys, nil
 }
 
-func (repo *ToysRepository) getToyTags(
+// getMasterPriceDistribution splits prices range of Toys of Master into equal buckets
+// and counts Toys in each bucket via single grouped query.
+func (repo *ToysRepository) getMasterPriceDistribution(
 	ctx context.Context,
-	toyID uint64,
-	connection *sql.Conn,
-) ([]entities.Tag, error) {
+	masterID uint64,
+	minPrice float64,
+	maxPrice float64,
+	connection transactions.Executor,
+) ([]entities.PriceBucket, error) {
 	ctx, span := repo.traceProvider.Span(ctx, tracing.CallerName(tracing.DefaultSkipLevel))
 	defer span.End()
 
 	span.AddEvent(repo.spanConfig.Events.Start.Name, repo.spanConfig.Events.Start.Opts...)
 	defer span.AddEvent(repo.spanConfig.Events.End.Name, repo.spanConfig.Events.End.Opts...)
 
+	bucketsCount := statsPriceBucketsCount
+	if maxPrice <= minPrice {
+		bucketsCount = 1 // All Toys have same price
+	}
+
+	width := (maxPrice - minPrice) / float64(bucketsCount)
+	buckets := make([]entities.PriceBucket, bucketsCount)
+	bucketCase := sq.Case()
+
+	for i := range buckets {
+		buckets[i].Floor = float32(minPrice + float64(i)*width)
+		buckets[i].Ceil = float32(minPrice + float64(i+1)*width)
+
+		if i < bucketsCount-1 {
+			bucketCase = bucketCase.When(sq.Lt{toyPriceColumnName: minPrice + float64(i+1)*width}, strconv.Itoa(i))
+		}
+	}
+
+	buckets[bucketsCount-1].Ceil = float32(maxPrice)
+	bucketCase = bucketCase.Else(strconv.Itoa(bucketsCount - 1))
+
 	stmt, params, err := sq.
-		Select(selectAllColumns).
-		From(tagsTableName).
-		Where(
-			sq.Expr(
-				idColumnName+" IN (?)",
-				sq.Select(tagIDColumnName).
-					From(toysAndTagsAssociationTableName).
-					Where(sq.Eq{toyIDColumnName: toyID}),
-			),
-		).
+		Select().
+		Column(sq.Alias(bucketCase, statsBucketAlias)).
+		Column(selectCount).
+		From(toysTableName).
+		Where(sq.Eq{masterIDColumnName: masterID}).
+		GroupBy(statsBucketAlias).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -1170,23 +2098,255 @@ func (repo *ToysRepository) getToyTags(
 		}
 	}()
 
-	var tags []entities.Tag
-
 	for rows.Next() {
-		var tag entities.Tag
-		columns := db.GetEntityColumns(&tag) // Only pointer to use rows.Scan() successfully
-
-		err = rows.Scan(columns...)
-		if err != nil {
+		var bucket, count uint64
+		if err = rows.Scan(&bucket, &count); err != nil {
 			return nil, err
 		}
 
-		tags = append(tags, tag)
+		if bucket < uint64(bucketsCount) {
+			buckets[bucket].Count = count
+		}
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return tags, nil
+	return buckets, nil
+}
+
+// applyToysFilters adds conditions for provided ToysFilters to Toys select query.
+// Toys of banned Masters are always excluded from selection.
+func applyToysFilters(builder sq.SelectBuilder, filters *entities.ToysFilters) sq.SelectBuilder {
+	builder = builder.Where(visibleToysCondition())
+
+	moderationStatuses := []entities.ToyModerationStatus{entities.ToyModerationStatusApproved}
+	if filters != nil && len(filters.ModerationStatuses) > 0 {
+		moderationStatuses = filters.ModerationStatuses
+	}
+
+	builder = builder.
+		Where(
+			sq.Eq{
+				fmt.Sprintf(
+					"%s.%s",
+					toysTableName,
+					toyModerationStatusColumnName,
+				): moderationStatuses,
+			},
+		)
+
+	if filters != nil && filters.Search != nil && *filters.Search != "" {
+		searchTerm := "%" + strings.ToLower(*filters.Search) + "%"
+		builder = builder.
+			Where(
+				sq.Or{
+					sq.ILike{
+						fmt.Sprintf(
+							"%s.%s",
+							toysTableName,
+							toyNameColumnName,
+						): searchTerm,
+					},
+					sq.ILike{
+						fmt.Sprintf(
+							"%s.%s",
+							toysTableName,
+							toyDescriptionColumnName,
+						): searchTerm,
+					},
+				},
+			)
+	}
+
+	if filters != nil && (filters.PriceFloor != nil || filters.PriceCeil != nil) {
+		priceConditions := sq.And{}
+		if filters.PriceFloor != nil {
+			priceConditions = append(
+				priceConditions,
+				sq.GtOrEq{
+					fmt.Sprintf(
+						"%s.%s",
+						toysTableName,
+						toyPriceColumnName,
+					): *filters.PriceFloor,
+				},
+			)
+		}
+
+		if filters.PriceCeil != nil {
+			priceConditions = append(
+				priceConditions,
+				sq.LtOrEq{
+					fmt.Sprintf(
+						"%s.%s",
+						toysTableName,
+						toyPriceColumnName,
+					): *filters.PriceCeil,
+				},
+			)
+		}
+
+		builder = builder.Where(priceConditions)
+	}
+
+	if filters != nil && filters.QuantityFloor != nil {
+		builder = builder.
+			Where(
+				sq.GtOrEq{
+					fmt.Sprintf(
+						"%s.%s",
+						toysTableName,
+						toyQuantityColumnName,
+					): *filters.QuantityFloor,
+				},
+			)
+	}
+
+	if filters != nil && filters.CategoryIDs != nil {
+		builder = builder.
+			Where(
+				sq.Eq{
+					fmt.Sprintf(
+						"%s.%s",
+						toysTableName,
+						categoryIDColumnName,
+					): filters.CategoryIDs,
+				},
+			)
+	}
+
+	if filters != nil && len(filters.TagIDs) > 0 {
+		for _, tagID := range filters.TagIDs {
+			builder = builder.
+				Where(
+					sq.Expr(
+						fmt.Sprintf(
+							"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
+							toysAndTagsAssociationTableName,
+							toysAndTagsAssociationTableName,
+							toyIDColumnName,
+							toysTableName,
+							idColumnName,
+							toysAndTagsAssociationTableName,
+							tagIDColumnName,
+						),
+						tagID,
+					),
+				)
+		}
+	}
+
+	if filters != nil && filters.OnlyAvailable != nil && *filters.OnlyAvailable {
+		builder = builder.
+			Where(
+				sq.Eq{
+					fmt.Sprintf(
+						"%s.%s",
+						toysTableName,
+						toyAvailableColumnName,
+					): true,
+				},
+			)
+	}
+
+	if filters != nil && filters.MinRating != nil {
+		builder = builder.
+			Where(
+				sq.GtOrEq{
+					fmt.Sprintf(
+						"%s.%s",
+						toysTableName,
+						averageRatingColumnName,
+					): *filters.MinRating,
+				},
+			)
+	}
+
+	return builder
+}
+
+// applyToysOrder adds sorting for provided ToysFilters to Toys select query.
+func applyToysOrder(builder sq.SelectBuilder, filters *entities.ToysFilters) sq.SelectBuilder {
+	// Rating sort has priority over creation date sort, if provided:
+	if filters != nil && filters.RatingOrderByAsc != nil {
+		ratingOrder := desc
+		if *filters.RatingOrderByAsc {
+			ratingOrder = asc
+		}
+
+		builder = builder.
+			OrderBy(
+				fmt.Sprintf(
+					"%s.%s %s",
+					toysTableName,
+					averageRatingColumnName,
+					ratingOrder,
+				),
+			)
+	}
+
+	createdAtOrder := desc
+	if filters != nil && filters.CreatedAtOrderByAsc != nil && *filters.CreatedAtOrderByAsc {
+		createdAtOrder = asc
+	}
+
+	return builder.
+		OrderBy(
+			fmt.Sprintf(
+				"%s.%s %s",
+				toysTableName,
+				createdAtColumnName,
+				createdAtOrder,
+			),
+		)
+}
+
+// userFavouritesCondition returns condition for selecting only Toys, which were added to Favourites by User.
+func userFavouritesCondition(userID uint64) sq.Sqlizer {
+	return sq.Expr(
+		fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
+			favouritesTableName,
+			favouritesTableName,
+			toyIDColumnName,
+			toysTableName,
+			idColumnName,
+			favouritesTableName,
+			userIDColumnName,
+		),
+		userID,
+	)
+}
+
+// parseStatsDay converts scanned DATE value to time.Time, because different drivers return it in different types.
+func parseStatsDay(value any) (time.Time, error) {
+	switch day := value.(type) {
+	case time.Time:
+		return day.UTC(), nil
+	case string:
+		return time.Parse(time.DateOnly, day)
+	case []byte:
+		return time.Parse(time.DateOnly, string(day))
+	default:
+		return time.Time{}, fmt.Errorf("unexpected day type %T", value)
+	}
+}
+
+// visibleToysCondition returns condition for excluding Toys of banned Masters.
+func visibleToysCondition() sq.Sqlizer {
+	return sq.Expr(
+		fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.%s = ?)",
+			mastersTableName,
+			mastersTableName,
+			idColumnName,
+			toysTableName,
+			masterIDColumnName,
+			mastersTableName,
+			masterStatusColumnName,
+		),
+		entities.MasterStatusBanned,
+	)
 }
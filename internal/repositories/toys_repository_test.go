@@ -5,6 +5,7 @@ package repositories_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"os"
 	"path"
 	"testing"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 
 	"github.com/DKhorkov/libs/db"
@@ -22,7 +24,11 @@ import (
 	mocktracing "github.com/DKhorkov/libs/tracing/mocks"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
 	"github.com/DKhorkov/hmtm-toys/internal/repositories"
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
 	"github.com/DKhorkov/libs/pointers"
 )
 
@@ -637,6 +643,54 @@ func (s *ToysRepositoryTestSuite) TestGetToyByIDExisting() {
 	s.Equal("file1.jpg", toy.Attachments[0].Link)
 }
 
+func (s *ToysRepositoryTestSuite) TestGetToyByIDWithReadMask() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			// Context is returned as is, so repositories can read requested relations:
+			func(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return ctx, mocktracing.NewMockSpan()
+			},
+		).
+		Times(2) // Основной + getToyTags
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Test Toy", "Test Description", 99.99, 5, createdAt, createdAt,
+	)
+	s.NoError(err)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tags (id, name, created_at, updated_at) VALUES (?, ?, ?, ?)",
+		10, "Tag 1", createdAt, createdAt,
+	)
+	s.NoError(err)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys_tags_associations (id, toy_id, tag_id) VALUES (?, ?, ?)",
+		1, 1, 10,
+	)
+	s.NoError(err)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys_attachments (id, toy_id, link, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, "file1.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	ctx := readmasks.WithToyRelations(s.ctx, readmasks.ToyRelations{Tags: true})
+
+	toy, err := s.toysRepository.GetToyByID(ctx, 1)
+	s.NoError(err)
+	s.NotNil(toy)
+	s.Len(toy.Tags, 1)
+	s.Empty(toy.Attachments)
+}
+
 func (s *ToysRepositoryTestSuite) TestGetToyByIDNonExisting() {
 	s.traceProvider.
 		EXPECT().
@@ -646,9 +700,105 @@ func (s *ToysRepositoryTestSuite) TestGetToyByIDNonExisting() {
 
 	toy, err := s.toysRepository.GetToyByID(s.ctx, 999)
 	s.Error(err)
+	s.IsType(&customerrors.ToyNotFoundError{}, err)
 	s.Nil(toy)
 }
 
+func (s *ToysRepositoryTestSuite) TestGetToysByIDs() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getToysTags + getToysAttachments
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+		3, 2, 2, "Toy 3", "Desc 3", 19.99, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO tags (id, name, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?), (?, ?, ?, ?)",
+		10, "Tag 1", createdAt, createdAt,
+		20, "Tag 2", createdAt, createdAt,
+	)
+	s.NoError(err)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys_tags_associations (id, toy_id, tag_id) "+
+			"VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		1, 1, 10,
+		2, 1, 20,
+		3, 3, 20,
+	)
+	s.NoError(err)
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys_attachments (id, toy_id, link, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?)",
+		1, 3, "file1.jpg", createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	toys, err := s.toysRepository.GetToysByIDs(s.ctx, []uint64{3, 1, 999})
+	s.NoError(err)
+	s.Len(toys, 2)
+	s.Equal(uint64(1), toys[0].ID)
+	s.Len(toys[0].Tags, 2)
+	s.Empty(toys[0].Attachments)
+	s.Equal(uint64(3), toys[1].ID)
+	s.Len(toys[1].Tags, 1)
+	s.Equal("Tag 2", toys[1].Tags[0].Name)
+	s.Len(toys[1].Attachments, 1)
+	s.Equal("file1.jpg", toys[1].Attachments[0].Link)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysByIDsWithoutRelations() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			// Context is returned as is, so repositories can read requested relations:
+			func(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return ctx, mocktracing.NewMockSpan()
+			},
+		).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	toys, err := s.toysRepository.GetToysByIDs(readmasks.WithToyRelations(s.ctx, readmasks.ToyRelations{}), []uint64{1})
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Nil(toys[0].Tags)
+	s.Nil(toys[0].Attachments)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysByIDsWithoutExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	toys, err := s.toysRepository.GetToysByIDs(s.ctx, []uint64{999})
+	s.NoError(err)
+	s.Empty(toys)
+}
+
 func (s *ToysRepositoryTestSuite) TestAddToySuccess() {
 	s.traceProvider.
 		EXPECT().
@@ -681,6 +831,11 @@ func (s *ToysRepositoryTestSuite) TestDeleteToySuccess() {
 		Return(context.Background(), mocktracing.NewMockSpan()).
 		Times(1)
 
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
 	createdAt := time.Now().UTC()
 	_, err := s.connection.ExecContext(
 		s.ctx,
@@ -702,6 +857,56 @@ func (s *ToysRepositoryTestSuite) TestDeleteToySuccess() {
 	s.False(rows.Next())
 }
 
+func (s *ToysRepositoryTestSuite) TestDeleteMasterToysSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+		3, 2, 2, "Toy 3", "Desc 3", 19.99, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	toysIDs, err := s.toysRepository.DeleteMasterToys(s.ctx, 1)
+	s.NoError(err)
+	s.ElementsMatch([]uint64{1, 2}, toysIDs)
+
+	var toysCount int
+	err = s.connection.QueryRowContext(s.ctx, "SELECT COUNT(*) FROM toys").Scan(&toysCount)
+	s.NoError(err)
+	s.Equal(1, toysCount) // Toy of another Master is kept.
+}
+
+func (s *ToysRepositoryTestSuite) TestDeleteMasterToysWithoutToys() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	toysIDs, err := s.toysRepository.DeleteMasterToys(s.ctx, 1)
+	s.NoError(err)
+	s.Empty(toysIDs)
+}
+
 func (s *ToysRepositoryTestSuite) TestUpdateToyFullUpdate() {
 	s.traceProvider.
 		EXPECT().
@@ -831,3 +1036,824 @@ func (s *ToysRepositoryTestSuite) TestUpdateToyMinimalUpdate() {
 	s.InDelta(*newPrice, price, 0.01)
 	s.Equal(uint32(1), quantity)
 }
+
+func (s *ToysRepositoryTestSuite) TestUpdateToyPriceHistory() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(4) // 3x UpdateToy + GetMasterToysPriceHistory
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 50.00, 1, createdAt, createdAt,
+		2, 2, 2, "Toy 2", "Desc 2", 50.00, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Only the first update changes price of Master's Toy:
+	for _, toyData := range []entities.UpdateToyDTO{
+		{ID: 1, Price: pointers.New[float32](75.00)},
+		{ID: 1, Price: pointers.New[float32](75.00), Name: pointers.New("New Toy")},
+		{ID: 2, Price: pointers.New[float32](25.00)},
+	} {
+		s.NoError(s.toysRepository.UpdateToy(s.ctx, toyData))
+	}
+
+	// SQLite does not generate values for SERIAL columns, so IDs are filled manually:
+	_, err = s.connection.ExecContext(s.ctx, "UPDATE toys_price_history SET id = rowid")
+	s.NoError(err)
+
+	history, err := s.toysRepository.GetMasterToysPriceHistory(s.ctx, 1)
+	s.NoError(err)
+	s.Len(history, 1)
+	s.Equal(uint64(1), history[0].ToyID)
+	s.InDelta(50.00, history[0].PreviousPrice, 0.01)
+	s.InDelta(75.00, history[0].Price, 0.01)
+}
+
+func (s *ToysRepositoryTestSuite) TestUpdateToyWithinCommittedTransaction() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			// Context is returned as is, so repositories can join transaction:
+			func(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return ctx, mocktracing.NewMockSpan()
+			},
+		).
+		Times(4) // UpdateToy + GetToyByID + getToyTags + getToyAttachments
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Old Toy", "Old Desc", 50.00, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	transactionManager := transactions.NewManager(s.dbConnector, sql.LevelDefault, s.logger)
+	err = transactionManager.WithinTransaction(
+		s.ctx,
+		func(ctx context.Context) error {
+			if err := s.toysRepository.UpdateToy(ctx, entities.UpdateToyDTO{ID: 1, Name: pointers.New("New Toy")}); err != nil {
+				return err
+			}
+
+			// Changes are visible inside transaction before commit:
+			toy, err := s.toysRepository.GetToyByID(ctx, 1)
+			if err != nil {
+				return err
+			}
+
+			s.Equal("New Toy", toy.Name)
+
+			return nil
+		},
+	)
+	s.NoError(err)
+
+	var name string
+	s.NoError(s.connection.QueryRowContext(s.ctx, "SELECT name FROM toys WHERE id = ?", 1).Scan(&name))
+	s.Equal("New Toy", name)
+}
+
+func (s *ToysRepositoryTestSuite) TestUpdateToyWithinRolledBackTransaction() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			// Context is returned as is, so repositories can join transaction:
+			func(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return ctx, mocktracing.NewMockSpan()
+			},
+		).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Old Toy", "Old Desc", 50.00, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	expectedErr := errors.New("test error")
+	transactionManager := transactions.NewManager(s.dbConnector, sql.LevelDefault, s.logger)
+	err = transactionManager.WithinTransaction(
+		s.ctx,
+		func(ctx context.Context) error {
+			if err := s.toysRepository.UpdateToy(ctx, entities.UpdateToyDTO{ID: 1, Name: pointers.New("New Toy")}); err != nil {
+				return err
+			}
+
+			return expectedErr
+		},
+	)
+	s.ErrorIs(err, expectedErr)
+
+	var name string
+	s.NoError(s.connection.QueryRowContext(s.ctx, "SELECT name FROM toys WHERE id = ?", 1).Scan(&name))
+	s.Equal("Old Toy", name)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetUserFavouritesWithExistingFavourites() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getToyTags + getToyAttachments
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 3, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO favourites (id, user_id, toy_id, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, 1, createdAt, createdAt,
+		2, 2, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	toys, err := s.toysRepository.GetUserFavourites(s.ctx, 1, nil, nil)
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(1), toys[0].ID)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetUserFavouritesWithoutExistingFavourites() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	toys, err := s.toysRepository.GetUserFavourites(s.ctx, 1, nil, nil)
+	s.NoError(err)
+	s.Empty(toys)
+}
+
+func (s *ToysRepositoryTestSuite) TestCountUserFavouritesWithExistingFavourites() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 3, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO favourites (id, user_id, toy_id, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, 1, createdAt, createdAt,
+		2, 1, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	count, err := s.toysRepository.CountUserFavourites(
+		s.ctx,
+		1,
+		&entities.ToysFilters{PriceFloor: pointers.New[float32](50)},
+	)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetFavouriteExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Test Toy", "Test Description", 99.99, 5, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO favourites (id, user_id, toy_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	favourite, err := s.toysRepository.GetFavourite(s.ctx, 1, 1)
+	s.NoError(err)
+	s.NotNil(favourite)
+	s.Equal(uint64(1), favourite.ID)
+}
+
+func (s *ToysRepositoryTestSuite) TestAddFavouriteAlreadyExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, favourites_count, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Test Toy", "Test Description", 99.99, 5, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO favourites (id, user_id, toy_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Conflict is resolved by insert itself, so concurrent adds don't fail with unique violation:
+	favouriteID, err := s.toysRepository.AddFavourite(s.ctx, 1, 1)
+	s.IsType(&customerrors.FavouriteAlreadyExistsError{}, err)
+	s.Zero(favouriteID)
+
+	var favouritesCount int
+	err = s.connection.QueryRowContext(s.ctx, "SELECT favourites_count FROM toys WHERE id = 1").Scan(&favouritesCount)
+	s.NoError(err)
+	s.Equal(1, favouritesCount)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetFavouriteNonExisting() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	favourite, err := s.toysRepository.GetFavourite(s.ctx, 1, 1)
+	s.Error(err)
+	s.IsType(&customerrors.FavouriteNotFoundError{}, err)
+	s.Nil(favourite)
+}
+
+func (s *ToysRepositoryTestSuite) TestRemoveFavouriteSuccess() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	s.logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, favourites_count) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Test Toy", "Test Description", 99.99, 5, createdAt, createdAt, 1,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO favourites (id, user_id, toy_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 1, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	err = s.toysRepository.RemoveFavourite(s.ctx, 1, 1)
+	s.NoError(err)
+
+	var favouritesCount uint64
+	err = s.connection.QueryRowContext(s.ctx, "SELECT favourites_count FROM toys WHERE id = ?", 1).Scan(&favouritesCount)
+	s.NoError(err)
+	s.Zero(favouritesCount)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysWithMinRatingAndRatingOrder() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(5) // Основной + 2x(getToyTags + getToyAttachments)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, average_rating) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, 4.5,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt, 2.5,
+		3, 1, 2, "Toy 3", "Desc 3", 19.99, 1, createdAt, createdAt, 5,
+	)
+	s.NoError(err)
+
+	toys, err := s.toysRepository.GetToys(
+		s.ctx,
+		nil,
+		&entities.ToysFilters{
+			MinRating:        pointers.New[float32](4),
+			RatingOrderByAsc: pointers.New(false),
+		},
+	)
+	s.NoError(err)
+	s.Len(toys, 2)
+	s.Equal(uint64(3), toys[0].ID)
+	s.Equal(uint64(1), toys[1].ID)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetFeed() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 2, "Toy 2", "Desc 2", 19.99, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO follows (id, user_id, master_id, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+		1, 3, 1, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Error is returned, because SQLite doesn't support LATERAL joins, which are used to read Toys of each
+	// followed Master by index. Feed cursors are covered by handler tests:
+	toys, err := s.toysRepository.GetFeed(s.ctx, 3, nil, 1)
+	s.Error(err)
+	s.Empty(toys)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetMasterToysStats() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(5) // Основной + getMasterNewToysPerDay + 2x getMasterTopToys + getMasterPriceDistribution
+
+	day := time.Date(2025, 6, 13, 0, 0, 0, 0, time.UTC)
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"favourites_count, average_rating, reviews_count) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 100, 5, day.Add(10*time.Hour), day, 3, 4.5, 2,
+		2, 1, 2, "Toy 2", "Desc 2", 200, 0, day.Add(57*time.Hour), day, 0, 0, 0,
+		3, 1, 2, "Toy 3", "Desc 3", 500, 2, day.Add(58*time.Hour), day, 7, 5, 1,
+		4, 2, 2, "Toy 4", "Desc 4", 300, 10, day.Add(58*time.Hour), day, 10, 5, 10,
+	)
+	s.NoError(err)
+
+	stats, err := s.toysRepository.GetMasterToysStats(
+		s.ctx,
+		1,
+		entities.StatsPeriod{
+			From: day,
+			To:   day.AddDate(0, 0, 3),
+		},
+	)
+	s.NoError(err)
+	s.NotNil(stats)
+	s.Equal(uint64(3), stats.ToysCount)
+	s.Equal(uint64(2), stats.InStockToysCount)
+	s.Equal(uint64(1), stats.OutOfStockToysCount)
+	s.Equal(uint64(7), stats.TotalStock)
+	s.InDelta(1500, stats.StockValue, 0.001)
+
+	s.Equal(
+		[]entities.DailyToysCount{
+			{Date: day, Count: 1},
+			{Date: day.AddDate(0, 0, 1), Count: 0},
+			{Date: day.AddDate(0, 0, 2), Count: 2},
+		},
+		stats.NewToysPerDay,
+	)
+
+	s.Len(stats.MostFavouritedToys, 2)
+	s.Equal(uint64(3), stats.MostFavouritedToys[0].ID)
+	s.Equal(uint64(1), stats.MostFavouritedToys[1].ID)
+
+	s.Len(stats.HighestRatedToys, 2)
+	s.Equal(uint64(3), stats.HighestRatedToys[0].ID)
+	s.Equal(uint64(1), stats.HighestRatedToys[1].ID)
+
+	s.Equal(
+		[]entities.PriceBucket{
+			{Floor: 100, Ceil: 180, Count: 1},
+			{Floor: 180, Ceil: 260, Count: 1},
+			{Floor: 260, Ceil: 340, Count: 0},
+			{Floor: 340, Ceil: 420, Count: 0},
+			{Floor: 420, Ceil: 500, Count: 1},
+		},
+		stats.PriceDistribution,
+	)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetMasterToysStatsWithoutToys() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(2) // Основной + getMasterNewToysPerDay
+
+	day := time.Date(2025, 6, 13, 0, 0, 0, 0, time.UTC)
+	stats, err := s.toysRepository.GetMasterToysStats(
+		s.ctx,
+		1,
+		entities.StatsPeriod{
+			From: day,
+			To:   day.AddDate(0, 0, 1),
+		},
+	)
+	s.NoError(err)
+	s.NotNil(stats)
+	s.Zero(stats.ToysCount)
+	s.Len(stats.NewToysPerDay, 1)
+	s.Empty(stats.MostFavouritedToys)
+	s.Empty(stats.HighestRatedToys)
+	s.Empty(stats.PriceDistribution)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysOnlyAvailable() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(3) // Основной + getToyTags + getToyAttachments
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, available) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, false,
+		2, 2, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt, true,
+	)
+	s.NoError(err)
+
+	toys, err := s.toysRepository.GetToys(
+		s.ctx,
+		nil,
+		&entities.ToysFilters{OnlyAvailable: pointers.New(true)},
+	)
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(2), toys[0].ID)
+	s.True(toys[0].Available)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysWithBannedMaster() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(4) // GetToys + getToyTags + getToyAttachments + CountToys
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt, entities.MasterStatusBanned,
+		2, 2, "Master Info 2", createdAt, createdAt, entities.MasterStatusSuspended,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	// Toys of suspended Masters are still visible:
+	toys, err := s.toysRepository.GetToys(s.ctx, nil, nil)
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(2), toys[0].ID)
+
+	count, err := s.toysRepository.CountToys(s.ctx, nil)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysByIDWithBannedMaster() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(4) // GetToyByID + GetToysByIDs + getToysTags + getToysAttachments
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, status) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt, entities.MasterStatusBanned,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 2, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	toy, err := s.toysRepository.GetToyByID(s.ctx, 1)
+	s.IsType(&customerrors.ToyNotFoundError{}, err)
+	s.Nil(toy)
+
+	toys, err := s.toysRepository.GetToysByIDs(s.ctx, []uint64{1, 2})
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(2), toys[0].ID)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToyByIDWithModerationStatuses() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return ctx, mocktracing.NewMockSpan()
+			},
+		).
+		Times(5) // 2x GetToyByID + getToyTags + getToyAttachments + GetToysByIDs
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"moderation_status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, entities.ToyModerationStatusPending,
+	)
+	s.NoError(err)
+
+	// Not approved Toy is hidden by default:
+	toy, err := s.toysRepository.GetToyByID(s.ctx, 1)
+	s.IsType(&customerrors.ToyNotFoundError{}, err)
+	s.Nil(toy)
+
+	// Owners and moderators can read Toy regardless of moderation status:
+	ctx := visibility.WithAllToyModerationStatuses(s.ctx)
+	toy, err = s.toysRepository.GetToyByID(ctx, 1)
+	s.NoError(err)
+	s.Equal(entities.ToyModerationStatusPending, toy.ModerationStatus)
+
+	toys, err := s.toysRepository.GetToysByIDs(s.ctx, []uint64{1})
+	s.NoError(err)
+	s.Empty(toys)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysWithModerationStatuses() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(7) // 2 * (GetToys + getToyTags + getToyAttachments) + CountToys
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"moderation_status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, entities.ToyModerationStatusPending,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt, entities.ToyModerationStatusApproved,
+	)
+	s.NoError(err)
+
+	// Only approved Toys are visible by default:
+	toys, err := s.toysRepository.GetToys(s.ctx, nil, nil)
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(2), toys[0].ID)
+	s.Equal(entities.ToyModerationStatusApproved, toys[0].ModerationStatus)
+
+	toys, err = s.toysRepository.GetToys(
+		s.ctx,
+		nil,
+		&entities.ToysFilters{
+			ModerationStatuses: []entities.ToyModerationStatus{entities.ToyModerationStatusPending},
+		},
+	)
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(1), toys[0].ID)
+	s.Equal(entities.ToyModerationStatusPending, toys[0].ModerationStatus)
+
+	count, err := s.toysRepository.CountToys(s.ctx, nil)
+	s.NoError(err)
+	s.Equal(uint64(1), count)
+}
+
+func (s *ToysRepositoryTestSuite) TestUpdateToyModeration() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+				return ctx, mocktracing.NewMockSpan()
+			},
+		).
+		Times(4) // UpdateToyModeration + GetToyByID + getToyTags + getToyAttachments
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"moderation_status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, entities.ToyModerationStatusPending,
+	)
+	s.NoError(err)
+
+	err = s.toysRepository.UpdateToyModeration(
+		s.ctx,
+		entities.ToyModerationDTO{
+			ToyID:           1,
+			Status:          entities.ToyModerationStatusRejected,
+			RejectionReason: pointers.New("Ссылки на сторонние сайты запрещены"),
+		},
+	)
+	s.NoError(err)
+
+	toy, err := s.toysRepository.GetToyByID(visibility.WithAllToyModerationStatuses(s.ctx), 1)
+	s.NoError(err)
+	s.Equal(entities.ToyModerationStatusRejected, toy.ModerationStatus)
+	s.Equal(pointers.New("Ссылки на сторонние сайты запрещены"), toy.RejectionReason)
+	s.Nil(toy.Appeal)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetCategoryPriceStats() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"moderation_status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 100, 5, createdAt, createdAt, entities.ToyModerationStatusApproved,
+		2, 1, 2, "Toy 2", "Desc 2", 200, 3, createdAt, createdAt, entities.ToyModerationStatusApproved,
+		3, 1, 2, "Toy 3", "Desc 3", 5000, 3, createdAt, createdAt, entities.ToyModerationStatusPending,
+		4, 1, 3, "Toy 4", "Desc 4", 1000, 3, createdAt, createdAt, entities.ToyModerationStatusApproved,
+	)
+	s.NoError(err)
+
+	stats, err := s.toysRepository.GetCategoryPriceStats(s.ctx, 2)
+	s.NoError(err)
+	s.Equal(uint32(2), stats.CategoryID)
+	s.Equal(uint64(2), stats.ToysCount)
+	s.InDelta(150, stats.AveragePrice, 0.001)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetAllMasterToysWithHiddenToys() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(5) // GetAllMasterToys + 2x(getToyTags + getToyAttachments)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO masters (id, user_id, info, created_at, updated_at, status) VALUES (?, ?, ?, ?, ?, ?)",
+		1, 1, "Master Info 1", createdAt, createdAt, entities.MasterStatusBanned,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"moderation_status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, entities.ToyModerationStatusApproved,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt, entities.ToyModerationStatusRejected,
+		3, 2, 2, "Toy 3", "Desc 3", 19.99, 1, createdAt, createdAt, entities.ToyModerationStatusApproved,
+	)
+	s.NoError(err)
+
+	// Toys are returned, though Master is banned and one of Toys is rejected:
+	toys, err := s.toysRepository.GetAllMasterToys(s.ctx, 1)
+	s.NoError(err)
+	s.Len(toys, 2)
+	s.Equal(uint64(1), toys[0].ID)
+	s.Equal(uint64(2), toys[1].ID)
+	s.Equal(entities.ToyModerationStatusRejected, toys[1].ModerationStatus)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetToysBatch() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(9) // 3x(GetToysBatch + getToysTags + getToysAttachments)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at, "+
+			"moderation_status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt, entities.ToyModerationStatusApproved,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt, entities.ToyModerationStatusRejected,
+		3, 2, 2, "Toy 3", "Desc 3", 19.99, 1, createdAt, createdAt, entities.ToyModerationStatusApproved,
+		4, 1, 2, "Toy 4", "Desc 4", 9.99, 1, createdAt, createdAt, entities.ToyModerationStatusApproved,
+	)
+	s.NoError(err)
+
+	// Rejected Toy is skipped by default filters:
+	toys, err := s.toysRepository.GetToysBatch(s.ctx, nil, nil, 0, 2)
+	s.NoError(err)
+	s.Len(toys, 2)
+	s.Equal(uint64(1), toys[0].ID)
+	s.Equal(uint64(3), toys[1].ID)
+
+	toys, err = s.toysRepository.GetToysBatch(s.ctx, nil, nil, toys[1].ID, 2)
+	s.NoError(err)
+	s.Len(toys, 1)
+	s.Equal(uint64(4), toys[0].ID)
+
+	toys, err = s.toysRepository.GetToysBatch(s.ctx, pointers.New[uint64](1), nil, 0, 10)
+	s.NoError(err)
+	s.Len(toys, 2)
+	s.Equal(uint64(1), toys[0].ID)
+	s.Equal(uint64(4), toys[1].ID)
+}
+
+func (s *ToysRepositoryTestSuite) TestGetUserFavouritesRecords() {
+	s.traceProvider.
+		EXPECT().
+		Span(gomock.Any(), gomock.Any()).
+		Return(context.Background(), mocktracing.NewMockSpan()).
+		Times(1)
+
+	createdAt := time.Now().UTC()
+	_, err := s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO toys (id, master_id, category_id, name, description, price, quantity, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		1, 1, 2, "Toy 1", "Desc 1", 99.99, 5, createdAt, createdAt,
+		2, 1, 2, "Toy 2", "Desc 2", 49.99, 3, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	_, err = s.connection.ExecContext(
+		s.ctx,
+		"INSERT INTO favourites (id, user_id, toy_id, created_at, updated_at) "+
+			"VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, 1, 1, createdAt, createdAt,
+		2, 2, 1, createdAt, createdAt,
+		3, 1, 2, createdAt, createdAt,
+	)
+	s.NoError(err)
+
+	favourites, err := s.toysRepository.GetUserFavouritesRecords(s.ctx, 1)
+	s.NoError(err)
+	s.Len(favourites, 2)
+	s.Equal(uint64(1), favourites[0].ToyID)
+	s.Equal(uint64(2), favourites[1].ToyID)
+}
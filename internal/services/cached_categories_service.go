@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+const allCategoriesCacheKey = "categories:all"
+
+// CachedCategoriesService caches Categories and their rules, which are changed only by migrations.
+// Since there are no writes through service, cached values are refreshed only after TTL expiration.
+type CachedCategoriesService struct {
+	categoriesService interfaces.CategoriesService
+	loader            *cache.Loader
+	ttl               time.Duration
+}
+
+func NewCachedCategoriesService(
+	categoriesService interfaces.CategoriesService,
+	loader *cache.Loader,
+	ttl time.Duration,
+) *CachedCategoriesService {
+	return &CachedCategoriesService{
+		categoriesService: categoriesService,
+		loader:            loader,
+		ttl:               ttl,
+	}
+}
+
+func (service *CachedCategoriesService) GetCategoryByID(
+	ctx context.Context,
+	id uint32,
+) (*entities.Category, error) {
+	return cache.Load(
+		ctx,
+		service.loader,
+		fmt.Sprintf("categories:%d", id),
+		service.ttl,
+		func(ctx context.Context) (*entities.Category, error) {
+			return service.categoriesService.GetCategoryByID(ctx, id)
+		},
+	)
+}
+
+func (service *CachedCategoriesService) GetAllCategories(
+	ctx context.Context,
+) ([]entities.Category, error) {
+	return cache.Load(ctx, service.loader, allCategoriesCacheKey, service.ttl, service.categoriesService.GetAllCategories)
+}
+
+func (service *CachedCategoriesService) GetCategoryRules(
+	ctx context.Context,
+	categoryID uint32,
+) (*entities.CategoryRules, error) {
+	return cache.Load(
+		ctx,
+		service.loader,
+		fmt.Sprintf("categories:%d:rules", categoryID),
+		service.ttl,
+		func(ctx context.Context) (*entities.CategoryRules, error) {
+			return service.categoriesService.GetCategoryRules(ctx, categoryID)
+		},
+	)
+}
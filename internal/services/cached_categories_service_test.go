@@ -0,0 +1,75 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	mockservices "github.com/DKhorkov/hmtm-toys/mocks/services"
+)
+
+func TestCachedCategoriesService(t *testing.T) {
+	mockController := gomock.NewController(t)
+	categoriesService := mockservices.NewMockCategoriesService(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	cachedCategoriesService := services.NewCachedCategoriesService(
+		categoriesService,
+		cache.NewLoader(cache.NewLRU(10), logger),
+		time.Minute,
+	)
+
+	ctx := context.Background()
+	priceFloor := float32(100)
+	notFoundErr := &customerrors.CategoryNotFoundError{}
+
+	categoriesService.
+		EXPECT().
+		GetAllCategories(gomock.Any()).
+		Return([]entities.Category{{ID: 1}}, nil).
+		Times(1)
+
+	categoriesService.
+		EXPECT().
+		GetCategoryByID(gomock.Any(), uint32(1)).
+		Return(&entities.Category{ID: 1}, nil).
+		Times(1)
+
+	categoriesService.
+		EXPECT().
+		GetCategoryRules(gomock.Any(), uint32(1)).
+		Return(&entities.CategoryRules{CategoryID: 1, PriceFloor: &priceFloor}, nil).
+		Times(1)
+
+	// Not found errors are not cached:
+	categoriesService.
+		EXPECT().
+		GetCategoryByID(gomock.Any(), uint32(2)).
+		Return(nil, notFoundErr).
+		Times(2)
+
+	for range 2 {
+		categories, err := cachedCategoriesService.GetAllCategories(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []entities.Category{{ID: 1}}, categories)
+
+		category, err := cachedCategoriesService.GetCategoryByID(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, &entities.Category{ID: 1}, category)
+
+		rules, err := cachedCategoriesService.GetCategoryRules(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, &entities.CategoryRules{CategoryID: 1, PriceFloor: &priceFloor}, rules)
+
+		_, err = cachedCategoriesService.GetCategoryByID(ctx, 2)
+		require.ErrorIs(t, err, notFoundErr)
+	}
+}
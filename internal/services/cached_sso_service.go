@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/libs/logging"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// cachedUser is cache entry of User. Missing User is cached with nil User to avoid repeated SSO calls.
+type cachedUser struct {
+	User      *entities.User `json:"user,omitempty"`
+	FetchedAt time.Time      `json:"fetchedAt"`
+}
+
+// CachedSsoService caches Users by ID. With config.SsoFailurePolicyServeStale Users are kept in cache
+// after TTL expiration for config.StaleTTL, so they can be served, while SSO fails.
+// Users by Email are not cached, because they are requested rarely.
+type CachedSsoService struct {
+	interfaces.SsoService
+
+	cache  interfaces.Cache
+	config config.UsersCacheConfig
+	group  singleflight.Group
+	logger logging.Logger
+}
+
+func NewCachedSsoService(
+	ssoService interfaces.SsoService,
+	cache interfaces.Cache,
+	usersCacheConfig config.UsersCacheConfig,
+	logger logging.Logger,
+) *CachedSsoService {
+	return &CachedSsoService{
+		SsoService: ssoService,
+		cache:      cache,
+		config:     usersCacheConfig,
+		logger:     logger,
+	}
+}
+
+func (service *CachedSsoService) GetUserByID(ctx context.Context, id uint64) (*entities.User, error) {
+	key := fmt.Sprintf("users:%d", id)
+
+	entry, found := service.get(ctx, key)
+	if found && service.isFresh(entry) {
+		return entry.user()
+	}
+
+	// Load is shared by all waiting callers, so it should not be canceled together with the first caller:
+	result, err, _ := service.group.Do(
+		key,
+		func() (any, error) {
+			return service.load(context.WithoutCancel(ctx), key, id)
+		},
+	)
+
+	switch {
+	case err == nil:
+		loadedEntry, _ := result.(cachedUser)
+
+		return loadedEntry.user()
+	case errors.As(err, new(*customerrors.UserNotFoundError)):
+		return nil, err
+	case found && service.config.SsoFailurePolicy == config.SsoFailurePolicyServeStale:
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			fmt.Sprintf("Failed to get User with ID=%d from SSO, serving stale User", id),
+			err,
+		)
+
+		return entry.user()
+	default:
+		return nil, err
+	}
+}
+
+func (service *CachedSsoService) load(ctx context.Context, key string, id uint64) (cachedUser, error) {
+	user, err := service.SsoService.GetUserByID(ctx, id)
+	if err != nil && !errors.As(err, new(*customerrors.UserNotFoundError)) {
+		return cachedUser{}, err
+	}
+
+	entry := cachedUser{User: user, FetchedAt: time.Now()}
+	ttl := service.config.NegativeTTL
+
+	switch {
+	case user != nil && service.config.SsoFailurePolicy == config.SsoFailurePolicyServeStale:
+		ttl = service.config.TTL + service.config.StaleTTL
+	case user != nil:
+		ttl = service.config.TTL
+	}
+
+	// Zero TTL means "without expiration" for Redis, so caching is skipped instead:
+	if ttl <= 0 {
+		return entry, err
+	}
+
+	if data, marshalErr := json.Marshal(entry); marshalErr != nil {
+		logging.LogErrorContext(ctx, service.logger, "Failed to encode "+key, marshalErr)
+	} else if setErr := service.cache.Set(ctx, key, data, ttl); setErr != nil {
+		logging.LogErrorContext(ctx, service.logger, fmt.Sprintf("Failed to set %s to cache", key), setErr)
+	}
+
+	return entry, err
+}
+
+func (service *CachedSsoService) get(ctx context.Context, key string) (cachedUser, bool) {
+	var entry cachedUser
+
+	data, found, err := service.cache.Get(ctx, key)
+	if err != nil {
+		logging.LogErrorContext(ctx, service.logger, fmt.Sprintf("Failed to get %s from cache", key), err)
+
+		return entry, false
+	}
+
+	if !found {
+		return entry, false
+	}
+
+	if err = json.Unmarshal(data, &entry); err != nil {
+		logging.LogErrorContext(ctx, service.logger, fmt.Sprintf("Failed to decode %s from cache", key), err)
+
+		return entry, false
+	}
+
+	return entry, true
+}
+
+// isFresh checks User's TTL. Missing User is fresh, while it is cached, because it is cached for NegativeTTL.
+func (service *CachedSsoService) isFresh(entry cachedUser) bool {
+	return entry.User == nil || time.Since(entry.FetchedAt) < service.config.TTL
+}
+
+func (entry cachedUser) user() (*entities.User, error) {
+	if entry.User == nil {
+		return nil, &customerrors.UserNotFoundError{}
+	}
+
+	return entry.User, nil
+}
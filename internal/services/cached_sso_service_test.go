@@ -0,0 +1,162 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/config"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	mockservices "github.com/DKhorkov/hmtm-toys/mocks/services"
+)
+
+func TestCachedSsoService_GetUserByID(t *testing.T) {
+	ssoErr := errors.New("sso is unavailable")
+
+	testCases := []struct {
+		name        string
+		config      config.UsersCacheConfig
+		setupMocks  func(ssoService *mockservices.MockSsoService, logger *loggermock.MockLogger)
+		expected    []*entities.User // results of consecutive calls.
+		expectedErr []error
+	}{
+		{
+			name: "User is cached",
+			config: config.UsersCacheConfig{
+				TTL:              time.Minute,
+				SsoFailurePolicy: config.SsoFailurePolicyFailClosed,
+			},
+			setupMocks: func(ssoService *mockservices.MockSsoService, _ *loggermock.MockLogger) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(1)).
+					Return(&entities.User{ID: 1}, nil).
+					Times(1)
+			},
+			expected:    []*entities.User{{ID: 1}, {ID: 1}},
+			expectedErr: []error{nil, nil},
+		},
+		{
+			name: "missing User is cached",
+			config: config.UsersCacheConfig{
+				TTL:              time.Minute,
+				NegativeTTL:      time.Minute,
+				SsoFailurePolicy: config.SsoFailurePolicyFailClosed,
+			},
+			setupMocks: func(ssoService *mockservices.MockSsoService, _ *loggermock.MockLogger) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.UserNotFoundError{}).
+					Times(1)
+			},
+			expected:    []*entities.User{nil, nil},
+			expectedErr: []error{&customerrors.UserNotFoundError{}, &customerrors.UserNotFoundError{}},
+		},
+		{
+			name: "missing User is not cached without negative TTL",
+			config: config.UsersCacheConfig{
+				TTL:              time.Minute,
+				SsoFailurePolicy: config.SsoFailurePolicyFailClosed,
+			},
+			setupMocks: func(ssoService *mockservices.MockSsoService, _ *loggermock.MockLogger) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(1)).
+					Return(nil, &customerrors.UserNotFoundError{}).
+					Times(2)
+			},
+			expected:    []*entities.User{nil, nil},
+			expectedErr: []error{&customerrors.UserNotFoundError{}, &customerrors.UserNotFoundError{}},
+		},
+		{
+			name: "expired User is served, while SSO is unavailable",
+			config: config.UsersCacheConfig{
+				TTL:              time.Nanosecond,
+				StaleTTL:         time.Minute,
+				SsoFailurePolicy: config.SsoFailurePolicyServeStale,
+			},
+			setupMocks: func(ssoService *mockservices.MockSsoService, logger *loggermock.MockLogger) {
+				gomock.InOrder(
+					ssoService.
+						EXPECT().
+						GetUserByID(gomock.Any(), uint64(1)).
+						Return(&entities.User{ID: 1}, nil).
+						Times(1),
+					ssoService.
+						EXPECT().
+						GetUserByID(gomock.Any(), uint64(1)).
+						Return(nil, ssoErr).
+						Times(1),
+				)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			expected:    []*entities.User{{ID: 1}, {ID: 1}},
+			expectedErr: []error{nil, nil},
+		},
+		{
+			name: "expired User is not served, while SSO is unavailable",
+			config: config.UsersCacheConfig{
+				TTL:              time.Nanosecond,
+				StaleTTL:         time.Minute,
+				SsoFailurePolicy: config.SsoFailurePolicyFailClosed,
+			},
+			setupMocks: func(ssoService *mockservices.MockSsoService, _ *loggermock.MockLogger) {
+				gomock.InOrder(
+					ssoService.
+						EXPECT().
+						GetUserByID(gomock.Any(), uint64(1)).
+						Return(&entities.User{ID: 1}, nil).
+						Times(1),
+					ssoService.
+						EXPECT().
+						GetUserByID(gomock.Any(), uint64(1)).
+						Return(nil, ssoErr).
+						Times(1),
+				)
+			},
+			expected:    []*entities.User{{ID: 1}, nil},
+			expectedErr: []error{nil, ssoErr},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			ssoService := mockservices.NewMockSsoService(mockController)
+			logger := loggermock.NewMockLogger(mockController)
+			cachedSsoService := services.NewCachedSsoService(ssoService, cache.NewLRU(10), tc.config, logger)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(ssoService, logger)
+			}
+
+			for i, expected := range tc.expected {
+				user, err := cachedSsoService.GetUserByID(ctx, 1)
+				if tc.expectedErr[i] != nil {
+					require.Error(t, err)
+					require.IsType(t, tc.expectedErr[i], err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				require.Equal(t, expected, user)
+			}
+		})
+	}
+}
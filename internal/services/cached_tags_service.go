@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+const allTagsCacheKey = "tags:all"
+
+// CachedTagsService caches Tags. List of all Tags is invalidated, when new Tags are created.
+type CachedTagsService struct {
+	tagsService interfaces.TagsService
+	loader      *cache.Loader
+	ttl         time.Duration
+}
+
+func NewCachedTagsService(
+	tagsService interfaces.TagsService,
+	loader *cache.Loader,
+	ttl time.Duration,
+) *CachedTagsService {
+	return &CachedTagsService{
+		tagsService: tagsService,
+		loader:      loader,
+		ttl:         ttl,
+	}
+}
+
+func (service *CachedTagsService) GetTagByID(ctx context.Context, id uint32) (*entities.Tag, error) {
+	return cache.Load(
+		ctx,
+		service.loader,
+		fmt.Sprintf("tags:%d", id),
+		service.ttl,
+		func(ctx context.Context) (*entities.Tag, error) {
+			return service.tagsService.GetTagByID(ctx, id)
+		},
+	)
+}
+
+func (service *CachedTagsService) GetAllTags(ctx context.Context) ([]entities.Tag, error) {
+	return cache.Load(ctx, service.loader, allTagsCacheKey, service.ttl, service.tagsService.GetAllTags)
+}
+
+func (service *CachedTagsService) CreateTags(
+	ctx context.Context,
+	tagsData []entities.CreateTagDTO,
+) ([]uint32, error) {
+	tagIDs, err := service.tagsService.CreateTags(ctx, tagsData)
+	if err != nil {
+		return nil, err
+	}
+
+	service.loader.Invalidate(ctx, allTagsCacheKey)
+
+	return tagIDs, nil
+}
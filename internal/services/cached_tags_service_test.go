@@ -0,0 +1,64 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	mockservices "github.com/DKhorkov/hmtm-toys/mocks/services"
+)
+
+func TestCachedTagsService_GetAllTags(t *testing.T) {
+	mockController := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	cachedTagsService := services.NewCachedTagsService(
+		tagsService,
+		cache.NewLoader(cache.NewLRU(10), logger),
+		time.Minute,
+	)
+
+	ctx := context.Background()
+	tagsData := []entities.CreateTagDTO{{Name: "Новый"}}
+
+	gomock.InOrder(
+		tagsService.
+			EXPECT().
+			GetAllTags(gomock.Any()).
+			Return([]entities.Tag{{ID: 1}}, nil).
+			Times(1),
+		tagsService.
+			EXPECT().
+			CreateTags(gomock.Any(), tagsData).
+			Return([]uint32{2}, nil).
+			Times(1),
+		tagsService.
+			EXPECT().
+			GetAllTags(gomock.Any()).
+			Return([]entities.Tag{{ID: 1}, {ID: 2}}, nil).
+			Times(1),
+	)
+
+	// The second call is served from cache:
+	for range 2 {
+		tags, err := cachedTagsService.GetAllTags(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []entities.Tag{{ID: 1}}, tags)
+	}
+
+	tagIDs, err := cachedTagsService.CreateTags(ctx, tagsData)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{2}, tagIDs)
+
+	tags, err := cachedTagsService.GetAllTags(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []entities.Tag{{ID: 1}, {ID: 2}}, tags)
+}
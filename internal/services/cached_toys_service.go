@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
+)
+
+// CachedToysService caches Toys by ID, which are the hottest reads of catalogue. Cached Toy is invalidated
+// by writes through service. Data, which is changed by other services, such as rating after new Review
+// or availability after Master's vacation, is refreshed after TTL expiration, so TTL should be short.
+// Lists are not cached, because their pagination and filters give too many keys with low hit rate.
+type CachedToysService struct {
+	interfaces.ToysService
+
+	loader *cache.Loader
+	ttl    time.Duration
+}
+
+func NewCachedToysService(
+	toysService interfaces.ToysService,
+	loader *cache.Loader,
+	ttl time.Duration,
+) *CachedToysService {
+	return &CachedToysService{
+		ToysService: toysService,
+		loader:      loader,
+		ttl:         ttl,
+	}
+}
+
+// GetToyByID always loads Toy with all relations and moderation statuses, because cached Toy is shared
+// by requests with different read masks and visibility. Not requested relations are dropped by handler.
+func (service *CachedToysService) GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error) {
+	toy, err := cache.Load(
+		ctx,
+		service.loader,
+		toyCacheKey(id),
+		service.ttl,
+		func(ctx context.Context) (*entities.Toy, error) {
+			return service.ToysService.GetToyByID(
+				visibility.WithAllToyModerationStatuses(
+					readmasks.WithToyRelations(ctx, readmasks.AllToyRelations()),
+				),
+				id,
+			)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(visibility.ToyModerationStatusesFromContext(ctx), toy.ModerationStatus) {
+		return nil, &customerrors.ToyNotFoundError{}
+	}
+
+	return toy, nil
+}
+
+func (service *CachedToysService) DeleteToy(ctx context.Context, id uint64) error {
+	if err := service.ToysService.DeleteToy(ctx, id); err != nil {
+		return err
+	}
+
+	service.loader.Invalidate(ctx, toyCacheKey(id))
+
+	return nil
+}
+
+func (service *CachedToysService) DeleteMasterToys(ctx context.Context, masterID uint64) ([]uint64, error) {
+	toysIDs, err := service.ToysService.DeleteMasterToys(ctx, masterID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(toysIDs))
+	for i, toyID := range toysIDs {
+		keys[i] = toyCacheKey(toyID)
+	}
+
+	service.loader.Invalidate(ctx, keys...)
+
+	return toysIDs, nil
+}
+
+func (service *CachedToysService) UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error {
+	if err := service.ToysService.UpdateToy(ctx, toyData); err != nil {
+		return err
+	}
+
+	service.loader.Invalidate(ctx, toyCacheKey(toyData.ID))
+
+	return nil
+}
+
+func (service *CachedToysService) UpdateToyModeration(
+	ctx context.Context,
+	moderationData entities.ToyModerationDTO,
+) error {
+	if err := service.ToysService.UpdateToyModeration(ctx, moderationData); err != nil {
+		return err
+	}
+
+	service.loader.Invalidate(ctx, toyCacheKey(moderationData.ToyID))
+
+	return nil
+}
+
+// AddFavourite invalidates Toy, because it contains count of favourites.
+func (service *CachedToysService) AddFavourite(ctx context.Context, userID, toyID uint64) (uint64, error) {
+	favouriteID, err := service.ToysService.AddFavourite(ctx, userID, toyID)
+	if err != nil {
+		return 0, err
+	}
+
+	service.loader.Invalidate(ctx, toyCacheKey(toyID))
+
+	return favouriteID, nil
+}
+
+// RemoveFavourite invalidates Toy, because it contains count of favourites.
+func (service *CachedToysService) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	if err := service.ToysService.RemoveFavourite(ctx, userID, toyID); err != nil {
+		return err
+	}
+
+	service.loader.Invalidate(ctx, toyCacheKey(toyID))
+
+	return nil
+}
+
+func toyCacheKey(id uint64) string {
+	return fmt.Sprintf("toys:%d", id)
+}
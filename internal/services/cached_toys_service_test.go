@@ -0,0 +1,258 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/cache"
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/readmasks"
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
+	mockservices "github.com/DKhorkov/hmtm-toys/mocks/services"
+)
+
+func TestCachedToysService_Invalidation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		write         func(ctx context.Context, toysService *services.CachedToysService) error
+		setupMocks    func(toysService *mockservices.MockToysService)
+		invalidated   bool
+		errorExpected bool
+	}{
+		{
+			name: "Toy updated",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				return toysService.UpdateToy(ctx, entities.UpdateToyDTO{ID: 1})
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					UpdateToy(gomock.Any(), entities.UpdateToyDTO{ID: 1}).
+					Return(nil).
+					Times(1)
+			},
+			invalidated: true,
+		},
+		{
+			name: "failed to update Toy",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				return toysService.UpdateToy(ctx, entities.UpdateToyDTO{ID: 1})
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					UpdateToy(gomock.Any(), entities.UpdateToyDTO{ID: 1}).
+					Return(errors.New("test")).
+					Times(1)
+			},
+			invalidated:   false,
+			errorExpected: true,
+		},
+		{
+			name: "Toy deleted",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				return toysService.DeleteToy(ctx, 1)
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					DeleteToy(gomock.Any(), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+			invalidated: true,
+		},
+		{
+			name: "Toys of Master deleted",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				_, err := toysService.DeleteMasterToys(ctx, 3)
+
+				return err
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					DeleteMasterToys(gomock.Any(), uint64(3)).
+					Return([]uint64{1, 2}, nil).
+					Times(1)
+			},
+			invalidated: true,
+		},
+		{
+			name: "Toy moderated",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				return toysService.UpdateToyModeration(ctx, entities.ToyModerationDTO{ToyID: 1})
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					UpdateToyModeration(gomock.Any(), entities.ToyModerationDTO{ToyID: 1}).
+					Return(nil).
+					Times(1)
+			},
+			invalidated: true,
+		},
+		{
+			name: "Toy added to favourites",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				_, err := toysService.AddFavourite(ctx, 2, 1)
+
+				return err
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					AddFavourite(gomock.Any(), uint64(2), uint64(1)).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			invalidated: true,
+		},
+		{
+			name: "Toy removed from favourites",
+			write: func(ctx context.Context, toysService *services.CachedToysService) error {
+				return toysService.RemoveFavourite(ctx, 2, 1)
+			},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					RemoveFavourite(gomock.Any(), uint64(2), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+			invalidated: true,
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockController := gomock.NewController(t)
+			toysService := mockservices.NewMockToysService(mockController)
+			logger := loggermock.NewMockLogger(mockController)
+			cachedToysService := services.NewCachedToysService(
+				toysService,
+				cache.NewLoader(cache.NewLRU(10), logger),
+				time.Minute,
+			)
+
+			loadsCount := 1
+			if tc.invalidated {
+				loadsCount++
+			}
+
+			toysService.
+				EXPECT().
+				GetToyByID(gomock.Any(), uint64(1)).
+				Return(&entities.Toy{ID: 1, ModerationStatus: entities.ToyModerationStatusApproved}, nil).
+				Times(loadsCount)
+
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService)
+			}
+
+			toy, err := cachedToysService.GetToyByID(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, &entities.Toy{ID: 1, ModerationStatus: entities.ToyModerationStatusApproved}, toy)
+
+			err = tc.write(ctx, cachedToysService)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			toy, err = cachedToysService.GetToyByID(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, &entities.Toy{ID: 1, ModerationStatus: entities.ToyModerationStatusApproved}, toy)
+		})
+	}
+}
+
+func TestCachedToysService_GetToyByIDWithReadMask(t *testing.T) {
+	mockController := gomock.NewController(t)
+	toysService := mockservices.NewMockToysService(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	cachedToysService := services.NewCachedToysService(
+		toysService,
+		cache.NewLoader(cache.NewLRU(10), logger),
+		time.Minute,
+	)
+
+	toy := &entities.Toy{
+		ID:               1,
+		ModerationStatus: entities.ToyModerationStatusApproved,
+		Tags:             []entities.Tag{{ID: 1}},
+	}
+
+	// Toy is cached with all relations, even if the first request needs only some of them:
+	toysService.
+		EXPECT().
+		GetToyByID(gomock.Any(), uint64(1)).
+		DoAndReturn(
+			func(ctx context.Context, _ uint64) (*entities.Toy, error) {
+				require.Equal(t, readmasks.AllToyRelations(), readmasks.ToyRelationsFromContext(ctx))
+
+				return toy, nil
+			},
+		).
+		Times(1)
+
+	ctx := readmasks.WithToyRelations(context.Background(), readmasks.ToyRelations{})
+
+	actual, err := cachedToysService.GetToyByID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, toy, actual)
+
+	actual, err = cachedToysService.GetToyByID(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, toy, actual)
+}
+
+func TestCachedToysService_GetToyByIDWithModerationStatuses(t *testing.T) {
+	mockController := gomock.NewController(t)
+	toysService := mockservices.NewMockToysService(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	cachedToysService := services.NewCachedToysService(
+		toysService,
+		cache.NewLoader(cache.NewLRU(10), logger),
+		time.Minute,
+	)
+
+	toy := &entities.Toy{ID: 1, ModerationStatus: entities.ToyModerationStatusPending}
+
+	// Toy is cached regardless of moderation status, which is checked for each request:
+	toysService.
+		EXPECT().
+		GetToyByID(gomock.Any(), uint64(1)).
+		DoAndReturn(
+			func(ctx context.Context, _ uint64) (*entities.Toy, error) {
+				require.Equal(
+					t,
+					visibility.AllToyModerationStatuses(),
+					visibility.ToyModerationStatusesFromContext(ctx),
+				)
+
+				return toy, nil
+			},
+		).
+		Times(1)
+
+	actual, err := cachedToysService.GetToyByID(visibility.WithAllToyModerationStatuses(context.Background()), 1)
+	require.NoError(t, err)
+	require.Equal(t, toy, actual)
+
+	actual, err = cachedToysService.GetToyByID(context.Background(), 1)
+	require.IsType(t, &customerrors.ToyNotFoundError{}, err)
+	require.Nil(t, actual)
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
@@ -39,7 +40,11 @@ func (service *CategoriesService) GetCategoryByID(
 			err,
 		)
 
-		return nil, &customerrors.CategoryNotFoundError{}
+		if errors.As(err, new(*customerrors.CategoryNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Category with ID=%d: %w", id, err)
 	}
 
 	return category, nil
@@ -50,3 +55,26 @@ func (service *CategoriesService) GetAllCategories(
 ) ([]entities.Category, error) {
 	return service.categoriesRepository.GetAllCategories(ctx)
 }
+
+func (service *CategoriesService) GetCategoryRules(
+	ctx context.Context,
+	categoryID uint32,
+) (*entities.CategoryRules, error) {
+	rules, err := service.categoriesRepository.GetCategoryRules(ctx, categoryID)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			fmt.Sprintf("Error occurred while trying to get rules of Category with ID=%d", categoryID),
+			err,
+		)
+
+		if errors.As(err, new(*customerrors.CategoryNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get rules of Category with ID=%d: %w", categoryID, err)
+	}
+
+	return rules, nil
+}
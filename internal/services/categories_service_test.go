@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -18,6 +19,8 @@ import (
 )
 
 func TestCategoriesService_GetCategoryByID(t *testing.T) {
+	notFoundErr := &customerrors.CategoryNotFoundError{}
+
 	testCases := []struct {
 		name          string
 		categoryID    uint32
@@ -46,7 +49,25 @@ func TestCategoriesService_GetCategoryByID(t *testing.T) {
 				categoriesRepository.
 					EXPECT().
 					GetCategoryByID(gomock.Any(), uint32(2)).
-					Return(nil, &customerrors.CategoryNotFoundError{}).
+					Return(nil, notFoundErr).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           notFoundErr,
+		},
+		{
+			name:       "database error",
+			categoryID: 3,
+			setupMocks: func(categoriesRepository *mockrepositories.MockCategoriesRepository, logger *loggermock.MockLogger) {
+				categoriesRepository.
+					EXPECT().
+					GetCategoryByID(gomock.Any(), uint32(3)).
+					Return(nil, sql.ErrConnDone).
 					Times(1)
 
 				logger.
@@ -55,7 +76,7 @@ func TestCategoriesService_GetCategoryByID(t *testing.T) {
 					Times(1)
 			},
 			errorExpected: true,
-			err:           &customerrors.CategoryNotFoundError{},
+			err:           sql.ErrConnDone,
 		},
 	}
 
@@ -74,7 +95,7 @@ func TestCategoriesService_GetCategoryByID(t *testing.T) {
 			category, err := categoriesService.GetCategoryByID(ctx, tc.categoryID)
 			if tc.errorExpected {
 				require.Error(t, err)
-				require.IsType(t, tc.err, err)
+				require.ErrorIs(t, err, tc.err)
 				assert.Nil(t, category)
 			} else {
 				require.NoError(t, err)
@@ -154,3 +175,89 @@ func TestCategoriesService_GetAllCategories(t *testing.T) {
 		})
 	}
 }
+
+func TestCategoriesService_GetCategoryRules(t *testing.T) {
+	notFoundErr := &customerrors.CategoryNotFoundError{}
+
+	testCases := []struct {
+		name          string
+		categoryID    uint32
+		expected      *entities.CategoryRules
+		setupMocks    func(categoriesRepository *mockrepositories.MockCategoriesRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:       "successfully got Category rules",
+			categoryID: 1,
+			expected:   &entities.CategoryRules{CategoryID: 1},
+			setupMocks: func(categoriesRepository *mockrepositories.MockCategoriesRepository, _ *loggermock.MockLogger) {
+				categoriesRepository.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), uint32(1)).
+					Return(&entities.CategoryRules{CategoryID: 1}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:       "failed to get Category rules",
+			categoryID: 2,
+			setupMocks: func(categoriesRepository *mockrepositories.MockCategoriesRepository, logger *loggermock.MockLogger) {
+				categoriesRepository.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), uint32(2)).
+					Return(nil, notFoundErr).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           notFoundErr,
+		},
+		{
+			name:       "database error",
+			categoryID: 3,
+			setupMocks: func(categoriesRepository *mockrepositories.MockCategoriesRepository, logger *loggermock.MockLogger) {
+				categoriesRepository.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), uint32(3)).
+					Return(nil, sql.ErrConnDone).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           sql.ErrConnDone,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	categoriesRepository := mockrepositories.NewMockCategoriesRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	categoriesService := services.NewCategoriesService(categoriesRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(categoriesRepository, logger)
+			}
+
+			rules, err := categoriesService.GetCategoryRules(ctx, tc.categoryID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.err)
+				assert.Nil(t, rules)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, rules)
+			}
+		})
+	}
+}
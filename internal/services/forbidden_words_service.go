@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+// NewForbiddenWordsService creates ForbiddenWordsService with empty dictionary.
+// ReloadForbiddenWords should be called to load dictionary from repository.
+func NewForbiddenWordsService(
+	forbiddenWordsRepository interfaces.ForbiddenWordsRepository,
+	logger logging.Logger,
+) *ForbiddenWordsService {
+	return &ForbiddenWordsService{
+		forbiddenWordsRepository: forbiddenWordsRepository,
+		logger:                   logger,
+		replacer:                 newForbiddenWordsReplacer(),
+	}
+}
+
+type ForbiddenWordsService struct {
+	forbiddenWordsRepository interfaces.ForbiddenWordsRepository
+	logger                   logging.Logger
+	replacer                 *strings.Replacer
+	mutex                    sync.RWMutex
+	words                    []string // normalized forbidden words.
+}
+
+func (service *ForbiddenWordsService) GetForbiddenWords(ctx context.Context) ([]string, error) {
+	return service.forbiddenWordsRepository.GetForbiddenWords(ctx)
+}
+
+// ReloadForbiddenWords replaces dictionary with words from repository.
+// Previous dictionary is kept, if words can't be loaded.
+func (service *ForbiddenWordsService) ReloadForbiddenWords(ctx context.Context) (int, error) {
+	rawWords, err := service.forbiddenWordsRepository.GetForbiddenWords(ctx)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			"Error occurred while trying to load forbidden words",
+			err,
+		)
+
+		return 0, err
+	}
+
+	uniqueWords := make(map[string]struct{}, len(rawWords))
+	words := make([]string, 0, len(rawWords))
+
+	for _, rawWord := range rawWords {
+		word := service.normalize(strings.TrimSpace(rawWord))
+		if word == "" {
+			continue
+		}
+
+		if _, ok := uniqueWords[word]; ok {
+			continue
+		}
+
+		uniqueWords[word] = struct{}{}
+		words = append(words, word)
+	}
+
+	service.mutex.Lock()
+	service.words = words
+	service.mutex.Unlock()
+
+	return len(words), nil
+}
+
+// ContainsForbiddenWords checks value against dictionary ignoring case, difference between "ё" and "е"
+// and Latin letters or digits, which are commonly used instead of similar Cyrillic letters.
+func (service *ForbiddenWordsService) ContainsForbiddenWords(value string) bool {
+	normalizedValue := service.normalize(value)
+
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	for _, word := range service.words {
+		if strings.Contains(normalizedValue, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (service *ForbiddenWordsService) normalize(value string) string {
+	return service.replacer.Replace(strings.ToLower(value))
+}
+
+// newForbiddenWordsReplacer creates replacer, which folds lowercase value to Cyrillic letters.
+func newForbiddenWordsReplacer() *strings.Replacer {
+	return strings.NewReplacer(
+		"ё", "е",
+		"a", "а",
+		"b", "в",
+		"c", "с",
+		"e", "е",
+		"h", "н",
+		"k", "к",
+		"m", "м",
+		"o", "о",
+		"p", "р",
+		"t", "т",
+		"u", "и",
+		"x", "х",
+		"y", "у",
+		"@", "а",
+		"0", "о",
+		"3", "з",
+		"4", "ч",
+		"6", "б",
+	)
+}
@@ -0,0 +1,173 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-toys/mocks/repositories"
+)
+
+func TestForbiddenWordsService_ReloadForbiddenWords(t *testing.T) {
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			forbiddenWordsRepository *mockrepositories.MockForbiddenWordsRepository,
+			logger *loggermock.MockLogger,
+		)
+		expected      int
+		errorExpected bool
+	}{
+		{
+			name: "success with duplicates and empty words",
+			setupMocks: func(
+				forbiddenWordsRepository *mockrepositories.MockForbiddenWordsRepository,
+				_ *loggermock.MockLogger,
+			) {
+				forbiddenWordsRepository.
+					EXPECT().
+					GetForbiddenWords(gomock.Any()).
+					Return([]string{"Ёжик", "ежик", " ", "жаба"}, nil).
+					Times(1)
+			},
+			expected: 2,
+		},
+		{
+			name: "error",
+			setupMocks: func(
+				forbiddenWordsRepository *mockrepositories.MockForbiddenWordsRepository,
+				logger *loggermock.MockLogger,
+			) {
+				forbiddenWordsRepository.
+					EXPECT().
+					GetForbiddenWords(gomock.Any()).
+					Return(nil, errors.New("test")).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	forbiddenWordsRepository := mockrepositories.NewMockForbiddenWordsRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	forbiddenWordsService := services.NewForbiddenWordsService(forbiddenWordsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(forbiddenWordsRepository, logger)
+			}
+
+			actual, err := forbiddenWordsService.ReloadForbiddenWords(ctx)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestForbiddenWordsService_ContainsForbiddenWords(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{
+			name:     "exact match",
+			value:    "Игрушка для ежика",
+			expected: true,
+		},
+		{
+			name:     "different case",
+			value:    "ИГРУШКА ДЛЯ ЕЖИКА",
+			expected: true,
+		},
+		{
+			name:     "ё instead of е",
+			value:    "Игрушка для ёжика",
+			expected: true,
+		},
+		{
+			name:     "Latin letters and digits instead of Cyrillic",
+			value:    "Игрушка для eжuкa и 6ук",
+			expected: true,
+		},
+		{
+			name:     "no forbidden words",
+			value:    "Игрушка для котика",
+			expected: false,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	forbiddenWordsRepository := mockrepositories.NewMockForbiddenWordsRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	forbiddenWordsService := services.NewForbiddenWordsService(forbiddenWordsRepository, logger)
+	ctx := context.Background()
+
+	forbiddenWordsRepository.
+		EXPECT().
+		GetForbiddenWords(gomock.Any()).
+		Return([]string{"ЁЖИК", "бук"}, nil).
+		Times(1)
+
+	_, err := forbiddenWordsService.ReloadForbiddenWords(ctx)
+	require.NoError(t, err)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := forbiddenWordsService.ContainsForbiddenWords(tc.value)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestForbiddenWordsService_ReloadForbiddenWordsKeepsPreviousDictionary(t *testing.T) {
+	mockController := gomock.NewController(t)
+	forbiddenWordsRepository := mockrepositories.NewMockForbiddenWordsRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	forbiddenWordsService := services.NewForbiddenWordsService(forbiddenWordsRepository, logger)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		forbiddenWordsRepository.
+			EXPECT().
+			GetForbiddenWords(gomock.Any()).
+			Return([]string{"жаба"}, nil).
+			Times(1),
+		forbiddenWordsRepository.
+			EXPECT().
+			GetForbiddenWords(gomock.Any()).
+			Return(nil, errors.New("test")).
+			Times(1),
+	)
+
+	logger.
+		EXPECT().
+		ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	_, err := forbiddenWordsService.ReloadForbiddenWords(ctx)
+	require.NoError(t, err)
+
+	_, err = forbiddenWordsService.ReloadForbiddenWords(ctx)
+	require.Error(t, err)
+	require.True(t, forbiddenWordsService.ContainsForbiddenWords("Жаба"))
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/DKhorkov/libs/logging"
 
@@ -39,7 +41,11 @@ func (service *MastersService) GetMasterByID(
 			err,
 		)
 
-		return nil, &customerrors.MasterNotFoundError{}
+		if errors.As(err, new(*customerrors.MasterNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Master with ID=%d: %w", id, err)
 	}
 
 	return master, nil
@@ -58,7 +64,34 @@ func (service *MastersService) GetMasterByUserID(
 			err,
 		)
 
-		return nil, &customerrors.MasterNotFoundError{}
+		if errors.As(err, new(*customerrors.MasterNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Master by userID=%d: %w", userID, err)
+	}
+
+	return master, nil
+}
+
+func (service *MastersService) GetMasterBySlug(
+	ctx context.Context,
+	slug string,
+) (*entities.Master, error) {
+	master, err := service.mastersRepository.GetMasterBySlug(ctx, slug)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			"Error occurred while trying to get Master by slug="+slug,
+			err,
+		)
+
+		if errors.As(err, new(*customerrors.MasterNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Master by slug=%s: %w", slug, err)
 	}
 
 	return master, nil
@@ -80,7 +113,11 @@ func (service *MastersService) RegisterMaster(
 	ctx context.Context,
 	masterData entities.RegisterMasterDTO,
 ) (uint64, error) {
-	master, _ := service.mastersRepository.GetMasterByUserID(ctx, masterData.UserID)
+	master, err := service.mastersRepository.GetMasterByUserID(ctx, masterData.UserID)
+	if err != nil && !errors.As(err, new(*customerrors.MasterNotFoundError)) {
+		return 0, fmt.Errorf("failed to check Master existence for userID=%d: %w", masterData.UserID, err)
+	}
+
 	if master != nil {
 		return 0, &customerrors.MasterAlreadyExistsError{}
 	}
@@ -88,9 +125,97 @@ func (service *MastersService) RegisterMaster(
 	return service.mastersRepository.RegisterMaster(ctx, masterData)
 }
 
+// UpdateMaster changes profile of Master. Slug is checked here only as a fast path, because concurrent updates
+// with the same slug are resolved by repository.
 func (service *MastersService) UpdateMaster(
 	ctx context.Context,
 	masterData entities.UpdateMasterDTO,
 ) error {
+	if masterData.Slug != nil {
+		master, err := service.mastersRepository.GetMasterBySlug(ctx, *masterData.Slug)
+		if err != nil && !errors.As(err, new(*customerrors.MasterNotFoundError)) {
+			return fmt.Errorf("failed to check Master slug=%s existence: %w", *masterData.Slug, err)
+		}
+
+		if master != nil && master.ID != masterData.ID {
+			return &customerrors.MasterSlugAlreadyExistsError{}
+		}
+	}
+
 	return service.mastersRepository.UpdateMaster(ctx, masterData)
 }
+
+func (service *MastersService) GetFollowedMasters(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Master, error) {
+	return service.mastersRepository.GetFollowedMasters(ctx, userID, pagination)
+}
+
+func (service *MastersService) CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error) {
+	return service.mastersRepository.CountFollowedMasters(ctx, userID)
+}
+
+func (service *MastersService) GetFollow(
+	ctx context.Context,
+	userID uint64,
+	masterID uint64,
+) (*entities.Follow, error) {
+	follow, err := service.mastersRepository.GetFollow(ctx, userID, masterID)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			fmt.Sprintf("Error occurred while trying to get Follow for User with ID=%d and Master with ID=%d", userID, masterID),
+			err,
+		)
+
+		if errors.As(err, new(*customerrors.FollowNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Follow for User with ID=%d and Master with ID=%d: %w", userID, masterID, err)
+	}
+
+	return follow, nil
+}
+
+// FollowMaster subscribes User to Master. Existence is checked by repository within insert, so concurrent
+// follows of the same Master return FollowAlreadyExistsError.
+func (service *MastersService) FollowMaster(ctx context.Context, userID, masterID uint64) (uint64, error) {
+	return service.mastersRepository.FollowMaster(ctx, userID, masterID)
+}
+
+func (service *MastersService) UnfollowMaster(ctx context.Context, userID, masterID uint64) error {
+	if _, err := service.GetFollow(ctx, userID, masterID); err != nil {
+		return err
+	}
+
+	return service.mastersRepository.UnfollowMaster(ctx, userID, masterID)
+}
+
+func (service *MastersService) SetVacationMode(
+	ctx context.Context,
+	vacationData entities.SetVacationModeDTO,
+) error {
+	return service.mastersRepository.SetVacationMode(ctx, vacationData)
+}
+
+func (service *MastersService) EndExpiredVacations(ctx context.Context, now time.Time) (uint64, error) {
+	return service.mastersRepository.EndExpiredVacations(ctx, now)
+}
+
+func (service *MastersService) ChangeMasterStatus(
+	ctx context.Context,
+	statusData entities.ChangeMasterStatusDTO,
+) error {
+	return service.mastersRepository.ChangeMasterStatus(ctx, statusData)
+}
+
+func (service *MastersService) GetMasterStatusHistory(
+	ctx context.Context,
+	masterID uint64,
+) ([]entities.MasterStatusChange, error) {
+	return service.mastersRepository.GetMasterStatusHistory(ctx, masterID)
+}
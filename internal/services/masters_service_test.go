@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -19,6 +20,8 @@ import (
 )
 
 func TestMastersService_GetMasterByID(t *testing.T) {
+	notFoundErr := &customerrors.MasterNotFoundError{}
+
 	testCases := []struct {
 		name          string
 		masterID      uint64
@@ -47,7 +50,7 @@ func TestMastersService_GetMasterByID(t *testing.T) {
 				mastersRepository.
 					EXPECT().
 					GetMasterByID(gomock.Any(), uint64(2)).
-					Return(nil, &customerrors.MasterNotFoundError{}).
+					Return(nil, notFoundErr).
 					Times(1)
 
 				logger.
@@ -56,7 +59,25 @@ func TestMastersService_GetMasterByID(t *testing.T) {
 					Times(1)
 			},
 			errorExpected: true,
-			err:           &customerrors.MasterNotFoundError{},
+			err:           notFoundErr,
+		},
+		{
+			name:     "database error",
+			masterID: 3,
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, logger *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetMasterByID(gomock.Any(), uint64(3)).
+					Return(nil, sql.ErrConnDone).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           sql.ErrConnDone,
 		},
 	}
 
@@ -75,7 +96,7 @@ func TestMastersService_GetMasterByID(t *testing.T) {
 			master, err := mastersService.GetMasterByID(ctx, tc.masterID)
 			if tc.errorExpected {
 				require.Error(t, err)
-				require.IsType(t, tc.err, err)
+				require.ErrorIs(t, err, tc.err)
 				assert.Nil(t, master)
 			} else {
 				require.NoError(t, err)
@@ -150,6 +171,72 @@ func TestMastersService_GetMasterByUserID(t *testing.T) {
 	}
 }
 
+func TestMastersService_GetMasterBySlug(t *testing.T) {
+	testCases := []struct {
+		name          string
+		slug          string
+		expected      *entities.Master
+		setupMocks    func(mastersRepository *mockrepositories.MockMastersRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:     "successfully got Master by slug",
+			slug:     "test-shop",
+			expected: &entities.Master{ID: 1},
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, _ *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "test-shop").
+					Return(&entities.Master{ID: 1}, nil).
+					Times(1)
+			},
+			errorExpected: false,
+		},
+		{
+			name: "failed to get Master by slug",
+			slug: "unknown-shop",
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, logger *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "unknown-shop").
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.MasterNotFoundError{},
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	mastersRepository := mockrepositories.NewMockMastersRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	mastersService := services.NewMastersService(mastersRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersRepository, logger)
+			}
+
+			master, err := mastersService.GetMasterBySlug(ctx, tc.slug)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+				assert.Nil(t, master)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMastersService_GetMasters(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -427,6 +514,7 @@ func TestMastersService_UpdateMaster(t *testing.T) {
 		master        entities.UpdateMasterDTO
 		setupMocks    func(mastersRepository *mockrepositories.MockMastersRepository, logger *loggermock.MockLogger)
 		errorExpected bool
+		err           error
 	}{
 		{
 			name: "update Master success",
@@ -447,6 +535,47 @@ func TestMastersService_UpdateMaster(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			name: "update Master success with own slug",
+			master: entities.UpdateMasterDTO{
+				ID:   1,
+				Slug: pointers.New[string]("test-shop"),
+			},
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, _ *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "test-shop").
+					Return(&entities.Master{ID: 1}, nil).
+					Times(1)
+
+				mastersRepository.
+					EXPECT().
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:   1,
+							Slug: pointers.New[string]("test-shop"),
+						}).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "update Master fail - slug already exists",
+			master: entities.UpdateMasterDTO{
+				ID:   1,
+				Slug: pointers.New[string]("test-shop"),
+			},
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, _ *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetMasterBySlug(gomock.Any(), "test-shop").
+					Return(&entities.Master{ID: 2}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.MasterSlugAlreadyExistsError{},
+		},
 	}
 
 	mockController := gomock.NewController(t)
@@ -464,6 +593,142 @@ func TestMastersService_UpdateMaster(t *testing.T) {
 			err := mastersService.UpdateMaster(ctx, tc.master)
 			if tc.errorExpected {
 				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMastersService_FollowMaster(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        uint64
+		masterID      uint64
+		expected      uint64
+		setupMocks    func(mastersRepository *mockrepositories.MockMastersRepository)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:     "follow Master success",
+			userID:   1,
+			masterID: 1,
+			expected: 1,
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository) {
+				mastersRepository.
+					EXPECT().
+					FollowMaster(gomock.Any(), uint64(1), uint64(1)).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+		},
+		{
+			name:     "follow Master fail - already exists",
+			userID:   1,
+			masterID: 1,
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository) {
+				mastersRepository.
+					EXPECT().
+					FollowMaster(gomock.Any(), uint64(1), uint64(1)).
+					Return(uint64(0), &customerrors.FollowAlreadyExistsError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.FollowAlreadyExistsError{},
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	mastersRepository := mockrepositories.NewMockMastersRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	mastersService := services.NewMastersService(mastersRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersRepository)
+			}
+
+			followID, err := mastersService.FollowMaster(ctx, tc.userID, tc.masterID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, followID)
+		})
+	}
+}
+
+func TestMastersService_UnfollowMaster(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        uint64
+		masterID      uint64
+		setupMocks    func(mastersRepository *mockrepositories.MockMastersRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:     "unfollow Master success",
+			userID:   1,
+			masterID: 1,
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, _ *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetFollow(gomock.Any(), uint64(1), uint64(1)).
+					Return(&entities.Follow{ID: 1}, nil).
+					Times(1)
+
+				mastersRepository.
+					EXPECT().
+					UnfollowMaster(gomock.Any(), uint64(1), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:     "unfollow Master fail - not found",
+			userID:   1,
+			masterID: 1,
+			setupMocks: func(mastersRepository *mockrepositories.MockMastersRepository, logger *loggermock.MockLogger) {
+				mastersRepository.
+					EXPECT().
+					GetFollow(gomock.Any(), uint64(1), uint64(1)).
+					Return(nil, &customerrors.FollowNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.FollowNotFoundError{},
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	mastersRepository := mockrepositories.NewMockMastersRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	mastersService := services.NewMastersService(mastersRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersRepository, logger)
+			}
+
+			err := mastersService.UnfollowMaster(ctx, tc.userID, tc.masterID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
 			} else {
 				require.NoError(t, err)
 			}
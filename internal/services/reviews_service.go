@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DKhorkov/libs/logging"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+)
+
+type ReviewsService struct {
+	reviewsRepository interfaces.ReviewsRepository
+	logger            logging.Logger
+}
+
+func NewReviewsService(
+	reviewsRepository interfaces.ReviewsRepository,
+	logger logging.Logger,
+) *ReviewsService {
+	return &ReviewsService{
+		reviewsRepository: reviewsRepository,
+		logger:            logger,
+	}
+}
+
+// AddReview adds User's Review of Toy. Existence is checked by repository within insert, so concurrent
+// adds of Review of the same Toy return ReviewAlreadyExistsError.
+func (service *ReviewsService) AddReview(
+	ctx context.Context,
+	reviewData entities.AddReviewDTO,
+) (uint64, error) {
+	return service.reviewsRepository.AddReview(ctx, reviewData)
+}
+
+func (service *ReviewsService) GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error) {
+	review, err := service.reviewsRepository.GetReviewByID(ctx, id)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			fmt.Sprintf("Error occurred while trying to get Review with ID=%d", id),
+			err,
+		)
+
+		if errors.As(err, new(*customerrors.ReviewNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Review with ID=%d: %w", id, err)
+	}
+
+	return review, nil
+}
+
+func (service *ReviewsService) GetToyReviews(
+	ctx context.Context,
+	toyID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Review, error) {
+	return service.reviewsRepository.GetToyReviews(ctx, toyID, pagination)
+}
+
+func (service *ReviewsService) CountToyReviews(ctx context.Context, toyID uint64) (uint64, error) {
+	return service.reviewsRepository.CountToyReviews(ctx, toyID)
+}
+
+func (service *ReviewsService) GetUserReviews(ctx context.Context, userID uint64) ([]entities.Review, error) {
+	return service.reviewsRepository.GetUserReviews(ctx, userID)
+}
+
+func (service *ReviewsService) UpdateReview(ctx context.Context, reviewData entities.UpdateReviewDTO) error {
+	return service.reviewsRepository.UpdateReview(ctx, reviewData)
+}
+
+func (service *ReviewsService) DeleteReview(ctx context.Context, id uint64) error {
+	return service.reviewsRepository.DeleteReview(ctx, id)
+}
@@ -0,0 +1,187 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	loggermock "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
+	"github.com/DKhorkov/hmtm-toys/internal/services"
+	mockrepositories "github.com/DKhorkov/hmtm-toys/mocks/repositories"
+)
+
+func TestReviewsService_AddReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		reviewData    entities.AddReviewDTO
+		expected      uint64
+		setupMocks    func(reviewsRepository *mockrepositories.MockReviewsRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name: "add Review success",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  1,
+				UserID: 1,
+				Rating: 5,
+			},
+			expected: 1,
+			setupMocks: func(reviewsRepository *mockrepositories.MockReviewsRepository, _ *loggermock.MockLogger) {
+				reviewsRepository.
+					EXPECT().
+					AddReview(
+						gomock.Any(),
+						entities.AddReviewDTO{
+							ToyID:  1,
+							UserID: 1,
+							Rating: 5,
+						},
+					).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+		},
+		{
+			name: "add Review fail - already exists",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  1,
+				UserID: 1,
+				Rating: 5,
+			},
+			setupMocks: func(reviewsRepository *mockrepositories.MockReviewsRepository, _ *loggermock.MockLogger) {
+				reviewsRepository.
+					EXPECT().
+					AddReview(
+						gomock.Any(),
+						entities.AddReviewDTO{
+							ToyID:  1,
+							UserID: 1,
+							Rating: 5,
+						},
+					).
+					Return(uint64(0), &customerrors.ReviewAlreadyExistsError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.ReviewAlreadyExistsError{},
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	reviewsRepository := mockrepositories.NewMockReviewsRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	reviewsService := services.NewReviewsService(reviewsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(reviewsRepository, logger)
+			}
+
+			reviewID, err := reviewsService.AddReview(ctx, tc.reviewData)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, reviewID)
+		})
+	}
+}
+
+func TestReviewsService_GetReviewByID(t *testing.T) {
+	notFoundErr := &customerrors.ReviewNotFoundError{}
+
+	testCases := []struct {
+		name          string
+		reviewID      uint64
+		expected      *entities.Review
+		setupMocks    func(reviewsRepository *mockrepositories.MockReviewsRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:     "successfully got Review by id",
+			reviewID: 1,
+			expected: &entities.Review{ID: 1},
+			setupMocks: func(reviewsRepository *mockrepositories.MockReviewsRepository, _ *loggermock.MockLogger) {
+				reviewsRepository.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(1)).
+					Return(&entities.Review{ID: 1}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:     "failed to get Review by id",
+			reviewID: 2,
+			setupMocks: func(reviewsRepository *mockrepositories.MockReviewsRepository, logger *loggermock.MockLogger) {
+				reviewsRepository.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(2)).
+					Return(nil, notFoundErr).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           notFoundErr,
+		},
+		{
+			name:     "database error",
+			reviewID: 3,
+			setupMocks: func(reviewsRepository *mockrepositories.MockReviewsRepository, logger *loggermock.MockLogger) {
+				reviewsRepository.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(3)).
+					Return(nil, sql.ErrConnDone).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           sql.ErrConnDone,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	reviewsRepository := mockrepositories.NewMockReviewsRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	reviewsService := services.NewReviewsService(reviewsRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(reviewsRepository, logger)
+			}
+
+			review, err := reviewsService.GetReviewByID(ctx, tc.reviewID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, review)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
@@ -36,7 +37,11 @@ func (service *TagsService) GetTagByID(ctx context.Context, id uint32) (*entitie
 			err,
 		)
 
-		return nil, &customerrors.TagNotFoundError{}
+		if errors.As(err, new(*customerrors.TagNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Tag with ID=%d: %w", id, err)
 	}
 
 	return tag, nil
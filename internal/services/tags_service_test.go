@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -18,6 +19,8 @@ import (
 )
 
 func TestTagsService_GetTagByID(t *testing.T) {
+	notFoundErr := &customerrors.TagNotFoundError{}
+
 	testCases := []struct {
 		name          string
 		tagID         uint32
@@ -46,7 +49,25 @@ func TestTagsService_GetTagByID(t *testing.T) {
 				tagsRepository.
 					EXPECT().
 					GetTagByID(gomock.Any(), uint32(2)).
-					Return(nil, &customerrors.TagNotFoundError{}).
+					Return(nil, notFoundErr).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           notFoundErr,
+		},
+		{
+			name:  "database error",
+			tagID: 3,
+			setupMocks: func(tagsRepository *mockrepositories.MockTagsRepository, logger *loggermock.MockLogger) {
+				tagsRepository.
+					EXPECT().
+					GetTagByID(gomock.Any(), uint32(3)).
+					Return(nil, sql.ErrConnDone).
 					Times(1)
 
 				logger.
@@ -55,7 +76,7 @@ func TestTagsService_GetTagByID(t *testing.T) {
 					Times(1)
 			},
 			errorExpected: true,
-			err:           &customerrors.TagNotFoundError{},
+			err:           sql.ErrConnDone,
 		},
 	}
 
@@ -74,7 +95,7 @@ func TestTagsService_GetTagByID(t *testing.T) {
 			tag, err := tagsService.GetTagByID(ctx, tc.tagID)
 			if tc.errorExpected {
 				require.Error(t, err)
-				require.IsType(t, tc.err, err)
+				require.ErrorIs(t, err, tc.err)
 				assert.Nil(t, tag)
 			} else {
 				require.NoError(t, err)
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/DKhorkov/libs/logging"
@@ -36,7 +37,11 @@ func (service *ToysService) GetToyByID(ctx context.Context, id uint64) (*entitie
 			err,
 		)
 
-		return nil, &customerrors.ToyNotFoundError{}
+		if errors.As(err, new(*customerrors.ToyNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Toy with ID=%d: %w", id, err)
 	}
 
 	return toy, nil
@@ -71,11 +76,41 @@ func (service *ToysService) CountMasterToys(
 	return service.toysRepository.CountMasterToys(ctx, masterID, filters)
 }
 
+func (service *ToysService) GetAllMasterToys(ctx context.Context, masterID uint64) ([]entities.Toy, error) {
+	return service.toysRepository.GetAllMasterToys(ctx, masterID)
+}
+
+func (service *ToysService) GetMasterToysPriceHistory(
+	ctx context.Context,
+	masterID uint64,
+) ([]entities.ToyPriceChange, error) {
+	return service.toysRepository.GetMasterToysPriceHistory(ctx, masterID)
+}
+
+func (service *ToysService) GetToysByIDs(ctx context.Context, ids []uint64) ([]entities.Toy, error) {
+	return service.toysRepository.GetToysByIDs(ctx, ids)
+}
+
+func (service *ToysService) GetToysBatch(
+	ctx context.Context,
+	masterID *uint64,
+	filters *entities.ToysFilters,
+	afterID uint64,
+	limit uint64,
+) ([]entities.Toy, error) {
+	return service.toysRepository.GetToysBatch(ctx, masterID, filters, afterID, limit)
+}
+
 func (service *ToysService) AddToy(
 	ctx context.Context,
 	toyData entities.AddToyDTO,
 ) (uint64, error) {
-	if service.checkToyExistence(ctx, toyData) {
+	exists, err := service.checkToyExistence(ctx, toyData)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check Toy existence for Master with ID=%d: %w", toyData.MasterID, err)
+	}
+
+	if exists {
 		return 0, &customerrors.ToyAlreadyExistsError{}
 	}
 
@@ -86,23 +121,133 @@ func (service *ToysService) DeleteToy(ctx context.Context, id uint64) error {
 	return service.toysRepository.DeleteToy(ctx, id)
 }
 
+func (service *ToysService) DeleteMasterToys(ctx context.Context, masterID uint64) ([]uint64, error) {
+	return service.toysRepository.DeleteMasterToys(ctx, masterID)
+}
+
 func (service *ToysService) UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error {
 	return service.toysRepository.UpdateToy(ctx, toyData)
 }
 
+func (service *ToysService) GetUserFavourites(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+	filters *entities.ToysFilters,
+) ([]entities.Toy, error) {
+	return service.toysRepository.GetUserFavourites(ctx, userID, pagination, filters)
+}
+
+func (service *ToysService) CountUserFavourites(
+	ctx context.Context,
+	userID uint64,
+	filters *entities.ToysFilters,
+) (uint64, error) {
+	return service.toysRepository.CountUserFavourites(ctx, userID, filters)
+}
+
+func (service *ToysService) GetFavourite(
+	ctx context.Context,
+	userID uint64,
+	toyID uint64,
+) (*entities.Favourite, error) {
+	favourite, err := service.toysRepository.GetFavourite(ctx, userID, toyID)
+	if err != nil {
+		logging.LogErrorContext(
+			ctx,
+			service.logger,
+			fmt.Sprintf("Error occurred while trying to get Favourite for User with ID=%d and Toy with ID=%d", userID, toyID),
+			err,
+		)
+
+		if errors.As(err, new(*customerrors.FavouriteNotFoundError)) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("failed to get Favourite for User with ID=%d and Toy with ID=%d: %w", userID, toyID, err)
+	}
+
+	return favourite, nil
+}
+
+func (service *ToysService) GetUserFavouritesRecords(
+	ctx context.Context,
+	userID uint64,
+) ([]entities.Favourite, error) {
+	return service.toysRepository.GetUserFavouritesRecords(ctx, userID)
+}
+
+// AddFavourite adds Toy to User's favourites. Existence is checked by repository within insert, so concurrent
+// adds of the same Toy return FavouriteAlreadyExistsError.
+func (service *ToysService) AddFavourite(ctx context.Context, userID, toyID uint64) (uint64, error) {
+	return service.toysRepository.AddFavourite(ctx, userID, toyID)
+}
+
+func (service *ToysService) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	if _, err := service.GetFavourite(ctx, userID, toyID); err != nil {
+		return err
+	}
+
+	return service.toysRepository.RemoveFavourite(ctx, userID, toyID)
+}
+
+func (service *ToysService) GetFeed(
+	ctx context.Context,
+	userID uint64,
+	cursor *entities.FeedCursor,
+	limit uint64,
+) ([]entities.Toy, error) {
+	return service.toysRepository.GetFeed(ctx, userID, cursor, limit)
+}
+
+func (service *ToysService) GetMasterToysStats(
+	ctx context.Context,
+	masterID uint64,
+	period entities.StatsPeriod,
+) (*entities.MasterStats, error) {
+	return service.toysRepository.GetMasterToysStats(ctx, masterID, period)
+}
+
+func (service *ToysService) UpdateToyModeration(
+	ctx context.Context,
+	moderationData entities.ToyModerationDTO,
+) error {
+	return service.toysRepository.UpdateToyModeration(ctx, moderationData)
+}
+
+func (service *ToysService) GetCategoryPriceStats(
+	ctx context.Context,
+	categoryID uint32,
+) (*entities.CategoryPriceStats, error) {
+	return service.toysRepository.GetCategoryPriceStats(ctx, categoryID)
+}
+
+// checkToyExistence checks, whether Master already has the same Toy. Error is returned, if Toys of Master
+// can't be read, so failed check is not mistaken for missing duplicate.
 func (service *ToysService) checkToyExistence(
 	ctx context.Context,
 	toyData entities.AddToyDTO,
-) bool {
-	toys, err := service.toysRepository.GetMasterToys(ctx, toyData.MasterID, nil, nil)
-	if err == nil {
-		for _, toy := range toys {
-			if toy.Name == toyData.Name && toy.CategoryID == toyData.CategoryID &&
-				toy.Description == toyData.Description {
-				return true
-			}
+) (bool, error) {
+	// Toys on moderation are also checked to avoid duplicates in moderation queue:
+	filters := &entities.ToysFilters{
+		ModerationStatuses: []entities.ToyModerationStatus{
+			entities.ToyModerationStatusApproved,
+			entities.ToyModerationStatusPending,
+			entities.ToyModerationStatusRejected,
+		},
+	}
+
+	toys, err := service.toysRepository.GetMasterToys(ctx, toyData.MasterID, nil, filters)
+	if err != nil {
+		return false, err
+	}
+
+	for _, toy := range toys {
+		if toy.Name == toyData.Name && toy.CategoryID == toyData.CategoryID &&
+			toy.Description == toyData.Description {
+			return true, nil
 		}
 	}
 
-	return false
+	return false, nil
 }
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -21,6 +22,8 @@ import (
 )
 
 func TestToysService_GetToyByID(t *testing.T) {
+	notFoundErr := &customerrors.ToyNotFoundError{}
+
 	testCases := []struct {
 		name          string
 		toyID         uint64
@@ -49,7 +52,25 @@ func TestToysService_GetToyByID(t *testing.T) {
 				toysRepository.
 					EXPECT().
 					GetToyByID(gomock.Any(), uint64(2)).
-					Return(nil, &customerrors.ToyNotFoundError{}).
+					Return(nil, notFoundErr).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           notFoundErr,
+		},
+		{
+			name:  "database error",
+			toyID: 3,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, logger *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					GetToyByID(gomock.Any(), uint64(3)).
+					Return(nil, sql.ErrConnDone).
 					Times(1)
 
 				logger.
@@ -58,7 +79,7 @@ func TestToysService_GetToyByID(t *testing.T) {
 					Times(1)
 			},
 			errorExpected: true,
-			err:           &customerrors.ToyNotFoundError{},
+			err:           sql.ErrConnDone,
 		},
 	}
 
@@ -77,7 +98,7 @@ func TestToysService_GetToyByID(t *testing.T) {
 			toy, err := toysService.GetToyByID(ctx, tc.toyID)
 			if tc.errorExpected {
 				require.Error(t, err)
-				require.IsType(t, tc.err, err)
+				require.ErrorIs(t, err, tc.err)
 				assert.Nil(t, toy)
 			} else {
 				require.NoError(t, err)
@@ -574,6 +595,14 @@ func TestToysService_GetMasterToys(t *testing.T) {
 }
 
 func TestToysService_AddToy(t *testing.T) {
+	allModerationStatusesFilters := &entities.ToysFilters{
+		ModerationStatuses: []entities.ToyModerationStatus{
+			entities.ToyModerationStatusApproved,
+			entities.ToyModerationStatusPending,
+			entities.ToyModerationStatusRejected,
+		},
+	}
+
 	testCases := []struct {
 		name          string
 		toy           entities.AddToyDTO
@@ -593,7 +622,7 @@ func TestToysService_AddToy(t *testing.T) {
 						gomock.Any(),
 						uint64(1),
 						nil,
-						nil,
+						allModerationStatusesFilters,
 					).
 					Return([]entities.Toy{}, nil).
 					Times(1)
@@ -624,7 +653,7 @@ func TestToysService_AddToy(t *testing.T) {
 						gomock.Any(),
 						uint64(1),
 						nil,
-						nil,
+						allModerationStatusesFilters,
 					).
 					Return(
 						[]entities.Toy{
@@ -668,6 +697,26 @@ func TestToysService_AddToy(t *testing.T) {
 	}
 }
 
+func TestToysService_AddToyWithFailedExistenceCheck(t *testing.T) {
+	mockController := gomock.NewController(t)
+	toysRepository := mockrepositories.NewMockToysRepository(mockController)
+	toysService := services.NewToysService(toysRepository, loggermock.NewMockLogger(mockController))
+
+	// Toy is not added, if duplicates can't be checked:
+	toysRepository.
+		EXPECT().
+		GetMasterToys(gomock.Any(), uint64(1), nil, gomock.Any()).
+		Return(nil, sql.ErrConnDone).
+		Times(1)
+
+	toyID, err := toysService.AddToy(
+		context.Background(),
+		entities.AddToyDTO{MasterID: 1, Description: "test", Name: "test", CategoryID: 1},
+	)
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	assert.Zero(t, toyID)
+}
+
 func TestToysService_DeleteToy(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -722,6 +771,64 @@ func TestToysService_DeleteToy(t *testing.T) {
 	}
 }
 
+func TestToysService_DeleteMasterToys(t *testing.T) {
+	testCases := []struct {
+		name          string
+		masterID      uint64
+		setupMocks    func(toysRepository *mockrepositories.MockToysRepository, logger *loggermock.MockLogger)
+		expected      []uint64
+		errorExpected bool
+	}{
+		{
+			name:     "delete Master Toys success",
+			masterID: 1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, _ *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					DeleteMasterToys(gomock.Any(), uint64(1)).
+					Return([]uint64{1, 2}, nil).
+					Times(1)
+			},
+			expected: []uint64{1, 2},
+		},
+		{
+			name:     "delete Master Toys fail",
+			masterID: 1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, _ *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					DeleteMasterToys(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("test error")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	toysRepository := mockrepositories.NewMockToysRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	toysService := services.NewToysService(toysRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysRepository, logger)
+			}
+
+			actual, err := toysService.DeleteMasterToys(ctx, tc.masterID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestToysService_UpdateToy(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -825,3 +932,152 @@ func TestToysService_UpdateToy(t *testing.T) {
 		})
 	}
 }
+
+func TestToysService_AddFavourite(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        uint64
+		toyID         uint64
+		expected      uint64
+		setupMocks    func(toysRepository *mockrepositories.MockToysRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:     "add Favourite success",
+			userID:   1,
+			toyID:    1,
+			expected: 1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, _ *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					AddFavourite(gomock.Any(), uint64(1), uint64(1)).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "add Favourite fail - already exists",
+			userID: 1,
+			toyID:  1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, _ *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					AddFavourite(gomock.Any(), uint64(1), uint64(1)).
+					Return(uint64(0), &customerrors.FavouriteAlreadyExistsError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.FavouriteAlreadyExistsError{},
+		},
+		{
+			name:   "add Favourite fail - repository error",
+			userID: 1,
+			toyID:  1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, _ *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					AddFavourite(gomock.Any(), uint64(1), uint64(1)).
+					Return(uint64(0), errors.New("test error")).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           errors.New("test error"),
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	toysRepository := mockrepositories.NewMockToysRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	toysService := services.NewToysService(toysRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysRepository, logger)
+			}
+
+			favouriteID, err := toysService.AddFavourite(ctx, tc.userID, tc.toyID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expected, favouriteID)
+		})
+	}
+}
+
+func TestToysService_RemoveFavourite(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        uint64
+		toyID         uint64
+		setupMocks    func(toysRepository *mockrepositories.MockToysRepository, logger *loggermock.MockLogger)
+		errorExpected bool
+		err           error
+	}{
+		{
+			name:   "remove Favourite success",
+			userID: 1,
+			toyID:  1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, _ *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					GetFavourite(gomock.Any(), uint64(1), uint64(1)).
+					Return(&entities.Favourite{ID: 1, UserID: 1, ToyID: 1}, nil).
+					Times(1)
+
+				toysRepository.
+					EXPECT().
+					RemoveFavourite(gomock.Any(), uint64(1), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "remove Favourite fail - not found",
+			userID: 1,
+			toyID:  1,
+			setupMocks: func(toysRepository *mockrepositories.MockToysRepository, logger *loggermock.MockLogger) {
+				toysRepository.
+					EXPECT().
+					GetFavourite(gomock.Any(), uint64(1), uint64(1)).
+					Return(nil, &customerrors.FavouriteNotFoundError{}).
+					Times(1)
+
+				logger.
+					EXPECT().
+					ErrorContext(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1)
+			},
+			errorExpected: true,
+			err:           &customerrors.FavouriteNotFoundError{},
+		},
+	}
+
+	mockController := gomock.NewController(t)
+	toysRepository := mockrepositories.NewMockToysRepository(mockController)
+	logger := loggermock.NewMockLogger(mockController)
+	toysService := services.NewToysService(toysRepository, logger)
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysRepository, logger)
+			}
+
+			err := toysService.RemoveFavourite(ctx, tc.userID, tc.toyID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.IsType(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package transactions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/DKhorkov/libs/db"
+	"github.com/DKhorkov/libs/logging"
+)
+
+// transactionKey is used to pass unit of work through context to repositories.
+type transactionKey struct{}
+
+// unitOfWork is transaction, started by Manager, with callbacks to run after its commit.
+type unitOfWork struct {
+	transaction *sql.Tx
+	mutex       sync.Mutex
+	onCommit    []func()
+}
+
+// Executor is implemented both by *sql.Conn and *sql.Tx, so repositories can execute queries
+// regardless of whether they joined transaction or not.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// NewManager creates Manager, which starts transactions with provided isolation level.
+func NewManager(
+	dbConnector db.Connector,
+	isolationLevel sql.IsolationLevel,
+	logger logging.Logger,
+) *Manager {
+	return &Manager{
+		dbConnector:    dbConnector,
+		isolationLevel: isolationLevel,
+		logger:         logger,
+	}
+}
+
+// Manager runs unit of work in a single transaction, which repositories join through context.
+type Manager struct {
+	dbConnector    db.Connector
+	isolationLevel sql.IsolationLevel
+	logger         logging.Logger
+}
+
+// WithinTransaction calls fn with context, containing transaction. Transaction is committed, if fn succeeds,
+// and rolled back otherwise. If context already contains transaction, fn joins it, so nested units of work
+// are committed or rolled back together with the outer one.
+func (manager *Manager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return manager.within(ctx, &sql.TxOptions{Isolation: manager.isolationLevel}, fn)
+}
+
+// WithinSnapshot calls fn with context, containing read-only transaction with repeatable read isolation level,
+// so all queries of fn see the same snapshot of data regardless of isolation level of Manager.
+// If context already contains transaction, fn joins it.
+func (manager *Manager) WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	return manager.within(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, fn)
+}
+
+func (manager *Manager) within(
+	ctx context.Context,
+	options *sql.TxOptions,
+	fn func(ctx context.Context) error,
+) error {
+	if _, ok := fromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	transaction, err := manager.dbConnector.Pool().BeginTx(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	// Rollback transaction according Go best practises https://go.dev/doc/database/execute-transactions.
+	defer func() {
+		if err = transaction.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			logging.LogErrorContext(ctx, manager.logger, "failed to rollback db transaction", err)
+		}
+	}()
+
+	unit := &unitOfWork{transaction: transaction}
+	if err = fn(context.WithValue(ctx, transactionKey{}, unit)); err != nil {
+		return err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return err
+	}
+
+	unit.mutex.Lock()
+	defer unit.mutex.Unlock()
+
+	for _, callback := range unit.onCommit {
+		callback()
+	}
+
+	return nil
+}
+
+// Transaction wraps *sql.Tx to commit and rollback only transactions, started by repository itself.
+// Transaction, joined through context, is finished by Manager.
+type Transaction struct {
+	*sql.Tx
+
+	joined bool
+}
+
+func (transaction *Transaction) Commit() error {
+	if transaction.joined {
+		return nil
+	}
+
+	return transaction.Tx.Commit()
+}
+
+func (transaction *Transaction) Rollback() error {
+	if transaction.joined {
+		return nil
+	}
+
+	return transaction.Tx.Rollback()
+}
+
+// Begin returns transaction from context, if it exists, or starts new one.
+func Begin(ctx context.Context, dbConnector db.Connector) (*Transaction, error) {
+	if transaction, ok := fromContext(ctx); ok {
+		return &Transaction{Tx: transaction, joined: true}, nil
+	}
+
+	transaction, err := dbConnector.Transaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transaction{Tx: transaction}, nil
+}
+
+// Connection returns transaction from context, if it exists, or new connection from pool.
+// Returned release function should be deferred to close connection. Joined transaction is not affected by it.
+func Connection(
+	ctx context.Context,
+	dbConnector db.Connector,
+	logger logging.Logger,
+) (Executor, func(), error) {
+	if transaction, ok := fromContext(ctx); ok {
+		return transaction, func() {}, nil
+	}
+
+	connection, err := dbConnector.Connection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return connection, func() { db.CloseConnectionContext(ctx, connection, logger) }, nil
+}
+
+// ReadConnector is implemented by connectors, which can route read-only queries to read replicas.
+type ReadConnector interface {
+	ReadConnection(ctx context.Context) (*sql.Conn, error)
+}
+
+// ReadConnection is the same as Connection, but connection is taken from read replica, if dbConnector
+// implements ReadConnector. Transaction from context is still used to read own writes.
+// Should be used only for queries, which tolerate replication lag.
+func ReadConnection(
+	ctx context.Context,
+	dbConnector db.Connector,
+	logger logging.Logger,
+) (Executor, func(), error) {
+	readConnector, ok := dbConnector.(ReadConnector)
+	if !ok {
+		return Connection(ctx, dbConnector, logger)
+	}
+
+	if transaction, ok := fromContext(ctx); ok {
+		return transaction, func() {}, nil
+	}
+
+	connection, err := readConnector.ReadConnection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return connection, func() { db.CloseConnectionContext(ctx, connection, logger) }, nil
+}
+
+// ParseIsolationLevel converts isolation level name, such as "read committed", to sql.IsolationLevel.
+func ParseIsolationLevel(name string) (sql.IsolationLevel, error) {
+	for level := sql.LevelDefault; level <= sql.LevelLinearizable; level++ {
+		if strings.EqualFold(level.String(), name) {
+			return level, nil
+		}
+	}
+
+	return sql.LevelDefault, fmt.Errorf("unknown transaction isolation level: %s", name)
+}
+
+// InTransaction checks, if context contains transaction, started by Manager.
+func InTransaction(ctx context.Context) bool {
+	_, ok := fromContext(ctx)
+
+	return ok
+}
+
+// OnCommit calls callback after commit of transaction from context or immediately, if there is no transaction.
+// Callback is not called, if transaction is rolled back.
+func OnCommit(ctx context.Context, callback func()) {
+	unit, ok := ctx.Value(transactionKey{}).(*unitOfWork)
+	if !ok {
+		callback()
+
+		return
+	}
+
+	unit.mutex.Lock()
+	defer unit.mutex.Unlock()
+
+	unit.onCommit = append(unit.onCommit, callback)
+}
+
+func fromContext(ctx context.Context) (*sql.Tx, bool) {
+	unit, ok := ctx.Value(transactionKey{}).(*unitOfWork)
+	if !ok {
+		return nil, false
+	}
+
+	return unit.transaction, true
+}
@@ -0,0 +1,62 @@
+package transactions_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+)
+
+func TestParseIsolationLevel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		levelName     string
+		expected      sql.IsolationLevel
+		errorExpected bool
+	}{
+		{
+			name:      "default",
+			levelName: "Default",
+			expected:  sql.LevelDefault,
+		},
+		{
+			name:      "read committed",
+			levelName: "Read Committed",
+			expected:  sql.LevelReadCommitted,
+		},
+		{
+			name:      "case insensitive",
+			levelName: "serializable",
+			expected:  sql.LevelSerializable,
+		},
+		{
+			name:          "unknown",
+			levelName:     "test",
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			level, err := transactions.ParseIsolationLevel(tc.levelName)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, level)
+			}
+		})
+	}
+}
+
+func TestOnCommitWithoutTransaction(t *testing.T) {
+	var called bool
+
+	ctx := context.Background()
+	require.False(t, transactions.InTransaction(ctx))
+	transactions.OnCommit(ctx, func() { called = true })
+	require.True(t, called)
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+package transactions_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3" // Must be imported for correct work
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/DKhorkov/libs/db"
+	mocklogging "github.com/DKhorkov/libs/logging/mocks"
+
+	"github.com/DKhorkov/hmtm-toys/internal/transactions"
+)
+
+func TestOnCommit(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		calledExpected bool
+	}{
+		{
+			name:           "committed",
+			calledExpected: true,
+		},
+		{
+			name:           "rolled back",
+			err:            errors.New("test"),
+			calledExpected: false,
+		},
+	}
+
+	dbConnector, err := db.New("file:on_commit?mode=memory&cache=shared", "sqlite3", nil)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, dbConnector.Close())
+	}()
+
+	ctrl := gomock.NewController(t)
+	transactionManager := transactions.NewManager(dbConnector, sql.LevelDefault, mocklogging.NewMockLogger(ctrl))
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var called bool
+
+			err := transactionManager.WithinTransaction(
+				ctx,
+				func(ctx context.Context) error {
+					require.True(t, transactions.InTransaction(ctx))
+					transactions.OnCommit(ctx, func() { called = true })
+					require.False(t, called)
+
+					return tc.err
+				},
+			)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.calledExpected, called)
+		})
+	}
+}
@@ -2,13 +2,22 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
+	"github.com/DKhorkov/libs/pointers"
 	"github.com/DKhorkov/libs/validation"
 
 	"github.com/DKhorkov/hmtm-toys/internal/config"
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	"github.com/DKhorkov/hmtm-toys/internal/interfaces"
+	"github.com/DKhorkov/hmtm-toys/internal/loaders"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
 )
 
 const (
@@ -16,15 +25,38 @@ const (
 	priceFloor    = 1
 	quantityCeil  = 1_000
 	quantityFloor = 1
+	ratingCeil    = 5
+	ratingFloor   = 1
+	feedLimit     = 20
+	feedLimitCeil = 100
+
+	exportToysBatchSize        = 100
+	exportToysReadAheadBatches = 10
+	exportToysReadAheadTimeout = 30 * time.Second
+	batchToysCeil              = 100
+
+	statsPeriodDefault = 30 * 24 * time.Hour
+	statsPeriodCeil    = 366 * 24 * time.Hour
+	vacationCeil       = 366 * 24 * time.Hour
+
+	bannedUserReason  = "Пользователь заблокирован в SSO"
+	deletedUserReason = "Пользователь удален в SSO"
 )
 
+// errDryRun rolls back transaction of imported Toy in dry-run mode.
+var errDryRun = errors.New("dry run")
+
 type UseCases struct {
-	tagsService       interfaces.TagsService
-	categoriesService interfaces.CategoriesService
-	mastersService    interfaces.MastersService
-	toysService       interfaces.ToysService
-	ssoService        interfaces.SsoService
-	validationConfig  config.ValidationConfig
+	tagsService           interfaces.TagsService
+	categoriesService     interfaces.CategoriesService
+	mastersService        interfaces.MastersService
+	toysService           interfaces.ToysService
+	ssoService            interfaces.SsoService
+	reviewsService        interfaces.ReviewsService
+	forbiddenWordsService interfaces.ForbiddenWordsService
+	transactionManager    interfaces.TransactionManager
+	validationConfig      config.ValidationConfig
+	userEventsConfig      config.UserEventsConfig
 }
 
 func New(
@@ -33,15 +65,23 @@ func New(
 	mastersService interfaces.MastersService,
 	toysService interfaces.ToysService,
 	ssoService interfaces.SsoService,
+	reviewsService interfaces.ReviewsService,
+	forbiddenWordsService interfaces.ForbiddenWordsService,
+	transactionManager interfaces.TransactionManager,
 	validationConfig config.ValidationConfig,
+	userEventsConfig config.UserEventsConfig,
 ) *UseCases {
 	return &UseCases{
-		tagsService:       tagsService,
-		categoriesService: categoriesService,
-		mastersService:    mastersService,
-		toysService:       toysService,
-		ssoService:        ssoService,
-		validationConfig:  validationConfig,
+		tagsService:           tagsService,
+		categoriesService:     categoriesService,
+		mastersService:        mastersService,
+		toysService:           toysService,
+		ssoService:            ssoService,
+		reviewsService:        reviewsService,
+		forbiddenWordsService: forbiddenWordsService,
+		transactionManager:    transactionManager,
+		validationConfig:      validationConfig,
+		userEventsConfig:      userEventsConfig,
 	}
 }
 
@@ -64,10 +104,89 @@ func (useCases *UseCases) GetAllCategories(ctx context.Context) ([]entities.Cate
 	return useCases.categoriesService.GetAllCategories(ctx)
 }
 
+// GetCategoryRules returns Category rules, merged with default ones. Only minimal description length
+// is not provided, if it is not set for Category.
+func (useCases *UseCases) GetCategoryRules(
+	ctx context.Context,
+	categoryID uint32,
+) (*entities.CategoryRules, error) {
+	rules, err := useCases.categoriesService.GetCategoryRules(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rules.PriceFloor == nil {
+		rules.PriceFloor = pointers.New[float32](priceFloor)
+	}
+
+	if rules.PriceCeil == nil {
+		rules.PriceCeil = pointers.New[float32](priceCeil)
+	}
+
+	if rules.QuantityFloor == nil {
+		rules.QuantityFloor = pointers.New[uint32](quantityFloor)
+	}
+
+	if rules.QuantityCeil == nil {
+		rules.QuantityCeil = pointers.New[uint32](quantityCeil)
+	}
+
+	return rules, nil
+}
+
 func (useCases *UseCases) GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error) {
 	return useCases.toysService.GetToyByID(ctx, id)
 }
 
+// BatchGetToys returns found Toys in order of provided IDs and IDs of missing Toys. Duplicated IDs are ignored.
+func (useCases *UseCases) BatchGetToys(ctx context.Context, ids []uint64) ([]entities.Toy, []uint64, error) {
+	if len(ids) > batchToysCeil {
+		var violations fieldViolations
+		violations.add(
+			"IDs",
+			customerrors.ViolationCodeOutOfRange,
+			fmt.Sprintf("max %d toys can be requested at once", batchToysCeil),
+		)
+
+		return nil, nil, violations.err()
+	}
+
+	foundToys, err := useCases.toysService.GetToysByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	toysByIDs := make(map[uint64]entities.Toy, len(foundToys))
+	for _, toy := range foundToys {
+		toysByIDs[toy.ID] = toy
+	}
+
+	var (
+		toys       = make([]entities.Toy, 0, len(foundToys))
+		missingIDs []uint64
+		processed  = make(map[uint64]struct{}, len(ids))
+	)
+
+	for _, id := range ids {
+		if _, ok := processed[id]; ok {
+			continue
+		}
+
+		processed[id] = struct{}{}
+
+		toy, ok := toysByIDs[id]
+		if !ok {
+			missingIDs = append(missingIDs, id)
+
+			continue
+		}
+
+		toys = append(toys, toy)
+	}
+
+	return toys, missingIDs, nil
+}
+
 func (useCases *UseCases) GetToys(
 	ctx context.Context,
 	pagination *entities.Pagination,
@@ -94,6 +213,11 @@ func (useCases *UseCases) CountMasterToys(
 	masterID uint64,
 	filters *entities.ToysFilters,
 ) (uint64, error) {
+	// Counting toys of non-existing Master is an error, not zero.
+	if _, err := useCases.mastersService.GetMasterByID(ctx, masterID); err != nil {
+		return 0, err
+	}
+
 	return useCases.toysService.CountMasterToys(ctx, masterID, filters)
 }
 
@@ -124,42 +248,72 @@ func (useCases *UseCases) CountUserToys(
 	return useCases.toysService.CountMasterToys(ctx, master.ID, filters)
 }
 
+// AddToy checks Master, Category and Tags in the same transaction, in which Toy is added,
+// so they can't be changed concurrently between checks and insert.
 func (useCases *UseCases) AddToy(
 	ctx context.Context,
 	rawToyData entities.RawAddToyDTO,
 ) (uint64, error) {
-	if !validation.ValidateValueByRules(
-		rawToyData.Name,
-		useCases.validationConfig.Toy.Name,
-	) || validation.ContainsForbiddenWords(
-		rawToyData.Name,
-	) {
-		return 0, &validation.Error{Message: "invalid toy name"}
+	var toyID uint64
+
+	err := useCases.transactionManager.WithinTransaction(
+		ctx,
+		func(ctx context.Context) error {
+			var err error
+			toyID, err = useCases.addToy(ctx, rawToyData)
+
+			return err
+		},
+	)
+	if err != nil {
+		return 0, err
 	}
 
-	if !validation.ValidateValueByRules(
+	return toyID, nil
+}
+
+func (useCases *UseCases) addToy(
+	ctx context.Context,
+	rawToyData entities.RawAddToyDTO,
+) (uint64, error) {
+	var violations fieldViolations
+
+	useCases.validateText(&violations, "name", "toy name", rawToyData.Name, useCases.validationConfig.Toy.Name)
+	useCases.validateText(
+		&violations,
+		"description",
+		"toy description",
 		rawToyData.Description,
 		useCases.validationConfig.Toy.Description,
-	) || validation.ContainsForbiddenWords(
-		rawToyData.Description,
-	) {
-		return 0, &validation.Error{Message: "invalid toy description"}
-	}
+	)
 
-	if rawToyData.Price > priceCeil || rawToyData.Price < priceFloor {
-		return 0, &validation.Error{Message: "invalid toy price"}
+	master, err := useCases.GetMasterByUserID(ctx, rawToyData.UserID)
+	if err != nil {
+		return 0, err
 	}
 
-	if rawToyData.Quantity > quantityCeil || rawToyData.Quantity < quantityFloor {
-		return 0, &validation.Error{Message: "invalid toy quantity"}
+	if isMasterBlocked(master) {
+		return 0, &customerrors.MasterBlockedError{}
 	}
 
-	master, err := useCases.GetMasterByUserID(ctx, rawToyData.UserID)
+	categoryRules, err := useCases.GetCategoryRules(ctx, rawToyData.CategoryID)
 	if err != nil {
 		return 0, err
 	}
 
-	if _, err = useCases.GetCategoryByID(ctx, rawToyData.CategoryID); err != nil {
+	validateToyByCategoryRules(
+		&violations,
+		*categoryRules,
+		toyRulesData{
+			price:            rawToyData.Price,
+			quantity:         rawToyData.Quantity,
+			description:      rawToyData.Description,
+			tagsCount:        len(rawToyData.TagIDs),
+			attachmentsCount: len(rawToyData.Attachments),
+		},
+	)
+
+	if err = violations.err(); err != nil {
 		return 0, err
 	}
 
@@ -169,20 +323,280 @@ func (useCases *UseCases) AddToy(
 		}
 	}
 
+	moderationFlags, err := useCases.getToyModerationFlags(
+		ctx,
+		rawToyData.Name,
+		rawToyData.Description,
+		rawToyData.Price,
+		rawToyData.CategoryID,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Toy is sent to moderation queue, if any moderation heuristic is triggered:
+	moderationStatus := entities.ToyModerationStatusApproved
+	if len(moderationFlags) > 0 {
+		moderationStatus = entities.ToyModerationStatusPending
+	}
+
 	toyData := entities.AddToyDTO{
-		MasterID:    master.ID,
-		Name:        rawToyData.Name,
-		Description: rawToyData.Description,
-		Price:       rawToyData.Price,
-		Quantity:    rawToyData.Quantity,
-		CategoryID:  rawToyData.CategoryID,
-		TagIDs:      rawToyData.TagIDs,
-		Attachments: rawToyData.Attachments,
+		MasterID:         master.ID,
+		Name:             rawToyData.Name,
+		Description:      rawToyData.Description,
+		Price:            rawToyData.Price,
+		Quantity:         rawToyData.Quantity,
+		CategoryID:       rawToyData.CategoryID,
+		ModerationStatus: moderationStatus,
+		ModerationFlags:  joinModerationFlags(moderationFlags),
+		TagIDs:           rawToyData.TagIDs,
+		Attachments:      rawToyData.Attachments,
 	}
 
 	return useCases.toysService.AddToy(ctx, toyData)
 }
 
+// ImportToys adds Toys of Master from imported rows with the same rules as AddToy. Category and Tags are resolved
+// by names and missing Tags are created. Every row is imported in own transaction, so invalid rows are reported
+// and don't prevent import of valid ones. In dry-run mode transactions are rolled back, so nothing is written.
+// Errors, which are not related to row data, are reported for their rows as well, so client knows, which rows
+// were already imported. Import is stopped only if context is done, because the rest of rows would fail too.
+func (useCases *UseCases) ImportToys(
+	ctx context.Context,
+	userID uint64,
+	rows []entities.ImportToyRow,
+	dryRun bool,
+) ([]entities.ImportToyResult, error) {
+	master, err := useCases.GetMasterByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if isMasterBlocked(master) {
+		return nil, &customerrors.MasterBlockedError{}
+	}
+
+	categories, err := useCases.GetAllCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categoriesIDs := make(map[string]uint32, len(categories))
+	for _, category := range categories {
+		categoriesIDs[strings.ToLower(category.Name)] = category.ID
+	}
+
+	results := make([]entities.ImportToyResult, 0, len(rows))
+
+	for _, row := range rows {
+		result, err := useCases.importToy(ctx, userID, row, categoriesIDs, dryRun)
+		if err != nil {
+			err = fmt.Errorf("failed to import Toy from line %d: %w", row.Line, err)
+			if ctx.Err() != nil {
+				return nil, err
+			}
+
+			result.Err = err
+			result.Violations = []customerrors.FieldViolation{
+				{
+					Code:    customerrors.ViolationCodeInternal,
+					Message: "toy was not imported because of internal error",
+				},
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (useCases *UseCases) importToy(
+	ctx context.Context,
+	userID uint64,
+	row entities.ImportToyRow,
+	categoriesIDs map[string]uint32,
+	dryRun bool,
+) (entities.ImportToyResult, error) {
+	result := entities.ImportToyResult{Line: row.Line}
+
+	categoryID, ok := categoriesIDs[strings.ToLower(strings.TrimSpace(row.CategoryName))]
+	if !ok {
+		result.Violations = []customerrors.FieldViolation{
+			{
+				Field:   "category",
+				Code:    customerrors.ViolationCodeNotFound,
+				Message: fmt.Sprintf("category %q not found", row.CategoryName),
+			},
+		}
+
+		return result, nil
+	}
+
+	var toyID uint64
+
+	err := useCases.transactionManager.WithinTransaction(
+		ctx,
+		func(ctx context.Context) error {
+			toyData := entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        row.Name,
+				Description: row.Description,
+				Price:       row.Price,
+				Quantity:    row.Quantity,
+				Attachments: row.Attachments,
+			}
+
+			if len(row.TagNames) > 0 {
+				tagsData := make([]entities.CreateTagDTO, len(row.TagNames))
+				for i, tagName := range row.TagNames {
+					tagsData[i] = entities.CreateTagDTO{Name: tagName}
+				}
+
+				var err error
+				if toyData.TagIDs, err = useCases.CreateTags(ctx, tagsData); err != nil {
+					return err
+				}
+			}
+
+			var err error
+			if toyID, err = useCases.addToy(ctx, toyData); err != nil {
+				return err
+			}
+
+			if dryRun {
+				return errDryRun
+			}
+
+			return nil
+		},
+	)
+
+	var validationError *customerrors.ValidationError
+
+	switch {
+	case err == nil:
+		result.ToyID = &toyID
+	case errors.Is(err, errDryRun):
+	case errors.As(err, &validationError):
+		result.Violations = validationError.Violations
+	case errors.As(err, new(*customerrors.ToyAlreadyExistsError)):
+		result.Violations = []customerrors.FieldViolation{
+			{
+				Field:   "name",
+				Code:    customerrors.ViolationCodeAlreadyExists,
+				Message: "the same toy already exists",
+			},
+		}
+	default:
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ExportToys passes Toys, selected by filters and, if masterID is provided, owned by Master, to fn batch by batch,
+// so export doesn't keep all Toys in memory. Batches are read within single snapshot, so export is consistent
+// even if Toys are changed during it. Toys are ordered by ID and sorting filters are ignored.
+//
+// Snapshot is read by separate goroutine, which reads ahead at most exportToysReadAheadBatches batches, so fn,
+// which usually sends Toys to client, doesn't hold database connection. If fn doesn't take next batch within
+// exportToysReadAheadTimeout, export fails, so slow client can't hold snapshot for long. Export still holds
+// one connection of pool while reading, so server requires pool of at least config.MinMaxOpenConnections.
+func (useCases *UseCases) ExportToys(
+	ctx context.Context,
+	masterID *uint64,
+	filters *entities.ToysFilters,
+	fn func(toys []entities.Toy) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	batches := make(chan []entities.Toy, exportToysReadAheadBatches)
+	readErr := make(chan error, 1)
+
+	go func() {
+		defer close(batches)
+
+		readErr <- useCases.readToysBatches(ctx, masterID, filters, batches)
+	}()
+
+	var fnErr error
+
+	for toys := range batches {
+		if fnErr != nil {
+			continue // batches, read ahead before reading was stopped, are dropped.
+		}
+
+		if fnErr = fn(toys); fnErr != nil {
+			cancel()
+		}
+	}
+
+	if fnErr != nil {
+		return fnErr
+	}
+
+	return <-readErr
+}
+
+// readToysBatches reads Toys for ExportToys within single snapshot and passes them to batches.
+func (useCases *UseCases) readToysBatches(
+	ctx context.Context,
+	masterID *uint64,
+	filters *entities.ToysFilters,
+	batches chan<- []entities.Toy,
+) error {
+	return useCases.transactionManager.WithinSnapshot(
+		ctx,
+		func(ctx context.Context) error {
+			// Exporting Toys of non-existing Master is an error, not empty export.
+			if masterID != nil {
+				if _, err := useCases.mastersService.GetMasterByID(ctx, *masterID); err != nil {
+					return err
+				}
+			}
+
+			var afterID uint64
+
+			for {
+				toys, err := useCases.toysService.GetToysBatch(ctx, masterID, filters, afterID, exportToysBatchSize)
+				if err != nil {
+					return err
+				}
+
+				if len(toys) == 0 {
+					return nil
+				}
+
+				timer := time.NewTimer(exportToysReadAheadTimeout)
+
+				select {
+				case batches <- toys:
+					timer.Stop()
+				case <-timer.C:
+					return fmt.Errorf(
+						"exported Toys are not taken within %s: %w",
+						exportToysReadAheadTimeout,
+						context.DeadlineExceeded,
+					)
+				case <-ctx.Done():
+					timer.Stop()
+
+					return ctx.Err()
+				}
+
+				if len(toys) < exportToysBatchSize {
+					return nil
+				}
+
+				afterID = toys[len(toys)-1].ID
+			}
+		},
+	)
+}
+
 func (useCases *UseCases) GetMasterByID(ctx context.Context, id uint64) (*entities.Master, error) {
 	return useCases.mastersService.GetMasterByID(ctx, id)
 }
@@ -194,6 +608,50 @@ func (useCases *UseCases) GetMasterByUserID(
 	return useCases.mastersService.GetMasterByUserID(ctx, userID)
 }
 
+// GetUsersByIDs returns found Users by their IDs. Users are requested concurrently, so lookups are batched
+// by request-scoped loader. Missing Users are skipped. If some Users failed to load, the rest of them
+// are returned together with error, so caller can decide whether partial result is acceptable.
+func (useCases *UseCases) GetUsersByIDs(ctx context.Context, ids []uint64) (map[uint64]entities.User, error) {
+	users := make(map[uint64]entities.User, len(ids))
+
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		errs   []error
+		loaded = make(map[uint64]struct{}, len(ids))
+	)
+
+	for _, id := range ids {
+		if _, ok := loaded[id]; ok {
+			continue
+		}
+
+		loaded[id] = struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			user, err := useCases.getUserByID(ctx, id)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			switch {
+			case err == nil:
+				users[id] = *user
+			case !errors.As(err, new(*customerrors.UserNotFoundError)):
+				errs = append(errs, fmt.Errorf("failed to get User with ID=%d: %w", id, err))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return users, errors.Join(errs...)
+}
+
 func (useCases *UseCases) GetMasters(
 	ctx context.Context,
 	pagination *entities.Pagination,
@@ -210,17 +668,17 @@ func (useCases *UseCases) RegisterMaster(
 	ctx context.Context,
 	masterData entities.RegisterMasterDTO,
 ) (uint64, error) {
-	if masterData.Info != nil &&
-		(!validation.ValidateValueByRules(
-			*masterData.Info,
-			useCases.validationConfig.Master.Info,
-		) || validation.ContainsForbiddenWords(
-			*masterData.Info,
-		)) {
-		return 0, &validation.Error{Message: "invalid master info"}
+	var violations fieldViolations
+
+	if masterData.Info != nil {
+		useCases.validateText(&violations, "info", "master info", *masterData.Info, useCases.validationConfig.Master.Info)
+	}
+
+	if err := violations.err(); err != nil {
+		return 0, err
 	}
 
-	if _, err := useCases.ssoService.GetUserByID(ctx, masterData.UserID); err != nil {
+	if _, err := useCases.getUserByID(ctx, masterData.UserID); err != nil {
 		return 0, err
 	}
 
@@ -231,15 +689,20 @@ func (useCases *UseCases) CreateTags(
 	ctx context.Context,
 	tagsData []entities.CreateTagDTO,
 ) ([]uint32, error) {
-	for _, tag := range tagsData {
-		if !validation.ValidateValueByRules(
+	var violations fieldViolations
+
+	for i, tag := range tagsData {
+		useCases.validateText(
+			&violations,
+			fmt.Sprintf("tags[%d].name", i),
+			"tag name",
 			tag.Name,
 			useCases.validationConfig.Tag.Name,
-		) || validation.ContainsForbiddenWords(
-			tag.Name,
-		) {
-			return nil, &validation.Error{Message: "invalid tag name: " + tag.Name}
-		}
+		)
+	}
+
+	if err := violations.err(); err != nil {
+		return nil, err
 	}
 
 	existingTags, err := useCases.GetAllTags(ctx)
@@ -286,59 +749,145 @@ func (useCases *UseCases) CreateTags(
 }
 
 func (useCases *UseCases) DeleteToy(ctx context.Context, id uint64) error {
-	if _, err := useCases.GetToyByID(ctx, id); err != nil {
+	if _, err := useCases.GetToyByID(visibility.WithAllToyModerationStatuses(ctx), id); err != nil {
 		return err
 	}
 
 	return useCases.toysService.DeleteToy(ctx, id)
 }
 
+// UpdateToy checks Toy, Master, Category and Tags in the same transaction, in which Toy is updated,
+// so they can't be changed concurrently between checks and update. Owner can update Toy regardless
+// of its moderation status.
 func (useCases *UseCases) UpdateToy(
 	ctx context.Context,
 	rawToyData entities.RawUpdateToyDTO,
 ) error {
-	if rawToyData.Name != nil &&
-		(!validation.ValidateValueByRules(
-			*rawToyData.Name,
-			useCases.validationConfig.Toy.Name,
-		) || validation.ContainsForbiddenWords(
-			*rawToyData.Name,
-		)) {
-		return &validation.Error{Message: "invalid toy name"}
+	return useCases.transactionManager.WithinTransaction(
+		visibility.WithAllToyModerationStatuses(ctx),
+		func(ctx context.Context) error {
+			return useCases.updateToy(ctx, rawToyData)
+		},
+	)
+}
+
+func (useCases *UseCases) updateToy(
+	ctx context.Context,
+	rawToyData entities.RawUpdateToyDTO,
+) error {
+	var violations fieldViolations
+
+	if rawToyData.Name != nil {
+		useCases.validateText(&violations, "name", "toy name", *rawToyData.Name, useCases.validationConfig.Toy.Name)
 	}
 
-	if rawToyData.Description != nil &&
-		(!validation.ValidateValueByRules(
+	if rawToyData.Description != nil {
+		useCases.validateText(
+			&violations,
+			"description",
+			"toy description",
 			*rawToyData.Description,
 			useCases.validationConfig.Toy.Description,
-		) || validation.ContainsForbiddenWords(
-			*rawToyData.Description,
-		)) {
-		return &validation.Error{Message: "invalid toy description"}
+		)
+	}
+
+	toy, err := useCases.GetToyByID(ctx, rawToyData.ID)
+	if err != nil {
+		return err
 	}
 
-	if rawToyData.Price != nil && (*rawToyData.Price > priceCeil || *rawToyData.Price < priceFloor) {
-		return &validation.Error{Message: "invalid toy price"}
+	master, err := useCases.GetMasterByID(ctx, toy.MasterID)
+	if err != nil {
+		return err
 	}
 
-	if rawToyData.Quantity != nil && (*rawToyData.Quantity > quantityCeil || *rawToyData.Quantity < quantityFloor) {
-		return &validation.Error{Message: "invalid toy quantity"}
+	if isMasterBlocked(master) {
+		return &customerrors.MasterBlockedError{}
 	}
 
-	toy, err := useCases.GetToyByID(ctx, rawToyData.ID)
+	// Updated Toy is checked against rules of its new Category, if Category is changed:
+	categoryID := toy.CategoryID
+	if rawToyData.CategoryID != nil {
+		categoryID = *rawToyData.CategoryID
+	}
+
+	categoryRules, err := useCases.GetCategoryRules(ctx, categoryID)
 	if err != nil {
 		return err
 	}
 
-	if rawToyData.CategoryID != nil {
-		if _, err = useCases.GetCategoryByID(ctx, *rawToyData.CategoryID); err != nil {
+	rulesData := toyRulesData{
+		price:            toy.Price,
+		quantity:         toy.Quantity,
+		description:      toy.Description,
+		tagsCount:        len(toy.Tags),
+		attachmentsCount: len(toy.Attachments),
+	}
+
+	if rawToyData.Price != nil {
+		rulesData.price = *rawToyData.Price
+	}
+
+	if rawToyData.Quantity != nil {
+		rulesData.quantity = *rawToyData.Quantity
+	}
+
+	if rawToyData.Description != nil {
+		rulesData.description = *rawToyData.Description
+	}
+
+	if rawToyData.TagIDs != nil {
+		rulesData.tagsCount = len(rawToyData.TagIDs)
+	}
+
+	if rawToyData.Attachments != nil {
+		rulesData.attachmentsCount = len(rawToyData.Attachments)
+	}
+
+	validateToyByCategoryRules(&violations, *categoryRules, rulesData)
+
+	if err = violations.err(); err != nil {
+		return err
+	}
+
+	toyData := entities.UpdateToyDTO{
+		ID:          rawToyData.ID,
+		CategoryID:  rawToyData.CategoryID,
+		Name:        rawToyData.Name,
+		Description: rawToyData.Description,
+		Price:       rawToyData.Price,
+		Quantity:    rawToyData.Quantity,
+	}
+
+	// Tags and Attachments are replaced only if new lists are provided:
+	if rawToyData.TagIDs != nil {
+		toyData.TagIDsToAdd, toyData.TagIDsToDelete, err = useCases.diffToyTags(ctx, *toy, rawToyData.TagIDs)
+		if err != nil {
 			return err
 		}
 	}
 
-	for _, tagID := range rawToyData.TagIDs {
+	if rawToyData.Attachments != nil {
+		toyData.AttachmentsToAdd, toyData.AttachmentIDsToDelete = diffToyAttachments(*toy, rawToyData.Attachments)
+	}
+
+	toyData.ModerationStatus, toyData.ModerationFlags, err = useCases.getUpdatedToyModeration(ctx, *toy, rawToyData)
+	if err != nil {
+		return err
+	}
+
+	return useCases.toysService.UpdateToy(ctx, toyData)
+}
+
+// diffToyTags checks new Tags of Toy and returns IDs of Tags to add and to delete.
+func (useCases *UseCases) diffToyTags(
+	ctx context.Context,
+	toy entities.Toy,
+	tagIDs []uint32,
+) (tagIDsToAdd, tagIDsToDelete []uint32, err error) {
+	for _, tagID := range tagIDs {
 		if _, err = useCases.GetTagByID(ctx, tagID); err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
 
@@ -349,22 +898,22 @@ func (useCases *UseCases) UpdateToy(
 	}
 
 	// New Toy Tags IDs set:
-	newTagIDsSet := make(map[uint32]struct{}, len(rawToyData.TagIDs))
-	for _, tagID := range rawToyData.TagIDs {
+	newTagIDsSet := make(map[uint32]struct{}, len(tagIDs))
+	for _, tagID := range tagIDs {
 		newTagIDsSet[tagID] = struct{}{}
 	}
 
 	// Add new Tag if it is not already exists:
-	tagIDsToAdd := make([]uint32, 0)
+	tagIDsToAdd = make([]uint32, 0)
 
-	for _, tagID := range rawToyData.TagIDs {
+	for _, tagID := range tagIDs {
 		if _, ok := oldTagIDsSet[tagID]; !ok {
 			tagIDsToAdd = append(tagIDsToAdd, tagID)
 		}
 	}
 
 	// Delete old Tag if it is not used by Toy now:
-	tagIDsToDelete := make([]uint32, 0)
+	tagIDsToDelete = make([]uint32, 0)
 
 	for _, tag := range toy.Tags {
 		if _, ok := newTagIDsSet[tag.ID]; !ok {
@@ -372,6 +921,11 @@ func (useCases *UseCases) UpdateToy(
 		}
 	}
 
+	return tagIDsToAdd, tagIDsToDelete, nil
+}
+
+// diffToyAttachments returns links of Attachments to add and IDs of Attachments to delete.
+func diffToyAttachments(toy entities.Toy, attachments []string) ([]string, []uint64) {
 	// Old Toy Attachments set:
 	oldAttachmentsSet := make(map[string]struct{}, len(toy.Attachments))
 	for _, attachment := range toy.Attachments {
@@ -379,15 +933,15 @@ func (useCases *UseCases) UpdateToy(
 	}
 
 	// New Toy Attachments set:
-	newAttachmentsSet := make(map[string]struct{}, len(rawToyData.Attachments))
-	for _, attachment := range rawToyData.Attachments {
+	newAttachmentsSet := make(map[string]struct{}, len(attachments))
+	for _, attachment := range attachments {
 		newAttachmentsSet[attachment] = struct{}{}
 	}
 
 	// Add new Attachments if it is not already exists:
 	attachmentsToAdd := make([]string, 0)
 
-	for _, attachment := range rawToyData.Attachments {
+	for _, attachment := range attachments {
 		if _, ok := oldAttachmentsSet[attachment]; !ok {
 			attachmentsToAdd = append(attachmentsToAdd, attachment)
 		}
@@ -402,39 +956,1093 @@ func (useCases *UseCases) UpdateToy(
 		}
 	}
 
-	toyData := entities.UpdateToyDTO{
-		ID:                    rawToyData.ID,
-		CategoryID:            rawToyData.CategoryID,
-		Name:                  rawToyData.Name,
-		Description:           rawToyData.Description,
-		Price:                 rawToyData.Price,
-		Quantity:              rawToyData.Quantity,
-		TagIDsToAdd:           tagIDsToAdd,
-		TagIDsToDelete:        tagIDsToDelete,
-		AttachmentsToAdd:      attachmentsToAdd,
-		AttachmentIDsToDelete: attachmentsToDelete,
+	return attachmentsToAdd, attachmentsToDelete
+}
+
+// BatchUpdateToys changes prices and quantities of Toys of Master, which belongs to User, atomically. All items
+// are validated before update with the same rules as in UpdateToy, and Toys are updated only if no item has
+// violations. Otherwise nothing is updated and results contain violations of every invalid item.
+func (useCases *UseCases) BatchUpdateToys(
+	ctx context.Context,
+	userID uint64,
+	items []entities.BatchUpdateToyDTO,
+) ([]entities.BatchUpdateToyResult, error) {
+	var violations fieldViolations
+
+	switch {
+	case len(items) == 0:
+		violations.add("items", customerrors.ViolationCodeRequired, "toys to update are required")
+	case len(items) > batchToysCeil:
+		violations.add(
+			"items",
+			customerrors.ViolationCodeOutOfRange,
+			fmt.Sprintf("max %d toys can be updated at once", batchToysCeil),
+		)
 	}
 
-	return useCases.toysService.UpdateToy(ctx, toyData)
+	if err := violations.err(); err != nil {
+		return nil, err
+	}
+
+	var results []entities.BatchUpdateToyResult
+
+	err := useCases.transactionManager.WithinTransaction(
+		ctx,
+		func(ctx context.Context) error {
+			master, err := useCases.GetMasterByUserID(ctx, userID)
+			if err != nil {
+				return err
+			}
+
+			if isMasterBlocked(master) {
+				return &customerrors.MasterBlockedError{}
+			}
+
+			ids := make([]uint64, len(items))
+			for i, item := range items {
+				ids[i] = item.ID
+			}
+
+			toys, err := useCases.toysService.GetToysByIDs(visibility.WithAllToyModerationStatuses(ctx), ids)
+			if err != nil {
+				return err
+			}
+
+			toysByIDs := make(map[uint64]entities.Toy, len(toys))
+			for _, toy := range toys {
+				toysByIDs[toy.ID] = toy
+			}
+
+			results = make([]entities.BatchUpdateToyResult, len(items))
+			toysData := make([]entities.UpdateToyDTO, 0, len(items))
+			processed := make(map[uint64]struct{}, len(items))
+
+			for i, item := range items {
+				results[i].ID = item.ID
+
+				var itemViolations fieldViolations
+
+				toy, ok := toysByIDs[item.ID]
+
+				_, duplicated := processed[item.ID]
+				processed[item.ID] = struct{}{}
+
+				switch {
+				case duplicated:
+					itemViolations.add("ID", customerrors.ViolationCodeInvalidFormat, "toy is duplicated in batch")
+				case !ok || toy.MasterID != master.ID:
+					// Toys of other Masters are reported as missing to not disclose them:
+					itemViolations.add("ID", customerrors.ViolationCodeNotFound, "toy not found")
+				default:
+					var toyData entities.UpdateToyDTO
+					if toyData, err = useCases.validateBatchUpdateToy(ctx, &itemViolations, toy, item); err != nil {
+						return err
+					}
+
+					toysData = append(toysData, toyData)
+				}
+
+				results[i].Violations = itemViolations
+			}
+
+			if len(toysData) < len(items) {
+				return nil
+			}
+
+			for _, toyData := range toysData {
+				if err = useCases.toysService.UpdateToy(ctx, toyData); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
 }
 
-func (useCases *UseCases) UpdateMaster(
+// validateBatchUpdateToy checks new price and quantity of Toy against rules of its Category and returns data
+// for update, if item is valid.
+func (useCases *UseCases) validateBatchUpdateToy(
 	ctx context.Context,
-	masterData entities.UpdateMasterDTO,
-) error {
-	if masterData.Info != nil &&
-		(!validation.ValidateValueByRules(
-			*masterData.Info,
-			useCases.validationConfig.Master.Info,
-		) || validation.ContainsForbiddenWords(
-			*masterData.Info,
-		)) {
-		return &validation.Error{Message: "invalid master info"}
+	violations *fieldViolations,
+	toy entities.Toy,
+	item entities.BatchUpdateToyDTO,
+) (entities.UpdateToyDTO, error) {
+	if item.Price == nil && item.Quantity == nil {
+		violations.add("price", customerrors.ViolationCodeRequired, "toy price or quantity is required")
+
+		return entities.UpdateToyDTO{}, nil
 	}
 
-	if _, err := useCases.GetMasterByID(ctx, masterData.ID); err != nil {
-		return err
+	categoryRules, err := useCases.GetCategoryRules(ctx, toy.CategoryID)
+	if err != nil {
+		return entities.UpdateToyDTO{}, err
 	}
 
-	return useCases.mastersService.UpdateMaster(ctx, masterData)
+	rulesData := toyRulesData{
+		price:            toy.Price,
+		quantity:         toy.Quantity,
+		description:      toy.Description,
+		tagsCount:        len(toy.Tags),
+		attachmentsCount: len(toy.Attachments),
+	}
+
+	if item.Price != nil {
+		rulesData.price = *item.Price
+	}
+
+	if item.Quantity != nil {
+		rulesData.quantity = *item.Quantity
+	}
+
+	validateToyByCategoryRules(violations, *categoryRules, rulesData)
+
+	if len(*violations) > 0 {
+		return entities.UpdateToyDTO{}, nil
+	}
+
+	toyData := entities.UpdateToyDTO{
+		ID:       toy.ID,
+		Price:    item.Price,
+		Quantity: item.Quantity,
+	}
+
+	toyData.ModerationStatus, toyData.ModerationFlags, err = useCases.getUpdatedToyModeration(
+		ctx,
+		toy,
+		entities.RawUpdateToyDTO{ID: toy.ID, Price: item.Price, Quantity: item.Quantity},
+	)
+	if err != nil {
+		return entities.UpdateToyDTO{}, err
+	}
+
+	return toyData, nil
+}
+
+func (useCases *UseCases) ListModerationQueue(
+	ctx context.Context,
+	pagination *entities.Pagination,
+) ([]entities.Toy, error) {
+	// The oldest Toys are moderated first:
+	filters := &entities.ToysFilters{
+		ModerationStatuses:  []entities.ToyModerationStatus{entities.ToyModerationStatusPending},
+		CreatedAtOrderByAsc: pointers.New(true),
+	}
+
+	return useCases.toysService.GetToys(ctx, pagination, filters)
+}
+
+func (useCases *UseCases) ApproveToy(ctx context.Context, id uint64) error {
+	toy, err := useCases.toysService.GetToyByID(visibility.WithAllToyModerationStatuses(ctx), id)
+	if err != nil {
+		return err
+	}
+
+	if toy.ModerationStatus == entities.ToyModerationStatusApproved {
+		return &validation.Error{Message: "toy is already approved"}
+	}
+
+	return useCases.toysService.UpdateToyModeration(
+		ctx,
+		entities.ToyModerationDTO{
+			ToyID:  id,
+			Status: entities.ToyModerationStatusApproved,
+		},
+	)
+}
+
+func (useCases *UseCases) RejectToy(ctx context.Context, id uint64, reason string) error {
+	if !validation.ValidateValueByRules(
+		reason,
+		useCases.validationConfig.Toy.ModerationReason,
+	) || useCases.containsForbiddenWords(
+		reason,
+	) {
+		return &validation.Error{Message: "invalid rejection reason"}
+	}
+
+	toy, err := useCases.toysService.GetToyByID(visibility.WithAllToyModerationStatuses(ctx), id)
+	if err != nil {
+		return err
+	}
+
+	if toy.ModerationStatus == entities.ToyModerationStatusRejected {
+		return &validation.Error{Message: "toy is already rejected"}
+	}
+
+	return useCases.toysService.UpdateToyModeration(
+		ctx,
+		entities.ToyModerationDTO{
+			ToyID:           id,
+			Status:          entities.ToyModerationStatusRejected,
+			RejectionReason: &reason,
+		},
+	)
+}
+
+func (useCases *UseCases) ResubmitToy(ctx context.Context, id uint64, appeal *string) error {
+	if appeal != nil &&
+		(!validation.ValidateValueByRules(
+			*appeal,
+			useCases.validationConfig.Toy.Appeal,
+		) || useCases.containsForbiddenWords(
+			*appeal,
+		)) {
+		return &validation.Error{Message: "invalid appeal"}
+	}
+
+	toy, err := useCases.toysService.GetToyByID(visibility.WithAllToyModerationStatuses(ctx), id)
+	if err != nil {
+		return err
+	}
+
+	if toy.ModerationStatus != entities.ToyModerationStatusRejected {
+		return &validation.Error{Message: "only rejected toy can be resubmitted"}
+	}
+
+	master, err := useCases.GetMasterByID(ctx, toy.MasterID)
+	if err != nil {
+		return err
+	}
+
+	if isMasterBlocked(master) {
+		return &customerrors.MasterBlockedError{}
+	}
+
+	// Rejection reason is kept for moderators till new decision:
+	return useCases.toysService.UpdateToyModeration(
+		ctx,
+		entities.ToyModerationDTO{
+			ToyID:           id,
+			Status:          entities.ToyModerationStatusPending,
+			RejectionReason: toy.RejectionReason,
+			Appeal:          appeal,
+		},
+	)
+}
+
+func (useCases *UseCases) UpdateMaster(
+	ctx context.Context,
+	masterData entities.UpdateMasterDTO,
+) error {
+	if err := useCases.validateMasterData(masterData); err != nil {
+		return err
+	}
+
+	if _, err := useCases.GetMasterByID(ctx, masterData.ID); err != nil {
+		return err
+	}
+
+	return useCases.mastersService.UpdateMaster(ctx, masterData)
+}
+
+func (useCases *UseCases) GetMasterStats(
+	ctx context.Context,
+	masterID uint64,
+	period *entities.StatsPeriod,
+) (*entities.MasterStats, error) {
+	// Using last month as default period:
+	if period == nil {
+		now := time.Now().UTC()
+		period = &entities.StatsPeriod{
+			From: now.Add(-statsPeriodDefault),
+			To:   now,
+		}
+	}
+
+	if !period.From.Before(period.To) || period.To.Sub(period.From) > statsPeriodCeil {
+		return nil, &validation.Error{Message: "invalid stats period"}
+	}
+
+	if _, err := useCases.mastersService.GetMasterByID(ctx, masterID); err != nil {
+		return nil, err
+	}
+
+	return useCases.toysService.GetMasterToysStats(ctx, masterID, *period)
+}
+
+func (useCases *UseCases) SetVacationMode(ctx context.Context, vacationData entities.SetVacationModeDTO) error {
+	if vacationData.Until != nil {
+		now := time.Now().UTC()
+		if !vacationData.Until.After(now) || vacationData.Until.Sub(now) > vacationCeil {
+			return &validation.Error{Message: "invalid vacation end date"}
+		}
+	}
+
+	if vacationData.Message != nil &&
+		(!validation.ValidateValueByRules(
+			*vacationData.Message,
+			useCases.validationConfig.Master.VacationMessage,
+		) || useCases.containsForbiddenWords(
+			*vacationData.Message,
+		)) {
+		return &validation.Error{Message: "invalid vacation message"}
+	}
+
+	if _, err := useCases.mastersService.GetMasterByID(ctx, vacationData.MasterID); err != nil {
+		return err
+	}
+
+	return useCases.mastersService.SetVacationMode(ctx, vacationData)
+}
+
+func (useCases *UseCases) EndExpiredVacations(ctx context.Context) (uint64, error) {
+	return useCases.mastersService.EndExpiredVacations(ctx, time.Now().UTC())
+}
+
+func (useCases *UseCases) ChangeMasterStatus(ctx context.Context, statusData entities.ChangeMasterStatusDTO) error {
+	switch statusData.Status {
+	case entities.MasterStatusPending,
+		entities.MasterStatusVerified,
+		entities.MasterStatusSuspended,
+		entities.MasterStatusBanned:
+	default:
+		return &validation.Error{Message: "invalid master status"}
+	}
+
+	if !validation.ValidateValueByRules(
+		statusData.Reason,
+		useCases.validationConfig.Master.ModerationReason,
+	) || useCases.containsForbiddenWords(
+		statusData.Reason,
+	) {
+		return &validation.Error{Message: "invalid moderation reason"}
+	}
+
+	if _, err := useCases.mastersService.GetMasterByID(ctx, statusData.MasterID); err != nil {
+		return err
+	}
+
+	return useCases.mastersService.ChangeMasterStatus(ctx, statusData)
+}
+
+func (useCases *UseCases) GetMasterStatusHistory(
+	ctx context.Context,
+	masterID uint64,
+) ([]entities.MasterStatusChange, error) {
+	if _, err := useCases.mastersService.GetMasterByID(ctx, masterID); err != nil {
+		return nil, err
+	}
+
+	return useCases.mastersService.GetMasterStatusHistory(ctx, masterID)
+}
+
+func (useCases *UseCases) GetMasterBySlug(ctx context.Context, slug string) (*entities.Master, error) {
+	return useCases.mastersService.GetMasterBySlug(ctx, slug)
+}
+
+func (useCases *UseCases) GetUserFavourites(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+	filters *entities.ToysFilters,
+) ([]entities.Toy, error) {
+	return useCases.toysService.GetUserFavourites(ctx, userID, pagination, filters)
+}
+
+func (useCases *UseCases) CountUserFavourites(
+	ctx context.Context,
+	userID uint64,
+	filters *entities.ToysFilters,
+) (uint64, error) {
+	return useCases.toysService.CountUserFavourites(ctx, userID, filters)
+}
+
+func (useCases *UseCases) AddFavourite(ctx context.Context, userID, toyID uint64) (uint64, error) {
+	if _, err := useCases.getUserByID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if _, err := useCases.GetToyByID(ctx, toyID); err != nil {
+		return 0, err
+	}
+
+	return useCases.toysService.AddFavourite(ctx, userID, toyID)
+}
+
+func (useCases *UseCases) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	return useCases.toysService.RemoveFavourite(ctx, userID, toyID)
+}
+
+func (useCases *UseCases) GetFollowedMasters(
+	ctx context.Context,
+	userID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Master, error) {
+	return useCases.mastersService.GetFollowedMasters(ctx, userID, pagination)
+}
+
+func (useCases *UseCases) CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error) {
+	return useCases.mastersService.CountFollowedMasters(ctx, userID)
+}
+
+func (useCases *UseCases) FollowMaster(ctx context.Context, userID, masterID uint64) (uint64, error) {
+	if _, err := useCases.getUserByID(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	if _, err := useCases.GetMasterByID(ctx, masterID); err != nil {
+		return 0, err
+	}
+
+	return useCases.mastersService.FollowMaster(ctx, userID, masterID)
+}
+
+func (useCases *UseCases) UnfollowMaster(ctx context.Context, userID, masterID uint64) error {
+	return useCases.mastersService.UnfollowMaster(ctx, userID, masterID)
+}
+
+func (useCases *UseCases) GetFeed(
+	ctx context.Context,
+	userID uint64,
+	cursor *entities.FeedCursor,
+	limit *uint64,
+) ([]entities.Toy, error) {
+	feedSize := uint64(feedLimit)
+	if limit != nil && *limit > 0 {
+		feedSize = min(*limit, feedLimitCeil)
+	}
+
+	return useCases.toysService.GetFeed(ctx, userID, cursor, feedSize)
+}
+
+func (useCases *UseCases) AddReview(ctx context.Context, reviewData entities.AddReviewDTO) (uint64, error) {
+	if reviewData.Rating > ratingCeil || reviewData.Rating < ratingFloor {
+		return 0, &validation.Error{Message: "invalid review rating"}
+	}
+
+	if reviewData.Text != nil &&
+		(!validation.ValidateValueByRules(
+			*reviewData.Text,
+			useCases.validationConfig.Review.Text,
+		) || useCases.containsForbiddenWords(
+			*reviewData.Text,
+		)) {
+		return 0, &validation.Error{Message: "invalid review text"}
+	}
+
+	if _, err := useCases.getUserByID(ctx, reviewData.UserID); err != nil {
+		return 0, err
+	}
+
+	if _, err := useCases.GetToyByID(ctx, reviewData.ToyID); err != nil {
+		return 0, err
+	}
+
+	return useCases.reviewsService.AddReview(ctx, reviewData)
+}
+
+func (useCases *UseCases) GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error) {
+	return useCases.reviewsService.GetReviewByID(ctx, id)
+}
+
+func (useCases *UseCases) GetToyReviews(
+	ctx context.Context,
+	toyID uint64,
+	pagination *entities.Pagination,
+) ([]entities.Review, error) {
+	return useCases.reviewsService.GetToyReviews(ctx, toyID, pagination)
+}
+
+func (useCases *UseCases) CountToyReviews(ctx context.Context, toyID uint64) (uint64, error) {
+	return useCases.reviewsService.CountToyReviews(ctx, toyID)
+}
+
+func (useCases *UseCases) UpdateReview(
+	ctx context.Context,
+	rawReviewData entities.RawUpdateReviewDTO,
+) error {
+	if rawReviewData.Rating != nil && (*rawReviewData.Rating > ratingCeil || *rawReviewData.Rating < ratingFloor) {
+		return &validation.Error{Message: "invalid review rating"}
+	}
+
+	if rawReviewData.Text != nil &&
+		(!validation.ValidateValueByRules(
+			*rawReviewData.Text,
+			useCases.validationConfig.Review.Text,
+		) || useCases.containsForbiddenWords(
+			*rawReviewData.Text,
+		)) {
+		return &validation.Error{Message: "invalid review text"}
+	}
+
+	review, err := useCases.GetReviewByID(ctx, rawReviewData.ID)
+	if err != nil {
+		return err
+	}
+
+	reviewData := entities.UpdateReviewDTO{
+		ID:     rawReviewData.ID,
+		Rating: rawReviewData.Rating,
+		Text:   rawReviewData.Text,
+	}
+
+	// Photos are replaced only if new list is provided:
+	if rawReviewData.Photos != nil {
+		reviewData.PhotosToAdd, reviewData.PhotoIDsToDelete = diffReviewPhotos(*review, rawReviewData.Photos)
+	}
+
+	return useCases.reviewsService.UpdateReview(ctx, reviewData)
+}
+
+// diffReviewPhotos returns links of Photos to add and IDs of Photos to delete.
+func diffReviewPhotos(review entities.Review, photos []string) ([]string, []uint64) {
+	// Old Review Photos set:
+	oldPhotosSet := make(map[string]struct{}, len(review.Photos))
+	for _, photo := range review.Photos {
+		oldPhotosSet[photo.Link] = struct{}{}
+	}
+
+	// New Review Photos set:
+	newPhotosSet := make(map[string]struct{}, len(photos))
+	for _, photo := range photos {
+		newPhotosSet[photo] = struct{}{}
+	}
+
+	// Add new Photos if it is not already exists:
+	photosToAdd := make([]string, 0)
+
+	for _, photo := range photos {
+		if _, ok := oldPhotosSet[photo]; !ok {
+			photosToAdd = append(photosToAdd, photo)
+		}
+	}
+
+	// Delete old Photos if it is not used by Review now:
+	photoIDsToDelete := make([]uint64, 0)
+
+	for _, photo := range review.Photos {
+		if _, ok := newPhotosSet[photo.Link]; !ok {
+			photoIDsToDelete = append(photoIDsToDelete, photo.ID)
+		}
+	}
+
+	return photosToAdd, photoIDsToDelete
+}
+
+func (useCases *UseCases) DeleteReview(ctx context.Context, id uint64) error {
+	if _, err := useCases.GetReviewByID(ctx, id); err != nil {
+		return err
+	}
+
+	return useCases.reviewsService.DeleteReview(ctx, id)
+}
+
+// ReloadForbiddenWords reloads forbidden words dictionary, so moderators' changes are applied without restart.
+func (useCases *UseCases) ReloadForbiddenWords(ctx context.Context) (int, error) {
+	return useCases.forbiddenWordsService.ReloadForbiddenWords(ctx)
+}
+
+// ProcessUserEvent applies changes of User's account in SSO to User's Master. Processing is idempotent,
+// because events are delivered at least once.
+func (useCases *UseCases) ProcessUserEvent(ctx context.Context, event entities.UserEvent) error {
+	master, err := useCases.mastersService.GetMasterByUserID(ctx, event.UserID)
+	if errors.As(err, new(*customerrors.MasterNotFoundError)) {
+		return nil // User is not a Master.
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case entities.UserEventTypeBanned:
+		return useCases.banMaster(ctx, *master, bannedUserReason)
+	case entities.UserEventTypeDeleted:
+		return useCases.forgetMaster(ctx, *master)
+	default:
+		return nil // new types of events, which are not supported yet.
+	}
+}
+
+// ReconcileMasters finds Masters, whose Users don't exist in SSO anymore, for example, because event about
+// User's deletion was lost. Found Masters are processed as Masters of deleted Users, unless dryRun is set.
+// IDs of found Masters are returned.
+func (useCases *UseCases) ReconcileMasters(ctx context.Context, dryRun bool) ([]uint64, error) {
+	limit := max(useCases.userEventsConfig.ReconciliationBatchSize, 1)
+
+	// Forgotten Masters are skipped, so SSO is not asked about them on every run:
+	filters := &entities.MastersFilters{ExcludeBannedForReason: pointers.New(deletedUserReason)}
+
+	var (
+		orphanedMasters []entities.Master
+		lastID          uint64
+	)
+
+	for {
+		// Keyset pagination is not affected by Masters, which are changed or added during scan:
+		filters.IDAfter = pointers.New(lastID)
+
+		masters, err := useCases.mastersService.GetMasters(
+			ctx,
+			&entities.Pagination{Limit: pointers.New(limit)},
+			filters,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, master := range masters {
+			_, err = useCases.getUserByID(ctx, master.UserID)
+
+			switch {
+			case errors.As(err, new(*customerrors.UserNotFoundError)):
+				orphanedMasters = append(orphanedMasters, master)
+			case err != nil:
+				// Unavailable SSO should not be confused with missing User:
+				return nil, err
+			}
+		}
+
+		if uint64(len(masters)) < limit {
+			break
+		}
+
+		lastID = masters[len(masters)-1].ID
+	}
+
+	orphanedMastersIDs := make([]uint64, len(orphanedMasters))
+	for i, master := range orphanedMasters {
+		orphanedMastersIDs[i] = master.ID
+	}
+
+	if dryRun {
+		return orphanedMastersIDs, nil
+	}
+
+	for _, master := range orphanedMasters {
+		if err := useCases.forgetMaster(ctx, master); err != nil {
+			return nil, err
+		}
+	}
+
+	return orphanedMastersIDs, nil
+}
+
+// ExportUserData assembles archive of all data, which is stored about User: Master's profile with status history
+// and all Toys, including hidden ones, with their price history, and also User's Favourites and Reviews.
+// Data is read from single snapshot, so archive is consistent even if User's data is changed concurrently.
+func (useCases *UseCases) ExportUserData(ctx context.Context, userID uint64) (*entities.UserDataExport, error) {
+	export := &entities.UserDataExport{
+		Version:    entities.UserDataExportVersion,
+		UserID:     userID,
+		ExportedAt: time.Now().UTC(),
+	}
+
+	err := useCases.transactionManager.WithinSnapshot(
+		ctx,
+		func(ctx context.Context) error {
+			master, err := useCases.mastersService.GetMasterByUserID(ctx, userID)
+
+			switch {
+			case errors.As(err, new(*customerrors.MasterNotFoundError)):
+				// User is not a Master, so only Favourites and Reviews are exported.
+			case err != nil:
+				return err
+			default:
+				export.Master = master
+
+				if export.MasterStatusHistory, err = useCases.mastersService.GetMasterStatusHistory(
+					ctx,
+					master.ID,
+				); err != nil {
+					return err
+				}
+
+				if export.Toys, err = useCases.toysService.GetAllMasterToys(ctx, master.ID); err != nil {
+					return err
+				}
+
+				if export.ToysPriceHistory, err = useCases.toysService.GetMasterToysPriceHistory(
+					ctx,
+					master.ID,
+				); err != nil {
+					return err
+				}
+			}
+
+			if export.Favourites, err = useCases.toysService.GetUserFavouritesRecords(ctx, userID); err != nil {
+				return err
+			}
+
+			export.Reviews, err = useCases.reviewsService.GetUserReviews(ctx, userID)
+
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// banMaster hides Master with all Toys, if Master is not banned yet.
+func (useCases *UseCases) banMaster(ctx context.Context, master entities.Master, reason string) error {
+	if master.Status == entities.MasterStatusBanned {
+		return nil
+	}
+
+	return useCases.mastersService.ChangeMasterStatus(
+		ctx,
+		entities.ChangeMasterStatusDTO{
+			MasterID: master.ID,
+			Status:   entities.MasterStatusBanned,
+			Reason:   reason,
+		},
+	)
+}
+
+// forgetMaster bans Master of deleted User, archives or deletes Toys according to config and removes
+// personal data from Master's profile. Master is banned with deletedUserReason even if it is already banned
+// for another reason, so already forgotten Masters can be recognized and skipped.
+func (useCases *UseCases) forgetMaster(ctx context.Context, master entities.Master) error {
+	return useCases.transactionManager.WithinTransaction(
+		ctx,
+		func(ctx context.Context) error {
+			forgotten, err := useCases.isMasterForgotten(ctx, master)
+			if err != nil || forgotten {
+				return err
+			}
+
+			err = useCases.mastersService.ChangeMasterStatus(
+				ctx,
+				entities.ChangeMasterStatusDTO{
+					MasterID: master.ID,
+					Status:   entities.MasterStatusBanned,
+					Reason:   deletedUserReason,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			// Toys of banned Master are hidden, so they are archived without additional changes:
+			if useCases.userEventsConfig.DeletedUserToysPolicy == config.DeletedUserToysPolicyDelete {
+				if _, err = useCases.toysService.DeleteMasterToys(ctx, master.ID); err != nil {
+					return err
+				}
+			}
+
+			if master.VacationUntil != nil {
+				err = useCases.mastersService.SetVacationMode(
+					ctx,
+					entities.SetVacationModeDTO{MasterID: master.ID},
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			// All profile fields are listed in mask without values, so all of them are cleared:
+			return useCases.mastersService.UpdateMaster(
+				ctx,
+				entities.UpdateMasterDTO{
+					ID: master.ID,
+					UpdateMask: []string{
+						entities.MasterFieldInfo,
+						entities.MasterFieldShopName,
+						entities.MasterFieldSlug,
+						entities.MasterFieldAvatar,
+						entities.MasterFieldBanner,
+						entities.MasterFieldCity,
+						entities.MasterFieldRegion,
+						entities.MasterFieldPolicies,
+						entities.MasterFieldSocialLinks,
+						entities.MasterFieldShipsTo,
+					},
+				},
+			)
+		},
+	)
+}
+
+// isMasterForgotten checks, if Master was already processed as Master of deleted User.
+func (useCases *UseCases) isMasterForgotten(ctx context.Context, master entities.Master) (bool, error) {
+	if master.Status != entities.MasterStatusBanned {
+		return false, nil
+	}
+
+	history, err := useCases.mastersService.GetMasterStatusHistory(ctx, master.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// History starts from the newest change, which is the reason of current status:
+	return len(history) > 0 && history[0].Reason == deletedUserReason, nil
+}
+
+// getUserByID gets User through request-scoped loader, if it is provided, so concurrent lookups of Users,
+// made while handling the same request, are batched.
+func (useCases *UseCases) getUserByID(ctx context.Context, id uint64) (*entities.User, error) {
+	if usersLoader, ok := loaders.UsersLoaderFromContext(ctx); ok {
+		return usersLoader.GetUserByID(ctx, id)
+	}
+
+	return useCases.ssoService.GetUserByID(ctx, id)
+}
+
+// containsForbiddenWords checks value both against built-in list and configurable dictionary.
+func (useCases *UseCases) containsForbiddenWords(value string) bool {
+	return validation.ContainsForbiddenWords(value) || useCases.forbiddenWordsService.ContainsForbiddenWords(value)
+}
+
+// validateMasterData checks all provided Master fields and returns all found violations at once.
+func (useCases *UseCases) validateMasterData(masterData entities.UpdateMasterDTO) error {
+	rules := useCases.validationConfig.Master
+
+	// Free text fields are additionally checked for forbidden words:
+	textFields := []struct {
+		field   string
+		subject string
+		value   *string
+		rules   []string
+	}{
+		{field: "info", subject: "master info", value: masterData.Info, rules: rules.Info},
+		{field: "shopName", subject: "master shop name", value: masterData.ShopName, rules: rules.ShopName},
+		{field: "city", subject: "master city", value: masterData.City, rules: rules.Location},
+		{field: "region", subject: "master region", value: masterData.Region, rules: rules.Location},
+		{field: "policies", subject: "master policies", value: masterData.Policies, rules: rules.Policies},
+	}
+
+	var violations fieldViolations
+
+	for _, textField := range textFields {
+		if textField.value != nil {
+			useCases.validateText(&violations, textField.field, textField.subject, *textField.value, textField.rules)
+		}
+	}
+
+	if masterData.Slug != nil && !validation.ValidateValueByRules(*masterData.Slug, rules.Slug) {
+		violations.add("slug", customerrors.ViolationCodeInvalidFormat, "invalid master slug")
+	}
+
+	if masterData.Avatar != nil && !validation.ValidateValueByRules(*masterData.Avatar, rules.Link) {
+		violations.add("avatar", customerrors.ViolationCodeInvalidFormat, "invalid master avatar")
+	}
+
+	if masterData.Banner != nil && !validation.ValidateValueByRules(*masterData.Banner, rules.Link) {
+		violations.add("banner", customerrors.ViolationCodeInvalidFormat, "invalid master banner")
+	}
+
+	for i, link := range masterData.SocialLinks {
+		if !validation.ValidateValueByRules(link, rules.Link) {
+			violations.add(
+				fmt.Sprintf("socialLinks[%d]", i),
+				customerrors.ViolationCodeInvalidFormat,
+				"invalid master social link: "+link,
+			)
+		}
+	}
+
+	for i, region := range masterData.ShipsTo {
+		if !validation.ValidateValueByRules(region, rules.Location) {
+			violations.add(
+				fmt.Sprintf("shipsTo[%d]", i),
+				customerrors.ViolationCodeInvalidFormat,
+				"invalid master ships to region: "+region,
+			)
+		}
+	}
+
+	return violations.err()
+}
+
+// fieldViolations collects failed validation rules, so all of them can be returned to client at once.
+type fieldViolations []customerrors.FieldViolation
+
+func (violations *fieldViolations) add(field, code, message string) {
+	*violations = append(
+		*violations,
+		customerrors.FieldViolation{
+			Field:   field,
+			Code:    code,
+			Message: message,
+		},
+	)
+}
+
+// err returns ValidationError with all collected violations or nil, if there are no violations.
+func (violations fieldViolations) err() error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &customerrors.ValidationError{Violations: violations}
+}
+
+// validateText checks value by rules and forbidden words and adds violation of field, if value is invalid.
+func (useCases *UseCases) validateText(
+	violations *fieldViolations,
+	field, subject, value string,
+	rules []string,
+) {
+	if !validation.ValidateValueByRules(value, rules) {
+		violations.add(field, customerrors.ViolationCodeInvalidFormat, "invalid "+subject)
+
+		return
+	}
+
+	if useCases.containsForbiddenWords(value) {
+		violations.add(field, customerrors.ViolationCodeForbiddenWords, subject+" contains forbidden words")
+	}
+}
+
+// toyRulesData contains Toy data, which is checked against Category rules.
+type toyRulesData struct {
+	price            float32
+	quantity         uint32
+	description      string
+	tagsCount        int
+	attachmentsCount int
+}
+
+// validateToyByCategoryRules checks Toy data against Category rules, which are already merged with default ones.
+func validateToyByCategoryRules(violations *fieldViolations, rules entities.CategoryRules, toyData toyRulesData) {
+	if toyData.price < *rules.PriceFloor || toyData.price > *rules.PriceCeil {
+		violations.add("price", customerrors.ViolationCodeOutOfRange, "invalid toy price")
+	}
+
+	if toyData.quantity < *rules.QuantityFloor || toyData.quantity > *rules.QuantityCeil {
+		violations.add("quantity", customerrors.ViolationCodeOutOfRange, "invalid toy quantity")
+	}
+
+	if rules.DescriptionMinLength != nil &&
+		utf8.RuneCountInString(toyData.description) < int(*rules.DescriptionMinLength) {
+		violations.add("description", customerrors.ViolationCodeTooShort, "toy description is too short for category")
+	}
+
+	for _, attribute := range rules.RequiredAttributes {
+		switch attribute {
+		case entities.ToyAttributeTags:
+			if toyData.tagsCount == 0 {
+				violations.add("tagIDs", customerrors.ViolationCodeRequired, "toy tags are required for category")
+			}
+		case entities.ToyAttributeAttachments:
+			if toyData.attachmentsCount == 0 {
+				violations.add(
+					"attachments",
+					customerrors.ViolationCodeRequired,
+					"toy attachments are required for category",
+				)
+			}
+		}
+	}
+}
+
+// isMasterBlocked checks, whether Master is not allowed to add and update Toys due to moderation.
+func isMasterBlocked(master *entities.Master) bool {
+	return master.Status == entities.MasterStatusSuspended || master.Status == entities.MasterStatusBanned
+}
+
+// getToyModerationFlags checks Toy data by moderation heuristics and returns descriptions of triggered ones.
+// Toy can be published without moderation, if no heuristic is triggered.
+func (useCases *UseCases) getToyModerationFlags(
+	ctx context.Context,
+	name, description string,
+	price float32,
+	categoryID uint32,
+) ([]string, error) {
+	rules := useCases.validationConfig.Toy.Moderation
+	text := strings.ToLower(name + " " + description)
+
+	var flags []string
+
+	var suspiciousWords []string
+
+	for _, word := range rules.SuspiciousWords {
+		if word != "" && strings.Contains(text, strings.ToLower(word)) {
+			suspiciousWords = append(suspiciousWords, word)
+		}
+	}
+
+	if len(suspiciousWords) > 0 {
+		flags = append(flags, "suspicious words: "+strings.Join(suspiciousWords, ", "))
+	}
+
+	for _, pattern := range rules.ExternalLinks {
+		if pattern.MatchString(description) {
+			flags = append(flags, "external links in description")
+
+			break
+		}
+	}
+
+	if rules.PriceOutlierRatio > 0 {
+		stats, err := useCases.toysService.GetCategoryPriceStats(ctx, categoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		ratio := float64(rules.PriceOutlierRatio)
+		if stats.ToysCount >= uint64(rules.PriceOutlierMinToys) && stats.AveragePrice > 0 &&
+			(float64(price) > stats.AveragePrice*ratio || float64(price) < stats.AveragePrice/ratio) {
+			flags = append(flags, "price outlier for category")
+		}
+	}
+
+	return flags, nil
+}
+
+func joinModerationFlags(flags []string) *string {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	return pointers.New(strings.Join(flags, "; "))
+}
+
+// getUpdatedToyModeration returns new moderation status and flags of updated Toy or nil status,
+// if moderation status should not be changed.
+func (useCases *UseCases) getUpdatedToyModeration(
+	ctx context.Context,
+	toy entities.Toy,
+	rawToyData entities.RawUpdateToyDTO,
+) (*entities.ToyModerationStatus, *string, error) {
+	contentUpdated := rawToyData.Name != nil || rawToyData.Description != nil ||
+		rawToyData.Price != nil || rawToyData.CategoryID != nil
+
+	// Editing of rejected Toy is a resubmission, so it is checked by moderators again:
+	if !contentUpdated && toy.ModerationStatus != entities.ToyModerationStatusRejected {
+		return nil, nil, nil
+	}
+
+	if rawToyData.Name != nil {
+		toy.Name = *rawToyData.Name
+	}
+
+	if rawToyData.Description != nil {
+		toy.Description = *rawToyData.Description
+	}
+
+	if rawToyData.Price != nil {
+		toy.Price = *rawToyData.Price
+	}
+
+	if rawToyData.CategoryID != nil {
+		toy.CategoryID = *rawToyData.CategoryID
+	}
+
+	moderationFlags, err := useCases.getToyModerationFlags(
+		ctx,
+		toy.Name,
+		toy.Description,
+		toy.Price,
+		toy.CategoryID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Approved Toy stays approved, if no moderation heuristic is triggered:
+	if len(moderationFlags) == 0 && toy.ModerationStatus == entities.ToyModerationStatusApproved {
+		return nil, nil, nil
+	}
+
+	return pointers.New(entities.ToyModerationStatusPending), joinModerationFlags(moderationFlags), nil
 }
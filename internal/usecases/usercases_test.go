@@ -3,9 +3,11 @@ package usecases
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/DKhorkov/hmtm-toys/internal/config"
 	"github.com/DKhorkov/libs/validation"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -13,7 +15,9 @@ import (
 	"github.com/DKhorkov/libs/pointers"
 
 	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	customerrors "github.com/DKhorkov/hmtm-toys/internal/errors"
 	mockservices "github.com/DKhorkov/hmtm-toys/mocks/services"
+	mocktransactions "github.com/DKhorkov/hmtm-toys/mocks/transactions"
 )
 
 const (
@@ -28,8 +32,62 @@ var (
 	ctx              = context.Background()
 	cfg              = config.New()
 	validationConfig = cfg.Validation
+	userEventsConfig = cfg.UserEvents
 )
 
+// newForbiddenWordsService creates ForbiddenWordsService with empty dictionary, so only built-in list is checked.
+func newForbiddenWordsService(ctrl *gomock.Controller) *mockservices.MockForbiddenWordsService {
+	forbiddenWordsService := mockservices.NewMockForbiddenWordsService(ctrl)
+	forbiddenWordsService.
+		EXPECT().
+		ContainsForbiddenWords(gomock.Any()).
+		Return(false).
+		AnyTimes()
+
+	return forbiddenWordsService
+}
+
+// forgottenMasterData returns update of Master of deleted User, which clears all profile fields by update mask.
+func forgottenMasterData(masterID uint64) entities.UpdateMasterDTO {
+	return entities.UpdateMasterDTO{
+		ID: masterID,
+		UpdateMask: []string{
+			entities.MasterFieldInfo,
+			entities.MasterFieldShopName,
+			entities.MasterFieldSlug,
+			entities.MasterFieldAvatar,
+			entities.MasterFieldBanner,
+			entities.MasterFieldCity,
+			entities.MasterFieldRegion,
+			entities.MasterFieldPolicies,
+			entities.MasterFieldSocialLinks,
+			entities.MasterFieldShipsTo,
+		},
+	}
+}
+
+// newTransactionManager creates TransactionManager mock, which runs unit of work without transaction.
+func newTransactionManager(ctrl *gomock.Controller) *mocktransactions.MockTransactionManager {
+	run := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	transactionManager := mocktransactions.NewMockTransactionManager(ctrl)
+	transactionManager.
+		EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(run).
+		AnyTimes()
+
+	transactionManager.
+		EXPECT().
+		WithinSnapshot(gomock.Any(), gomock.Any()).
+		DoAndReturn(run).
+		AnyTimes()
+
+	return transactionManager
+}
+
 func TestUseCases_GetTagByID(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -78,13 +136,20 @@ func TestUseCases_GetTagByID(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -162,13 +227,20 @@ func TestUseCases_GetAllTags(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -244,13 +316,20 @@ func TestUseCases_GetCategoryByID(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -277,6 +356,146 @@ func TestUseCases_GetCategoryByID(t *testing.T) {
 	}
 }
 
+func TestUseCases_GetCategoryRules(t *testing.T) {
+	testCases := []struct {
+		name       string
+		categoryID uint32
+		setupMocks func(
+			tagsService *mockservices.MockTagsService,
+			categoriesService *mockservices.MockCategoriesService,
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+			ssoService *mockservices.MockSsoService,
+		)
+		expected      *entities.CategoryRules
+		errorExpected bool
+	}{
+		{
+			name:       "success with default rules",
+			categoryID: categoryID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
+			expected: &entities.CategoryRules{
+				CategoryID:    categoryID,
+				PriceFloor:    pointers.New[float32](1),
+				PriceCeil:     pointers.New[float32](1_000_000),
+				QuantityFloor: pointers.New[uint32](1),
+				QuantityCeil:  pointers.New[uint32](1_000),
+			},
+		},
+		{
+			name:       "success with Category rules",
+			categoryID: categoryID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(
+						&entities.CategoryRules{
+							CategoryID:           categoryID,
+							PriceFloor:           pointers.New[float32](100),
+							PriceCeil:            pointers.New[float32](5_000_000),
+							QuantityCeil:         pointers.New[uint32](10),
+							DescriptionMinLength: pointers.New[uint32](50),
+							RequiredAttributes:   []entities.ToyAttribute{entities.ToyAttributeAttachments},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected: &entities.CategoryRules{
+				CategoryID:           categoryID,
+				PriceFloor:           pointers.New[float32](100),
+				PriceCeil:            pointers.New[float32](5_000_000),
+				QuantityFloor:        pointers.New[uint32](1),
+				QuantityCeil:         pointers.New[uint32](10),
+				DescriptionMinLength: pointers.New[uint32](50),
+				RequiredAttributes:   []entities.ToyAttribute{entities.ToyAttributeAttachments},
+			},
+		},
+		{
+			name:       "Category not found",
+			categoryID: categoryID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(nil, &customerrors.CategoryNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(
+					tagsService,
+					categoriesService,
+					mastersService,
+					toysService,
+					ssoService,
+				)
+			}
+
+			actual, err := useCases.GetCategoryRules(ctx, tc.categoryID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestUseCases_GetAllCategories(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -328,13 +547,20 @@ func TestUseCases_GetAllCategories(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -410,13 +636,20 @@ func TestUseCases_GetToyByID(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -524,13 +757,20 @@ func TestUseCases_GetToys(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -617,13 +857,20 @@ func TestUseCases_CountToys(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -680,10 +927,16 @@ func TestUseCases_CountMasterToys(t *testing.T) {
 			setupMocks: func(
 				_ *mockservices.MockTagsService,
 				_ *mockservices.MockCategoriesService,
-				_ *mockservices.MockMastersService,
+				mastersService *mockservices.MockMastersService,
 				toysService *mockservices.MockToysService,
 				_ *mockservices.MockSsoService,
 			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
 				toysService.
 					EXPECT().
 					CountMasterToys(
@@ -704,6 +957,24 @@ func TestUseCases_CountMasterToys(t *testing.T) {
 			},
 			expected: 1,
 		},
+		{
+			name:     "Master not found",
+			masterID: masterID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
 	}
 
 	ctrl := gomock.NewController(t)
@@ -712,13 +983,20 @@ func TestUseCases_CountMasterToys(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -843,13 +1121,20 @@ func TestUseCases_CountUserToys(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -960,13 +1245,20 @@ func TestUseCases_GetMasterToys(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1111,13 +1403,20 @@ func TestUseCases_GetUserToys(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1193,13 +1492,20 @@ func TestUseCases_GetMasterByID(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1297,13 +1603,20 @@ func TestUseCases_GetMasters(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1380,13 +1693,20 @@ func TestUseCases_CountMasters(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1462,13 +1782,20 @@ func TestUseCases_GetMasterByUserID(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1495,6 +1822,94 @@ func TestUseCases_GetMasterByUserID(t *testing.T) {
 	}
 }
 
+func TestUseCases_GetUsersByIDs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ids           []uint64
+		setupMocks    func(ssoService *mockservices.MockSsoService)
+		expected      map[uint64]entities.User
+		errorExpected bool
+	}{
+		{
+			name: "success with duplicated and missing Users",
+			ids:  []uint64{userID, userID, 2},
+			setupMocks: func(ssoService *mockservices.MockSsoService) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID, DisplayName: "test"}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(2)).
+					Return(nil, &customerrors.UserNotFoundError{}).
+					Times(1)
+			},
+			expected: map[uint64]entities.User{
+				userID: {ID: userID, DisplayName: "test"},
+			},
+		},
+		{
+			name: "partial result, when some Users failed to load",
+			ids:  []uint64{userID, 3},
+			setupMocks: func(ssoService *mockservices.MockSsoService) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID, DisplayName: "test"}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), uint64(3)).
+					Return(nil, errors.New("sso is unavailable")).
+					Times(1)
+			},
+			expected: map[uint64]entities.User{
+				userID: {ID: userID, DisplayName: "test"},
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "without IDs",
+			expected: map[uint64]entities.User{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	useCases := New(
+		mockservices.NewMockTagsService(ctrl),
+		mockservices.NewMockCategoriesService(ctrl),
+		mockservices.NewMockMastersService(ctrl),
+		mockservices.NewMockToysService(ctrl),
+		ssoService,
+		mockservices.NewMockReviewsService(ctrl),
+		newForbiddenWordsService(ctrl),
+		newTransactionManager(ctrl),
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(ssoService)
+			}
+
+			actual, err := useCases.GetUsersByIDs(ctx, tc.ids)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestUseCases_AddToy(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1542,13 +1957,8 @@ func TestUseCases_AddToy(t *testing.T) {
 
 				categoriesService.
 					EXPECT().
-					GetCategoryByID(gomock.Any(), categoryID).
-					Return(
-						&entities.Category{
-							ID:   categoryID,
-							Name: "test",
-						}, nil,
-					).
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
 					Times(1)
 
 				tagsService.
@@ -1562,19 +1972,33 @@ func TestUseCases_AddToy(t *testing.T) {
 					).
 					Times(1)
 
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(
+						&entities.CategoryPriceStats{
+							CategoryID:   categoryID,
+							ToysCount:    20,
+							AveragePrice: 100,
+						},
+						nil,
+					).
+					Times(1)
+
 				toysService.
 					EXPECT().
 					AddToy(
 						gomock.Any(),
 						entities.AddToyDTO{
-							MasterID:    masterID,
-							CategoryID:  categoryID,
-							Name:        "Игрушка",
-							Description: "Тестовая игрушка",
-							Quantity:    1,
-							Price:       110.5,
-							TagIDs:      []uint32{tagID},
-							Attachments: []string{"test"},
+							MasterID:         masterID,
+							CategoryID:       categoryID,
+							Name:             "Игрушка",
+							Description:      "Тестовая игрушка",
+							Quantity:         1,
+							Price:            110.5,
+							ModerationStatus: entities.ToyModerationStatusApproved,
+							TagIDs:           []uint32{tagID},
+							Attachments:      []string{"test"},
 						},
 					).
 					Return(toyID, nil).
@@ -1583,19 +2007,17 @@ func TestUseCases_AddToy(t *testing.T) {
 			expected: toyID,
 		},
 		{
-			name: "Master not found",
+			name: "success with moderation",
 			toy: entities.RawAddToyDTO{
 				UserID:      userID,
 				CategoryID:  categoryID,
 				Name:        "Игрушка",
-				Description: "Тестовая игрушка",
+				Description: "Тестовая игрушка оптом",
 				Quantity:    1,
-				Price:       110.5,
-				TagIDs:      []uint32{tagID},
-				Attachments: []string{"test"},
+				Price:       1100,
 			},
 			setupMocks: func(
-				tagsService *mockservices.MockTagsService,
+				_ *mockservices.MockTagsService,
 				categoriesService *mockservices.MockCategoriesService,
 				mastersService *mockservices.MockMastersService,
 				toysService *mockservices.MockToysService,
@@ -1604,14 +2026,58 @@ func TestUseCases_AddToy(t *testing.T) {
 				mastersService.
 					EXPECT().
 					GetMasterByUserID(gomock.Any(), userID).
-					Return(nil, errors.New("test")).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+						},
+						nil,
+					).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(
+						&entities.CategoryPriceStats{
+							CategoryID:   categoryID,
+							ToysCount:    20,
+							AveragePrice: 100,
+						},
+						nil,
+					).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					AddToy(
+						gomock.Any(),
+						entities.AddToyDTO{
+							MasterID:         masterID,
+							CategoryID:       categoryID,
+							Name:             "Игрушка",
+							Description:      "Тестовая игрушка оптом",
+							Quantity:         1,
+							Price:            1100,
+							ModerationStatus: entities.ToyModerationStatusPending,
+							ModerationFlags: pointers.New[string](
+								"suspicious words: оптом; price outlier for category",
+							),
+						},
+					).
+					Return(toyID, nil).
 					Times(1)
 			},
-			expected:      0,
-			errorExpected: true,
+			expected: toyID,
 		},
 		{
-			name: "Category not found",
+			name: "Master blocked",
 			toy: entities.RawAddToyDTO{
 				UserID:      userID,
 				CategoryID:  categoryID,
@@ -1619,8 +2085,68 @@ func TestUseCases_AddToy(t *testing.T) {
 				Description: "Тестовая игрушка",
 				Quantity:    1,
 				Price:       110.5,
-				TagIDs:      []uint32{tagID},
-				Attachments: []string{"test"},
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+							Status: entities.MasterStatusBanned,
+						},
+						nil,
+					).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "Master not found",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "Игрушка",
+				Description: "Тестовая игрушка",
+				Quantity:    1,
+				Price:       110.5,
+				TagIDs:      []uint32{tagID},
+				Attachments: []string{"test"},
+			},
+			setupMocks: func(
+				tagsService *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			expected:      0,
+			errorExpected: true,
+		},
+		{
+			name: "Category not found",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "Игрушка",
+				Description: "Тестовая игрушка",
+				Quantity:    1,
+				Price:       110.5,
+				TagIDs:      []uint32{tagID},
+				Attachments: []string{"test"},
 			},
 			setupMocks: func(
 				tagsService *mockservices.MockTagsService,
@@ -1643,7 +2169,7 @@ func TestUseCases_AddToy(t *testing.T) {
 
 				categoriesService.
 					EXPECT().
-					GetCategoryByID(gomock.Any(), categoryID).
+					GetCategoryRules(gomock.Any(), categoryID).
 					Return(nil, errors.New("test")).
 					Times(1)
 			},
@@ -1683,13 +2209,8 @@ func TestUseCases_AddToy(t *testing.T) {
 
 				categoriesService.
 					EXPECT().
-					GetCategoryByID(gomock.Any(), categoryID).
-					Return(
-						&entities.Category{
-							ID:   categoryID,
-							Name: "test",
-						}, nil,
-					).
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
 					Times(1)
 
 				tagsService.
@@ -1713,6 +2234,31 @@ func TestUseCases_AddToy(t *testing.T) {
 				TagIDs:      []uint32{tagID},
 				Attachments: []string{"test"},
 			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+						},
+						nil,
+					).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
 			expected:      0,
 			errorExpected: true,
 		},
@@ -1728,161 +2274,288 @@ func TestUseCases_AddToy(t *testing.T) {
 				TagIDs:      []uint32{tagID},
 				Attachments: []string{"test"},
 			},
-			expected:      0,
-			errorExpected: true,
-		},
-		{
-			name: "invalid name",
-			toy: entities.RawAddToyDTO{
-				UserID:      userID,
-				CategoryID:  categoryID,
-				Name:        "Мразь",
-				Description: "Тестовая игрушка",
-				Quantity:    1,
-				Price:       1_000_000_000,
-				TagIDs:      []uint32{tagID},
-				Attachments: []string{"test"},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+						},
+						nil,
+					).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
 			},
 			expected:      0,
 			errorExpected: true,
 		},
 		{
-			name: "invalid description",
+			name: "price is higher than default ceil, but allowed by Category",
 			toy: entities.RawAddToyDTO{
 				UserID:      userID,
 				CategoryID:  categoryID,
-				Name:        "test",
-				Description: "Конченная мразь",
+				Name:        "Игрушка",
+				Description: "Тестовая игрушка",
 				Quantity:    1,
-				Price:       1_000_000_000,
-				TagIDs:      []uint32{tagID},
-				Attachments: []string{"test"},
-			},
-			expected:      0,
-			errorExpected: true,
-		},
-	}
-
-	ctrl := gomock.NewController(t)
-	tagsService := mockservices.NewMockTagsService(ctrl)
-	categoriesService := mockservices.NewMockCategoriesService(ctrl)
-	mastersService := mockservices.NewMockMastersService(ctrl)
-	toysService := mockservices.NewMockToysService(ctrl)
-	ssoService := mockservices.NewMockSsoService(ctrl)
-	useCases := New(
-		tagsService,
-		categoriesService,
-		mastersService,
-		toysService,
-		ssoService,
-		validationConfig,
-	)
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			if tc.setupMocks != nil {
-				tc.setupMocks(
-					tagsService,
-					categoriesService,
-					mastersService,
-					toysService,
-					ssoService,
-				)
-			}
-
-			actual, err := useCases.AddToy(ctx, tc.toy)
-			if tc.errorExpected {
-				require.Error(t, err)
-			} else {
-				require.NoError(t, err)
-			}
-
-			require.Equal(t, tc.expected, actual)
-		})
-	}
-}
-
-func TestUseCases_RegisterMaster(t *testing.T) {
-	testCases := []struct {
-		name       string
-		master     entities.RegisterMasterDTO
-		setupMocks func(
-			tagsService *mockservices.MockTagsService,
-			categoriesService *mockservices.MockCategoriesService,
-			mastersService *mockservices.MockMastersService,
-			toysService *mockservices.MockToysService,
-			ssoService *mockservices.MockSsoService,
-		)
-		expected      uint64
-		errorExpected bool
-		expectedError error
-	}{
-		{
-			name: "success",
-			master: entities.RegisterMasterDTO{
-				UserID: userID,
-				Info:   pointers.New[string]("Мастер о себе"),
+				Price:       2_000_000,
 			},
 			setupMocks: func(
 				_ *mockservices.MockTagsService,
-				_ *mockservices.MockCategoriesService,
+				categoriesService *mockservices.MockCategoriesService,
 				mastersService *mockservices.MockMastersService,
-				_ *mockservices.MockToysService,
-				ssoService *mockservices.MockSsoService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
 			) {
-				ssoService.
+				mastersService.
 					EXPECT().
-					GetUserByID(gomock.Any(), userID).
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
 					Return(
-						&entities.User{
-							ID: userID,
+						&entities.CategoryRules{
+							CategoryID: categoryID,
+							PriceCeil:  pointers.New[float32](5_000_000),
 						},
 						nil,
 					).
 					Times(1)
 
-				mastersService.
+				toysService.
 					EXPECT().
-					RegisterMaster(
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					AddToy(
 						gomock.Any(),
-						entities.RegisterMasterDTO{
-							UserID: userID,
-							Info:   pointers.New[string]("Мастер о себе"),
+						entities.AddToyDTO{
+							MasterID:         masterID,
+							CategoryID:       categoryID,
+							Name:             "Игрушка",
+							Description:      "Тестовая игрушка",
+							Quantity:         1,
+							Price:            2_000_000,
+							ModerationStatus: entities.ToyModerationStatusApproved,
 						},
 					).
-					Return(masterID, nil).
+					Return(toyID, nil).
 					Times(1)
 			},
-			expected: masterID,
+			expected: toyID,
 		},
 		{
-			name: "User not found",
-			master: entities.RegisterMasterDTO{
-				UserID: userID,
-				Info:   pointers.New[string]("Мастер"),
+			name: "price is lower than Category floor",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "Игрушка",
+				Description: "Тестовая игрушка",
+				Quantity:    1,
+				Price:       110.5,
 			},
 			setupMocks: func(
 				_ *mockservices.MockTagsService,
-				_ *mockservices.MockCategoriesService,
-				_ *mockservices.MockMastersService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
 				_ *mockservices.MockToysService,
-				ssoService *mockservices.MockSsoService,
+				_ *mockservices.MockSsoService,
 			) {
-				ssoService.
+				mastersService.
 					EXPECT().
-					GetUserByID(gomock.Any(), userID).
-					Return(nil, errors.New("test")).
-					Times(1)
-			},
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+						},
+						nil,
+					).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{
+						CategoryID: categoryID,
+						PriceFloor: pointers.New[float32](5000),
+					}, nil).
+					Times(1)
+			},
+			expected:      0,
 			errorExpected: true,
 		},
 		{
-			name: "Invalid master info",
-			master: entities.RegisterMasterDTO{
-				Info: pointers.New[string]("Сука"),
+			name: "description is too short for Category",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "Игрушка",
+				Description: "Тестовая игрушка",
+				Quantity:    1,
+				Price:       110.5,
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+						},
+						nil,
+					).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{
+						CategoryID:           categoryID,
+						DescriptionMinLength: pointers.New[uint32](100),
+					}, nil).
+					Times(1)
 			},
+			expected:      0,
+			errorExpected: true,
+		},
+		{
+			name: "required attachments are not provided",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "Игрушка",
+				Description: "Тестовая игрушка",
+				Quantity:    1,
+				Price:       110.5,
+				TagIDs:      []uint32{tagID},
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+						},
+						nil,
+					).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{
+						CategoryID: categoryID,
+						RequiredAttributes: []entities.ToyAttribute{
+							entities.ToyAttributeTags,
+							entities.ToyAttributeAttachments,
+						},
+					}, nil).
+					Times(1)
+			},
+			expected:      0,
+			errorExpected: true,
+		},
+		{
+			name: "invalid name",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "Мразь",
+				Description: "Тестовая игрушка",
+				Quantity:    1,
+				Price:       1_000_000_000,
+				TagIDs:      []uint32{tagID},
+				Attachments: []string{"test"},
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
+			expected:      0,
+			errorExpected: true,
+		},
+		{
+			name: "invalid description",
+			toy: entities.RawAddToyDTO{
+				UserID:      userID,
+				CategoryID:  categoryID,
+				Name:        "test",
+				Description: "Конченная мразь",
+				Quantity:    1,
+				Price:       1_000_000_000,
+				TagIDs:      []uint32{tagID},
+				Attachments: []string{"test"},
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
+			expected:      0,
 			errorExpected: true,
-			expectedError: &validation.Error{},
 		},
 	}
 
@@ -1892,13 +2565,20 @@ func TestUseCases_RegisterMaster(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -1913,12 +2593,9 @@ func TestUseCases_RegisterMaster(t *testing.T) {
 				)
 			}
 
-			actual, err := useCases.RegisterMaster(ctx, tc.master)
+			actual, err := useCases.AddToy(ctx, tc.toy)
 			if tc.errorExpected {
 				require.Error(t, err)
-				if tc.expectedError != nil {
-					require.IsType(t, tc.expectedError, err)
-				}
 			} else {
 				require.NoError(t, err)
 			}
@@ -1928,10 +2605,10 @@ func TestUseCases_RegisterMaster(t *testing.T) {
 	}
 }
 
-func TestUseCases_UpdateMaster(t *testing.T) {
+func TestUseCases_RegisterMaster(t *testing.T) {
 	testCases := []struct {
 		name       string
-		master     entities.UpdateMasterDTO
+		master     entities.RegisterMasterDTO
 		setupMocks func(
 			tagsService *mockservices.MockTagsService,
 			categoriesService *mockservices.MockCategoriesService,
@@ -1939,30 +2616,29 @@ func TestUseCases_UpdateMaster(t *testing.T) {
 			toysService *mockservices.MockToysService,
 			ssoService *mockservices.MockSsoService,
 		)
+		expected      uint64
 		errorExpected bool
 		expectedError error
 	}{
 		{
 			name: "success",
-			master: entities.UpdateMasterDTO{
-				ID:   masterID,
-				Info: pointers.New[string]("Мастер о себе"),
+			master: entities.RegisterMasterDTO{
+				UserID: userID,
+				Info:   pointers.New[string]("Мастер о себе"),
 			},
 			setupMocks: func(
 				_ *mockservices.MockTagsService,
 				_ *mockservices.MockCategoriesService,
 				mastersService *mockservices.MockMastersService,
 				_ *mockservices.MockToysService,
-				_ *mockservices.MockSsoService,
+				ssoService *mockservices.MockSsoService,
 			) {
-				mastersService.
+				ssoService.
 					EXPECT().
-					GetMasterByID(gomock.Any(), masterID).
+					GetUserByID(gomock.Any(), userID).
 					Return(
-						&entities.Master{
-							ID:     masterID,
-							UserID: userID,
-							Info:   pointers.New[string]("Какая-то инфа"),
+						&entities.User{
+							ID: userID,
 						},
 						nil,
 					).
@@ -1970,33 +2646,34 @@ func TestUseCases_UpdateMaster(t *testing.T) {
 
 				mastersService.
 					EXPECT().
-					UpdateMaster(
+					RegisterMaster(
 						gomock.Any(),
-						entities.UpdateMasterDTO{
-							ID:   masterID,
-							Info: pointers.New[string]("Мастер о себе"),
+						entities.RegisterMasterDTO{
+							UserID: userID,
+							Info:   pointers.New[string]("Мастер о себе"),
 						},
 					).
-					Return(nil).
+					Return(masterID, nil).
 					Times(1)
 			},
+			expected: masterID,
 		},
 		{
-			name: "Master not found",
-			master: entities.UpdateMasterDTO{
-				ID:   masterID,
-				Info: pointers.New[string]("Мастер о себе"),
+			name: "User not found",
+			master: entities.RegisterMasterDTO{
+				UserID: userID,
+				Info:   pointers.New[string]("Мастер"),
 			},
 			setupMocks: func(
 				_ *mockservices.MockTagsService,
 				_ *mockservices.MockCategoriesService,
-				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockMastersService,
 				_ *mockservices.MockToysService,
-				_ *mockservices.MockSsoService,
+				ssoService *mockservices.MockSsoService,
 			) {
-				mastersService.
+				ssoService.
 					EXPECT().
-					GetMasterByID(gomock.Any(), masterID).
+					GetUserByID(gomock.Any(), userID).
 					Return(nil, errors.New("test")).
 					Times(1)
 			},
@@ -2004,12 +2681,11 @@ func TestUseCases_UpdateMaster(t *testing.T) {
 		},
 		{
 			name: "Invalid master info",
-			master: entities.UpdateMasterDTO{
-				ID:   masterID,
-				Info: pointers.New[string]("invalid master info that would not work"),
+			master: entities.RegisterMasterDTO{
+				Info: pointers.New[string]("Сука"),
 			},
 			errorExpected: true,
-			expectedError: &validation.Error{},
+			expectedError: &customerrors.ValidationError{},
 		},
 	}
 
@@ -2019,13 +2695,20 @@ func TestUseCases_UpdateMaster(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -2040,7 +2723,7 @@ func TestUseCases_UpdateMaster(t *testing.T) {
 				)
 			}
 
-			err := useCases.UpdateMaster(ctx, tc.master)
+			actual, err := useCases.RegisterMaster(ctx, tc.master)
 			if tc.errorExpected {
 				require.Error(t, err)
 				if tc.expectedError != nil {
@@ -2049,14 +2732,16 @@ func TestUseCases_UpdateMaster(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+
+			require.Equal(t, tc.expected, actual)
 		})
 	}
 }
 
-func TestUseCases_DeleteToy(t *testing.T) {
+func TestUseCases_UpdateMaster(t *testing.T) {
 	testCases := []struct {
 		name       string
-		toyID      uint64
+		master     entities.UpdateMasterDTO
 		setupMocks func(
 			tagsService *mockservices.MockTagsService,
 			categoriesService *mockservices.MockCategoriesService,
@@ -2065,42 +2750,232 @@ func TestUseCases_DeleteToy(t *testing.T) {
 			ssoService *mockservices.MockSsoService,
 		)
 		errorExpected bool
+		expectedError error
 	}{
 		{
-			name:  "success",
-			toyID: toyID,
+			name: "success",
+			master: entities.UpdateMasterDTO{
+				ID:   masterID,
+				Info: pointers.New[string]("Мастер о себе"),
+			},
 			setupMocks: func(
 				_ *mockservices.MockTagsService,
 				_ *mockservices.MockCategoriesService,
-				_ *mockservices.MockMastersService,
-				toysService *mockservices.MockToysService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
 				_ *mockservices.MockSsoService,
 			) {
-				toysService.
+				mastersService.
 					EXPECT().
-					GetToyByID(gomock.Any(), toyID).
+					GetMasterByID(gomock.Any(), masterID).
 					Return(
-						&entities.Toy{
-							ID:   toyID,
-							Name: "test",
+						&entities.Master{
+							ID:     masterID,
+							UserID: userID,
+							Info:   pointers.New[string]("Какая-то инфа"),
 						},
 						nil,
 					).
 					Times(1)
 
-				toysService.
+				mastersService.
 					EXPECT().
-					DeleteToy(gomock.Any(), toyID).
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:   masterID,
+							Info: pointers.New[string]("Мастер о себе"),
+						},
+					).
 					Return(nil).
 					Times(1)
 			},
 		},
 		{
-			name:  "Toy not found",
-			toyID: toyID,
-			setupMocks: func(
-				_ *mockservices.MockTagsService,
-				_ *mockservices.MockCategoriesService,
+			name: "Master not found",
+			master: entities.UpdateMasterDTO{
+				ID:   masterID,
+				Info: pointers.New[string]("Мастер о себе"),
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "Invalid master info",
+			master: entities.UpdateMasterDTO{
+				ID:   masterID,
+				Info: pointers.New[string]("invalid master info that would not work"),
+			},
+			errorExpected: true,
+			expectedError: &customerrors.ValidationError{},
+		},
+		{
+			name: "Invalid master slug",
+			master: entities.UpdateMasterDTO{
+				ID:   masterID,
+				Slug: pointers.New[string]("Invalid Slug"),
+			},
+			errorExpected: true,
+			expectedError: &customerrors.ValidationError{},
+		},
+		{
+			name: "Invalid master social link",
+			master: entities.UpdateMasterDTO{
+				ID:          masterID,
+				SocialLinks: []string{"not a link"},
+			},
+			errorExpected: true,
+			expectedError: &customerrors.ValidationError{},
+		},
+		{
+			name: "success with shop profile",
+			master: entities.UpdateMasterDTO{
+				ID:          masterID,
+				ShopName:    pointers.New[string]("Мастерская игрушек"),
+				Slug:        pointers.New[string]("toys-workshop"),
+				City:        pointers.New[string]("Казань"),
+				SocialLinks: []string{"https://vk.com/toys-workshop"},
+				ShipsTo:     []string{"Татарстан"},
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					UpdateMaster(
+						gomock.Any(),
+						entities.UpdateMasterDTO{
+							ID:          masterID,
+							ShopName:    pointers.New[string]("Мастерская игрушек"),
+							Slug:        pointers.New[string]("toys-workshop"),
+							City:        pointers.New[string]("Казань"),
+							SocialLinks: []string{"https://vk.com/toys-workshop"},
+							ShipsTo:     []string{"Татарстан"},
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(
+					tagsService,
+					categoriesService,
+					mastersService,
+					toysService,
+					ssoService,
+				)
+			}
+
+			err := useCases.UpdateMaster(ctx, tc.master)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_DeleteToy(t *testing.T) {
+	testCases := []struct {
+		name       string
+		toyID      uint64
+		setupMocks func(
+			tagsService *mockservices.MockTagsService,
+			categoriesService *mockservices.MockCategoriesService,
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+			ssoService *mockservices.MockSsoService,
+		)
+		errorExpected bool
+	}{
+		{
+			name:  "success",
+			toyID: toyID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(
+						&entities.Toy{
+							ID:   toyID,
+							Name: "test",
+						},
+						nil,
+					).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					DeleteToy(gomock.Any(), toyID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:  "Toy not found",
+			toyID: toyID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
 				_ *mockservices.MockMastersService,
 				toysService *mockservices.MockToysService,
 				_ *mockservices.MockSsoService,
@@ -2122,13 +2997,20 @@ func TestUseCases_DeleteToy(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -2307,13 +3189,20 @@ func TestUseCases_CreateTags(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -2378,12 +3267,14 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					GetToyByID(gomock.Any(), toyID).
 					Return(
 						&entities.Toy{
-							ID:          toyID,
-							CategoryID:  categoryID,
-							Name:        "Какая-то игрушка",
-							Description: "Какое-то описание",
-							Quantity:    1,
-							Price:       110.5,
+							ID:               toyID,
+							MasterID:         masterID,
+							ModerationStatus: entities.ToyModerationStatusApproved,
+							CategoryID:       categoryID,
+							Name:             "Какая-то игрушка",
+							Description:      "Какое-то описание",
+							Quantity:         1,
+							Price:            110.5,
 							Tags: []entities.Tag{
 								{
 									ID:   tagID,
@@ -2409,17 +3300,24 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					).
 					Times(1)
 
-				categoriesService.
+				mastersService.
 					EXPECT().
-					GetCategoryByID(gomock.Any(), categoryID).
+					GetMasterByID(gomock.Any(), masterID).
 					Return(
-						&entities.Category{
-							ID:   categoryID,
-							Name: "test",
-						}, nil,
+						&entities.Master{
+							ID:     masterID,
+							Status: entities.MasterStatusVerified,
+						},
+						nil,
 					).
 					Times(1)
 
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
 				tagsService.
 					EXPECT().
 					GetTagByID(gomock.Any(), tagID).
@@ -2442,6 +3340,12 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					).
 					Times(1)
 
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
 				toysService.
 					EXPECT().
 					UpdateToy(
@@ -2487,12 +3391,14 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					GetToyByID(gomock.Any(), toyID).
 					Return(
 						&entities.Toy{
-							ID:          toyID,
-							CategoryID:  categoryID,
-							Name:        "Какая-то игрушка",
-							Description: "Какое-то описание",
-							Quantity:    1,
-							Price:       110.5,
+							ID:               toyID,
+							MasterID:         masterID,
+							ModerationStatus: entities.ToyModerationStatusApproved,
+							CategoryID:       categoryID,
+							Name:             "Какая-то игрушка",
+							Description:      "Какое-то описание",
+							Quantity:         1,
+							Price:            110.5,
 							Tags: []entities.Tag{
 								{
 									ID:   tagID,
@@ -2518,17 +3424,24 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					).
 					Times(1)
 
-				categoriesService.
+				mastersService.
 					EXPECT().
-					GetCategoryByID(gomock.Any(), categoryID).
+					GetMasterByID(gomock.Any(), masterID).
 					Return(
-						&entities.Category{
-							ID:   categoryID,
-							Name: "test",
-						}, nil,
+						&entities.Master{
+							ID:     masterID,
+							Status: entities.MasterStatusVerified,
+						},
+						nil,
 					).
 					Times(1)
 
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
 				tagsService.
 					EXPECT().
 					GetTagByID(gomock.Any(), tagID).
@@ -2561,12 +3474,14 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					GetToyByID(gomock.Any(), toyID).
 					Return(
 						&entities.Toy{
-							ID:          toyID,
-							CategoryID:  categoryID,
-							Name:        "Какая-то игрушка",
-							Description: "Какое-то описание",
-							Quantity:    1,
-							Price:       110.5,
+							ID:               toyID,
+							MasterID:         masterID,
+							ModerationStatus: entities.ToyModerationStatusApproved,
+							CategoryID:       categoryID,
+							Name:             "Какая-то игрушка",
+							Description:      "Какое-то описание",
+							Quantity:         1,
+							Price:            110.5,
 							Tags: []entities.Tag{
 								{
 									ID:   tagID,
@@ -2592,28 +3507,35 @@ func TestUseCases_UpdateToy(t *testing.T) {
 					).
 					Times(1)
 
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							Status: entities.MasterStatusVerified,
+						},
+						nil,
+					).
+					Times(1)
+
 				categoriesService.
 					EXPECT().
-					GetCategoryByID(gomock.Any(), categoryID).
+					GetCategoryRules(gomock.Any(), categoryID).
 					Return(nil, errors.New("test")).
 					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "Toy not found",
+			name: "empty lists remove Tags and Attachments",
 			toy: entities.RawUpdateToyDTO{
 				ID:          toyID,
-				CategoryID:  pointers.New[uint32](categoryID),
-				Name:        pointers.New[string]("Игрушка"),
-				Description: pointers.New[string]("Тестовая игрушка"),
-				Quantity:    pointers.New[uint32](1),
-				Price:       pointers.New[float32](110.5),
-				TagIDs:      []uint32{tagID, 2},
-				Attachments: []string{"oldAttachment", "newAttachment"},
+				TagIDs:      []uint32{},
+				Attachments: []string{},
 			},
 			setupMocks: func(
-				tagsService *mockservices.MockTagsService,
+				_ *mockservices.MockTagsService,
 				categoriesService *mockservices.MockCategoriesService,
 				mastersService *mockservices.MockMastersService,
 				toysService *mockservices.MockToysService,
@@ -2622,37 +3544,313 @@ func TestUseCases_UpdateToy(t *testing.T) {
 				toysService.
 					EXPECT().
 					GetToyByID(gomock.Any(), toyID).
-					Return(nil, errors.New("test")).
+					Return(
+						&entities.Toy{
+							ID:               toyID,
+							MasterID:         masterID,
+							CategoryID:       categoryID,
+							Price:            110.5,
+							Quantity:         1,
+							ModerationStatus: entities.ToyModerationStatusApproved,
+							Tags:             []entities.Tag{{ID: tagID}},
+							Attachments:      []entities.Attachment{{ID: 1, Link: "attachmentToDelete"}},
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToy(
+						gomock.Any(),
+						entities.UpdateToyDTO{
+							ID:                    toyID,
+							TagIDsToAdd:           []uint32{},
+							TagIDsToDelete:        []uint32{tagID},
+							AttachmentsToAdd:      []string{},
+							AttachmentIDsToDelete: []uint64{1},
+						},
+					).
+					Return(nil).
 					Times(1)
 			},
-			errorExpected: true,
 		},
 		{
-			name: "invalid quantity",
+			name: "resubmission of rejected Toy",
 			toy: entities.RawUpdateToyDTO{
-				ID:          toyID,
-				CategoryID:  pointers.New[uint32](categoryID),
-				Name:        pointers.New[string]("Игрушка"),
-				Description: pointers.New[string]("Тестовая игрушка"),
-				Quantity:    pointers.New[uint32](1_000_000),
-				Price:       pointers.New[float32](110.5),
-				TagIDs:      []uint32{tagID, 2},
-				Attachments: []string{"oldAttachment", "newAttachment"},
+				ID:       toyID,
+				Quantity: pointers.New[uint32](2),
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(
+						&entities.Toy{
+							ID:               toyID,
+							MasterID:         masterID,
+							CategoryID:       categoryID,
+							Name:             "Игрушка",
+							Description:      "Тестовая игрушка",
+							Price:            110.5,
+							ModerationStatus: entities.ToyModerationStatusRejected,
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToy(
+						gomock.Any(),
+						entities.UpdateToyDTO{
+							ID:               toyID,
+							Quantity:         pointers.New[uint32](2),
+							ModerationStatus: pointers.New(entities.ToyModerationStatusPending),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Master blocked",
+			toy: entities.RawUpdateToyDTO{
+				ID:   toyID,
+				Name: pointers.New[string]("Игрушка"),
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(
+						&entities.Toy{
+							ID:       toyID,
+							MasterID: masterID,
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(
+						&entities.Master{
+							ID:     masterID,
+							Status: entities.MasterStatusSuspended,
+						},
+						nil,
+					).
+					Times(1)
 			},
 			errorExpected: true,
 		},
 		{
-			name: "invalid price",
+			name: "Toy not found",
 			toy: entities.RawUpdateToyDTO{
 				ID:          toyID,
 				CategoryID:  pointers.New[uint32](categoryID),
 				Name:        pointers.New[string]("Игрушка"),
 				Description: pointers.New[string]("Тестовая игрушка"),
 				Quantity:    pointers.New[uint32](1),
-				Price:       pointers.New[float32](1_000_000_000),
+				Price:       pointers.New[float32](110.5),
 				TagIDs:      []uint32{tagID, 2},
 				Attachments: []string{"oldAttachment", "newAttachment"},
 			},
+			setupMocks: func(
+				tagsService *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "invalid quantity",
+			toy: entities.RawUpdateToyDTO{
+				ID:       toyID,
+				Quantity: pointers.New[uint32](1_000_000),
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(
+						&entities.Toy{
+							ID:          toyID,
+							MasterID:    masterID,
+							CategoryID:  categoryID,
+							Name:        "Игрушка",
+							Description: "Тестовая игрушка",
+							Quantity:    1,
+							Price:       110.5,
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "invalid price",
+			toy: entities.RawUpdateToyDTO{
+				ID:    toyID,
+				Price: pointers.New[float32](1_000_000_000),
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(
+						&entities.Toy{
+							ID:          toyID,
+							MasterID:    masterID,
+							CategoryID:  categoryID,
+							Name:        "Игрушка",
+							Description: "Тестовая игрушка",
+							Quantity:    1,
+							Price:       110.5,
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "description is too short for Category",
+			toy: entities.RawUpdateToyDTO{
+				ID:          toyID,
+				Description: pointers.New[string]("Игрушка для детей"),
+			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(
+						&entities.Toy{
+							ID:          toyID,
+							MasterID:    masterID,
+							CategoryID:  categoryID,
+							Name:        "Игрушка",
+							Description: "Тестовая игрушка",
+							Quantity:    1,
+							Price:       110.5,
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(
+						&entities.CategoryRules{
+							CategoryID:           categoryID,
+							DescriptionMinLength: pointers.New[uint32](30),
+						},
+						nil,
+					).
+					Times(1)
+			},
 			errorExpected: true,
 		},
 		{
@@ -2667,6 +3865,31 @@ func TestUseCases_UpdateToy(t *testing.T) {
 				TagIDs:      []uint32{tagID, 2},
 				Attachments: []string{"oldAttachment", "newAttachment"},
 			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, MasterID: masterID, CategoryID: categoryID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
 			errorExpected: true,
 		},
 		{
@@ -2681,6 +3904,31 @@ func TestUseCases_UpdateToy(t *testing.T) {
 				TagIDs:      []uint32{tagID, 2},
 				Attachments: []string{"oldAttachment", "newAttachment"},
 			},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockSsoService,
+			) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, MasterID: masterID, CategoryID: categoryID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
 			errorExpected: true,
 		},
 	}
@@ -2691,13 +3939,20 @@ func TestUseCases_UpdateToy(t *testing.T) {
 	mastersService := mockservices.NewMockMastersService(ctrl)
 	toysService := mockservices.NewMockToysService(ctrl)
 	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
 	useCases := New(
 		tagsService,
 		categoriesService,
 		mastersService,
 		toysService,
 		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
 		validationConfig,
+		userEventsConfig,
 	)
 
 	for _, tc := range testCases {
@@ -2721,3 +3976,3630 @@ func TestUseCases_UpdateToy(t *testing.T) {
 		})
 	}
 }
+
+func TestUseCases_BatchGetToys(t *testing.T) {
+	testCases := []struct {
+		name               string
+		ids                []uint64
+		setupMocks         func(toysService *mockservices.MockToysService)
+		expectedToys       []entities.Toy
+		expectedMissingIDs []uint64
+		errorExpected      bool
+	}{
+		{
+			name: "success",
+			ids:  []uint64{2, 1, 3, 2},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToysByIDs(gomock.Any(), []uint64{2, 1, 3, 2}).
+					Return([]entities.Toy{{ID: 1}, {ID: 2}}, nil).
+					Times(1)
+			},
+			expectedToys:       []entities.Toy{{ID: 2}, {ID: 1}},
+			expectedMissingIDs: []uint64{3},
+		},
+		{
+			name:          "too many IDs",
+			ids:           make([]uint64, batchToysCeil+1),
+			setupMocks:    func(_ *mockservices.MockToysService) {},
+			errorExpected: true,
+		},
+		{
+			name: "failed to get Toys",
+			ids:  []uint64{toyID},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToysByIDs(gomock.Any(), []uint64{toyID}).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			toysService := mockservices.NewMockToysService(ctrl)
+			useCases := New(
+				mockservices.NewMockTagsService(ctrl),
+				mockservices.NewMockCategoriesService(ctrl),
+				mockservices.NewMockMastersService(ctrl),
+				toysService,
+				mockservices.NewMockSsoService(ctrl),
+				mockservices.NewMockReviewsService(ctrl),
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				userEventsConfig,
+			)
+
+			tc.setupMocks(toysService)
+
+			toys, missingIDs, err := useCases.BatchGetToys(ctx, tc.ids)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedToys, toys)
+			require.Equal(t, tc.expectedMissingIDs, missingIDs)
+		})
+	}
+}
+
+func TestUseCases_BatchUpdateToys(t *testing.T) {
+	master := &entities.Master{ID: masterID, UserID: userID, Status: entities.MasterStatusVerified}
+	newToy := func(id, masterID uint64) entities.Toy {
+		return entities.Toy{
+			ID:               id,
+			MasterID:         masterID,
+			CategoryID:       categoryID,
+			Name:             "Игрушка",
+			Description:      "Тестовая игрушка",
+			Price:            100,
+			Quantity:         1,
+			ModerationStatus: entities.ToyModerationStatusApproved,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		items      []entities.BatchUpdateToyDTO
+		setupMocks func(
+			categoriesService *mockservices.MockCategoriesService,
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+		)
+		expected      []entities.BatchUpdateToyResult
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			items: []entities.BatchUpdateToyDTO{
+				{ID: 1, Price: pointers.New[float32](90)},
+				{ID: 2, Quantity: pointers.New[uint32](3)},
+			},
+			setupMocks: func(
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(master, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToysByIDs(gomock.Any(), []uint64{1, 2}).
+					Return([]entities.Toy{newToy(1, masterID), newToy(2, masterID)}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(2)
+
+				// Only changed price is checked by moderation heuristics:
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToy(gomock.Any(), entities.UpdateToyDTO{ID: 1, Price: pointers.New[float32](90)}).
+					Return(nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToy(gomock.Any(), entities.UpdateToyDTO{ID: 2, Quantity: pointers.New[uint32](3)}).
+					Return(nil).
+					Times(1)
+			},
+			expected: []entities.BatchUpdateToyResult{{ID: 1}, {ID: 2}},
+		},
+		{
+			name: "invalid items",
+			items: []entities.BatchUpdateToyDTO{
+				{ID: 1, Price: pointers.New[float32](0)},
+				{ID: 2},
+				{ID: 3, Quantity: pointers.New[uint32](2)},
+				{ID: 4, Quantity: pointers.New[uint32](2)},
+				{ID: 1, Quantity: pointers.New[uint32](2)},
+			},
+			setupMocks: func(
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(master, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToysByIDs(gomock.Any(), []uint64{1, 2, 3, 4, 1}).
+					Return([]entities.Toy{newToy(1, masterID), newToy(2, masterID), newToy(3, masterID+1)}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+			},
+			expected: []entities.BatchUpdateToyResult{
+				{
+					ID: 1,
+					Violations: []customerrors.FieldViolation{
+						{Field: "price", Code: customerrors.ViolationCodeOutOfRange, Message: "invalid toy price"},
+					},
+				},
+				{
+					ID: 2,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "price",
+							Code:    customerrors.ViolationCodeRequired,
+							Message: "toy price or quantity is required",
+						},
+					},
+				},
+				{
+					ID: 3,
+					Violations: []customerrors.FieldViolation{
+						{Field: "ID", Code: customerrors.ViolationCodeNotFound, Message: "toy not found"},
+					},
+				},
+				{
+					ID: 4,
+					Violations: []customerrors.FieldViolation{
+						{Field: "ID", Code: customerrors.ViolationCodeNotFound, Message: "toy not found"},
+					},
+				},
+				{
+					ID: 1,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "ID",
+							Code:    customerrors.ViolationCodeInvalidFormat,
+							Message: "toy is duplicated in batch",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no items",
+			setupMocks: func(
+				_ *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+			) {
+			},
+			errorExpected: true,
+		},
+		{
+			name:  "too many items",
+			items: make([]entities.BatchUpdateToyDTO, batchToysCeil+1),
+			setupMocks: func(
+				_ *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+			) {
+			},
+			errorExpected: true,
+		},
+		{
+			name:  "Master is blocked",
+			items: []entities.BatchUpdateToyDTO{{ID: 1, Quantity: pointers.New[uint32](3)}},
+			setupMocks: func(
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusSuspended}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:  "failed to update Toy",
+			items: []entities.BatchUpdateToyDTO{{ID: 1, Quantity: pointers.New[uint32](3)}},
+			setupMocks: func(
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(master, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToysByIDs(gomock.Any(), []uint64{1}).
+					Return([]entities.Toy{newToy(1, masterID)}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToy(gomock.Any(), gomock.Any()).
+					Return(errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			categoriesService := mockservices.NewMockCategoriesService(ctrl)
+			mastersService := mockservices.NewMockMastersService(ctrl)
+			toysService := mockservices.NewMockToysService(ctrl)
+			useCases := New(
+				mockservices.NewMockTagsService(ctrl),
+				categoriesService,
+				mastersService,
+				toysService,
+				mockservices.NewMockSsoService(ctrl),
+				mockservices.NewMockReviewsService(ctrl),
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				userEventsConfig,
+			)
+
+			tc.setupMocks(categoriesService, mastersService, toysService)
+
+			actual, err := useCases.BatchUpdateToys(ctx, userID, tc.items)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_AddFavourite(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     uint64
+		toyID      uint64
+		setupMocks func(
+			tagsService *mockservices.MockTagsService,
+			categoriesService *mockservices.MockCategoriesService,
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+			ssoService *mockservices.MockSsoService,
+		)
+		expected      uint64
+		errorExpected bool
+	}{
+		{
+			name:   "success",
+			userID: userID,
+			toyID:  toyID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					AddFavourite(gomock.Any(), userID, toyID).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: 1,
+		},
+		{
+			name:   "User not found",
+			userID: userID,
+			toyID:  toyID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:   "Toy not found",
+			userID: userID,
+			toyID:  toyID,
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(
+					tagsService,
+					categoriesService,
+					mastersService,
+					toysService,
+					ssoService,
+				)
+			}
+
+			favouriteID, err := useCases.AddFavourite(ctx, tc.userID, tc.toyID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, favouriteID)
+		})
+	}
+}
+
+func TestUseCases_AddReview(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reviewData entities.AddReviewDTO
+		setupMocks func(
+			toysService *mockservices.MockToysService,
+			ssoService *mockservices.MockSsoService,
+			reviewsService *mockservices.MockReviewsService,
+		)
+		expected      uint64
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  toyID,
+				UserID: userID,
+				Rating: 5,
+				Text:   pointers.New("Отличная игрушка!"),
+			},
+			setupMocks: func(
+				toysService *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+				reviewsService *mockservices.MockReviewsService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID}, nil).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					AddReview(
+						gomock.Any(),
+						entities.AddReviewDTO{
+							ToyID:  toyID,
+							UserID: userID,
+							Rating: 5,
+							Text:   pointers.New("Отличная игрушка!"),
+						},
+					).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: 1,
+		},
+		{
+			name: "invalid rating",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  toyID,
+				UserID: userID,
+				Rating: 6,
+			},
+			errorExpected: true,
+		},
+		{
+			name: "invalid text",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  toyID,
+				UserID: userID,
+				Rating: 5,
+				Text:   pointers.New("invalid text"),
+			},
+			errorExpected: true,
+		},
+		{
+			name: "User not found",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  toyID,
+				UserID: userID,
+				Rating: 1,
+			},
+			setupMocks: func(
+				_ *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+				_ *mockservices.MockReviewsService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "Toy not found",
+			reviewData: entities.AddReviewDTO{
+				ToyID:  toyID,
+				UserID: userID,
+				Rating: 1,
+			},
+			setupMocks: func(
+				toysService *mockservices.MockToysService,
+				ssoService *mockservices.MockSsoService,
+				_ *mockservices.MockReviewsService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService, ssoService, reviewsService)
+			}
+
+			reviewID, err := useCases.AddReview(ctx, tc.reviewData)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, reviewID)
+		})
+	}
+}
+
+func TestUseCases_UpdateReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		reviewData    entities.RawUpdateReviewDTO
+		setupMocks    func(reviewsService *mockservices.MockReviewsService)
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			reviewData: entities.RawUpdateReviewDTO{
+				ID:     1,
+				Rating: pointers.New[uint32](4),
+				Photos: []string{"new", "old"},
+			},
+			setupMocks: func(reviewsService *mockservices.MockReviewsService) {
+				reviewsService.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(1)).
+					Return(
+						&entities.Review{
+							ID: 1,
+							Photos: []entities.ReviewPhoto{
+								{ID: 1, Link: "old"},
+								{ID: 2, Link: "deleted"},
+							},
+						},
+						nil,
+					).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					UpdateReview(
+						gomock.Any(),
+						entities.UpdateReviewDTO{
+							ID:               1,
+							Rating:           pointers.New[uint32](4),
+							PhotosToAdd:      []string{"new"},
+							PhotoIDsToDelete: []uint64{2},
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "success without Photos",
+			reviewData: entities.RawUpdateReviewDTO{
+				ID:     1,
+				Rating: pointers.New[uint32](4),
+			},
+			setupMocks: func(reviewsService *mockservices.MockReviewsService) {
+				reviewsService.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(1)).
+					Return(
+						&entities.Review{
+							ID:     1,
+							Photos: []entities.ReviewPhoto{{ID: 1, Link: "old"}},
+						},
+						nil,
+					).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					UpdateReview(
+						gomock.Any(),
+						entities.UpdateReviewDTO{
+							ID:     1,
+							Rating: pointers.New[uint32](4),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid rating",
+			reviewData: entities.RawUpdateReviewDTO{
+				ID:     1,
+				Rating: pointers.New[uint32](0),
+			},
+			errorExpected: true,
+		},
+		{
+			name: "Review not found",
+			reviewData: entities.RawUpdateReviewDTO{
+				ID: 1,
+			},
+			setupMocks: func(reviewsService *mockservices.MockReviewsService) {
+				reviewsService.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(reviewsService)
+			}
+
+			err := useCases.UpdateReview(ctx, tc.reviewData)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_DeleteReview(t *testing.T) {
+	testCases := []struct {
+		name          string
+		reviewID      uint64
+		setupMocks    func(reviewsService *mockservices.MockReviewsService)
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			reviewID: 1,
+			setupMocks: func(reviewsService *mockservices.MockReviewsService) {
+				reviewsService.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(1)).
+					Return(&entities.Review{ID: 1}, nil).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					DeleteReview(gomock.Any(), uint64(1)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:     "Review not found",
+			reviewID: 1,
+			setupMocks: func(reviewsService *mockservices.MockReviewsService) {
+				reviewsService.
+					EXPECT().
+					GetReviewByID(gomock.Any(), uint64(1)).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(reviewsService)
+			}
+
+			err := useCases.DeleteReview(ctx, tc.reviewID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_FollowMaster(t *testing.T) {
+	testCases := []struct {
+		name       string
+		userID     uint64
+		masterID   uint64
+		setupMocks func(
+			mastersService *mockservices.MockMastersService,
+			ssoService *mockservices.MockSsoService,
+		)
+		expected      uint64
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			userID:   userID,
+			masterID: masterID,
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				ssoService *mockservices.MockSsoService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					FollowMaster(gomock.Any(), userID, masterID).
+					Return(uint64(1), nil).
+					Times(1)
+			},
+			expected: 1,
+		},
+		{
+			name:     "User not found",
+			userID:   userID,
+			masterID: masterID,
+			setupMocks: func(
+				_ *mockservices.MockMastersService,
+				ssoService *mockservices.MockSsoService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "Master not found",
+			userID:   userID,
+			masterID: masterID,
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				ssoService *mockservices.MockSsoService,
+			) {
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(&entities.User{ID: userID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersService, ssoService)
+			}
+
+			followID, err := useCases.FollowMaster(ctx, tc.userID, tc.masterID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, followID)
+		})
+	}
+}
+
+func TestUseCases_GetFeed(t *testing.T) {
+	testCases := []struct {
+		name          string
+		limit         *uint64
+		setupMocks    func(toysService *mockservices.MockToysService)
+		expected      []entities.Toy
+		errorExpected bool
+	}{
+		{
+			name: "default limit",
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetFeed(gomock.Any(), userID, nil, uint64(feedLimit)).
+					Return([]entities.Toy{{ID: toyID}}, nil).
+					Times(1)
+			},
+			expected: []entities.Toy{{ID: toyID}},
+		},
+		{
+			name:  "limit is capped",
+			limit: pointers.New[uint64](1_000),
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetFeed(gomock.Any(), userID, nil, uint64(feedLimitCeil)).
+					Return([]entities.Toy{{ID: toyID}}, nil).
+					Times(1)
+			},
+			expected: []entities.Toy{{ID: toyID}},
+		},
+		{
+			name:  "error",
+			limit: pointers.New[uint64](5),
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetFeed(gomock.Any(), userID, nil, uint64(5)).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService)
+			}
+
+			feed, err := useCases.GetFeed(ctx, userID, nil, tc.limit)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, feed)
+		})
+	}
+}
+
+func TestUseCases_GetMasterStats(t *testing.T) {
+	period := &entities.StatsPeriod{
+		From: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name       string
+		period     *entities.StatsPeriod
+		setupMocks func(
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+		)
+		expected      *entities.MasterStats
+		errorExpected bool
+		expectedError error
+	}{
+		{
+			name:   "success",
+			period: period,
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterToysStats(gomock.Any(), masterID, *period).
+					Return(&entities.MasterStats{MasterID: masterID, ToysCount: 2}, nil).
+					Times(1)
+			},
+			expected: &entities.MasterStats{MasterID: masterID, ToysCount: 2},
+		},
+		{
+			name: "success with default period",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterToysStats(gomock.Any(), masterID, gomock.Any()).
+					Return(&entities.MasterStats{MasterID: masterID}, nil).
+					Times(1)
+			},
+			expected: &entities.MasterStats{MasterID: masterID},
+		},
+		{
+			name: "invalid period",
+			period: &entities.StatsPeriod{
+				From: period.To,
+				To:   period.From,
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name: "too long period",
+			period: &entities.StatsPeriod{
+				From: period.From.AddDate(-2, 0, 0),
+				To:   period.To,
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:   "Master not found",
+			period: period,
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.MasterNotFoundError{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersService, toysService)
+			}
+
+			stats, err := useCases.GetMasterStats(ctx, masterID, tc.period)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, stats)
+		})
+	}
+}
+
+func TestUseCases_SetVacationMode(t *testing.T) {
+	until := time.Now().UTC().Add(24 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		vacationData  entities.SetVacationModeDTO
+		setupMocks    func(mastersService *mockservices.MockMastersService)
+		errorExpected bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			vacationData: entities.SetVacationModeDTO{
+				MasterID: masterID,
+				Until:    &until,
+				Message:  pointers.New[string]("Мастер в отпуске до понедельника"),
+			},
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					SetVacationMode(
+						gomock.Any(),
+						entities.SetVacationModeDTO{
+							MasterID: masterID,
+							Until:    &until,
+							Message:  pointers.New[string]("Мастер в отпуске до понедельника"),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:         "success disabling vacation",
+			vacationData: entities.SetVacationModeDTO{MasterID: masterID},
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					SetVacationMode(gomock.Any(), entities.SetVacationModeDTO{MasterID: masterID}).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "vacation end date in the past",
+			vacationData: entities.SetVacationModeDTO{
+				MasterID: masterID,
+				Until:    pointers.New(until.Add(-48 * time.Hour)),
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name: "too long vacation",
+			vacationData: entities.SetVacationModeDTO{
+				MasterID: masterID,
+				Until:    pointers.New(until.AddDate(2, 0, 0)),
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name: "invalid vacation message",
+			vacationData: entities.SetVacationModeDTO{
+				MasterID: masterID,
+				Until:    &until,
+				Message:  pointers.New[string]("invalid message"),
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:         "Master not found",
+			vacationData: entities.SetVacationModeDTO{MasterID: masterID},
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.MasterNotFoundError{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersService)
+			}
+
+			err := useCases.SetVacationMode(ctx, tc.vacationData)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_ChangeMasterStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		statusData    entities.ChangeMasterStatusDTO
+		setupMocks    func(mastersService *mockservices.MockMastersService)
+		errorExpected bool
+		expectedError error
+	}{
+		{
+			name: "success",
+			statusData: entities.ChangeMasterStatusDTO{
+				MasterID: masterID,
+				Status:   entities.MasterStatusSuspended,
+				Reason:   "Нарушение правил площадки",
+			},
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: masterID,
+							Status:   entities.MasterStatusSuspended,
+							Reason:   "Нарушение правил площадки",
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid status",
+			statusData: entities.ChangeMasterStatusDTO{
+				MasterID: masterID,
+				Status:   "unknown",
+				Reason:   "Нарушение правил площадки",
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name: "empty reason",
+			statusData: entities.ChangeMasterStatusDTO{
+				MasterID: masterID,
+				Status:   entities.MasterStatusBanned,
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name: "Master not found",
+			statusData: entities.ChangeMasterStatusDTO{
+				MasterID: masterID,
+				Status:   entities.MasterStatusVerified,
+				Reason:   "Проверка пройдена",
+			},
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.MasterNotFoundError{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersService)
+			}
+
+			err := useCases.ChangeMasterStatus(ctx, tc.statusData)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_GetMasterStatusHistory(t *testing.T) {
+	testCases := []struct {
+		name          string
+		masterID      uint64
+		setupMocks    func(mastersService *mockservices.MockMastersService)
+		expected      []entities.MasterStatusChange
+		errorExpected bool
+	}{
+		{
+			name:     "success",
+			masterID: masterID,
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(
+						[]entities.MasterStatusChange{
+							{
+								ID:             1,
+								MasterID:       masterID,
+								PreviousStatus: entities.MasterStatusPending,
+								Status:         entities.MasterStatusVerified,
+								Reason:         "Проверка пройдена",
+							},
+						},
+						nil,
+					).
+					Times(1)
+			},
+			expected: []entities.MasterStatusChange{
+				{
+					ID:             1,
+					MasterID:       masterID,
+					PreviousStatus: entities.MasterStatusPending,
+					Status:         entities.MasterStatusVerified,
+					Reason:         "Проверка пройдена",
+				},
+			},
+		},
+		{
+			name:     "Master not found",
+			masterID: masterID,
+			setupMocks: func(mastersService *mockservices.MockMastersService) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersService)
+			}
+
+			actual, err := useCases.GetMasterStatusHistory(ctx, tc.masterID)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_ListModerationQueue(t *testing.T) {
+	testCases := []struct {
+		name          string
+		pagination    *entities.Pagination
+		setupMocks    func(toysService *mockservices.MockToysService)
+		expected      []entities.Toy
+		errorExpected bool
+	}{
+		{
+			name:       "success",
+			pagination: &entities.Pagination{Limit: pointers.New[uint64](10)},
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToys(
+						gomock.Any(),
+						&entities.Pagination{Limit: pointers.New[uint64](10)},
+						&entities.ToysFilters{
+							ModerationStatuses:  []entities.ToyModerationStatus{entities.ToyModerationStatusPending},
+							CreatedAtOrderByAsc: pointers.New(true),
+						},
+					).
+					Return(
+						[]entities.Toy{{ID: toyID, ModerationStatus: entities.ToyModerationStatusPending}},
+						nil,
+					).
+					Times(1)
+			},
+			expected: []entities.Toy{{ID: toyID, ModerationStatus: entities.ToyModerationStatusPending}},
+		},
+		{
+			name: "error",
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToys(gomock.Any(), gomock.Nil(), gomock.Any()).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService)
+			}
+
+			actual, err := useCases.ListModerationQueue(ctx, tc.pagination)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_ApproveToy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		toyID         uint64
+		setupMocks    func(toysService *mockservices.MockToysService)
+		errorExpected bool
+		expectedError error
+	}{
+		{
+			name:  "success",
+			toyID: toyID,
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, ModerationStatus: entities.ToyModerationStatusPending}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToyModeration(
+						gomock.Any(),
+						entities.ToyModerationDTO{
+							ToyID:  toyID,
+							Status: entities.ToyModerationStatusApproved,
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:  "already approved",
+			toyID: toyID,
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, ModerationStatus: entities.ToyModerationStatusApproved}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:  "Toy not found",
+			toyID: toyID,
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(nil, &customerrors.ToyNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.ToyNotFoundError{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService)
+			}
+
+			err := useCases.ApproveToy(ctx, tc.toyID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_RejectToy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		toyID         uint64
+		reason        string
+		setupMocks    func(toysService *mockservices.MockToysService)
+		errorExpected bool
+		expectedError error
+	}{
+		{
+			name:   "success",
+			toyID:  toyID,
+			reason: "Ссылки на сторонние сайты запрещены",
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, ModerationStatus: entities.ToyModerationStatusPending}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToyModeration(
+						gomock.Any(),
+						entities.ToyModerationDTO{
+							ToyID:           toyID,
+							Status:          entities.ToyModerationStatusRejected,
+							RejectionReason: pointers.New[string]("Ссылки на сторонние сайты запрещены"),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "invalid reason",
+			toyID:         toyID,
+			reason:        "",
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:   "already rejected",
+			toyID:  toyID,
+			reason: "Ссылки на сторонние сайты запрещены",
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, ModerationStatus: entities.ToyModerationStatusRejected}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:   "Toy not found",
+			toyID:  toyID,
+			reason: "Ссылки на сторонние сайты запрещены",
+			setupMocks: func(toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(nil, &customerrors.ToyNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.ToyNotFoundError{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(toysService)
+			}
+
+			err := useCases.RejectToy(ctx, tc.toyID, tc.reason)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_ResubmitToy(t *testing.T) {
+	rejectedToy := &entities.Toy{
+		ID:               toyID,
+		MasterID:         masterID,
+		ModerationStatus: entities.ToyModerationStatusRejected,
+		RejectionReason:  pointers.New[string]("Ссылки на сторонние сайты запрещены"),
+	}
+
+	testCases := []struct {
+		name          string
+		toyID         uint64
+		appeal        *string
+		setupMocks    func(mastersService *mockservices.MockMastersService, toysService *mockservices.MockToysService)
+		errorExpected bool
+		expectedError error
+	}{
+		{
+			name:   "success",
+			toyID:  toyID,
+			appeal: pointers.New[string]("Ссылки удалены из описания"),
+			setupMocks: func(mastersService *mockservices.MockMastersService, toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(rejectedToy, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusVerified}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					UpdateToyModeration(
+						gomock.Any(),
+						entities.ToyModerationDTO{
+							ToyID:           toyID,
+							Status:          entities.ToyModerationStatusPending,
+							RejectionReason: pointers.New[string]("Ссылки на сторонние сайты запрещены"),
+							Appeal:          pointers.New[string]("Ссылки удалены из описания"),
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:          "invalid appeal",
+			toyID:         toyID,
+			appeal:        pointers.New[string]("invalid appeal"),
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:  "Toy is not rejected",
+			toyID: toyID,
+			setupMocks: func(_ *mockservices.MockMastersService, toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(&entities.Toy{ID: toyID, ModerationStatus: entities.ToyModerationStatusPending}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &validation.Error{},
+		},
+		{
+			name:  "Master blocked",
+			toyID: toyID,
+			setupMocks: func(mastersService *mockservices.MockMastersService, toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(rejectedToy, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusBanned}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.MasterBlockedError{},
+		},
+		{
+			name:  "Toy not found",
+			toyID: toyID,
+			setupMocks: func(_ *mockservices.MockMastersService, toysService *mockservices.MockToysService) {
+				toysService.
+					EXPECT().
+					GetToyByID(gomock.Any(), toyID).
+					Return(nil, &customerrors.ToyNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+			expectedError: &customerrors.ToyNotFoundError{},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(mastersService, toysService)
+			}
+
+			err := useCases.ResubmitToy(ctx, tc.toyID, tc.appeal)
+			if tc.errorExpected {
+				require.Error(t, err)
+				if tc.expectedError != nil {
+					require.IsType(t, tc.expectedError, err)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_ReloadForbiddenWords(t *testing.T) {
+	testCases := []struct {
+		name          string
+		setupMocks    func(forbiddenWordsService *mockservices.MockForbiddenWordsService)
+		expected      int
+		errorExpected bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(forbiddenWordsService *mockservices.MockForbiddenWordsService) {
+				forbiddenWordsService.
+					EXPECT().
+					ReloadForbiddenWords(gomock.Any()).
+					Return(3, nil).
+					Times(1)
+			},
+			expected: 3,
+		},
+		{
+			name: "error",
+			setupMocks: func(forbiddenWordsService *mockservices.MockForbiddenWordsService) {
+				forbiddenWordsService.
+					EXPECT().
+					ReloadForbiddenWords(gomock.Any()).
+					Return(0, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := mockservices.NewMockForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setupMocks != nil {
+				tc.setupMocks(forbiddenWordsService)
+			}
+
+			actual, err := useCases.ReloadForbiddenWords(ctx)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_AddToyWithDictionaryForbiddenWords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := mockservices.NewMockForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	forbiddenWordsService.
+		EXPECT().
+		ContainsForbiddenWords("Игрушка").
+		Return(true).
+		Times(1)
+
+	forbiddenWordsService.
+		EXPECT().
+		ContainsForbiddenWords("Тестовая игрушка").
+		Return(false).
+		Times(1)
+
+	mastersService.
+		EXPECT().
+		GetMasterByUserID(gomock.Any(), userID).
+		Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+		Times(1)
+
+	categoriesService.
+		EXPECT().
+		GetCategoryRules(gomock.Any(), categoryID).
+		Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+		Times(1)
+
+	_, err := useCases.AddToy(
+		ctx,
+		entities.RawAddToyDTO{
+			UserID:      userID,
+			CategoryID:  categoryID,
+			Name:        "Игрушка",
+			Description: "Тестовая игрушка",
+			Price:       120,
+			Quantity:    1,
+		},
+	)
+	require.Error(t, err)
+	require.Equal(
+		t,
+		&customerrors.ValidationError{
+			Violations: []customerrors.FieldViolation{
+				{
+					Field:   "name",
+					Code:    customerrors.ViolationCodeForbiddenWords,
+					Message: "toy name contains forbidden words",
+				},
+			},
+		},
+		err,
+	)
+}
+
+func TestUseCases_AddToyReturnsAllViolations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	mastersService.
+		EXPECT().
+		GetMasterByUserID(gomock.Any(), userID).
+		Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+		Times(1)
+
+	categoriesService.
+		EXPECT().
+		GetCategoryRules(gomock.Any(), categoryID).
+		Return(
+			&entities.CategoryRules{
+				CategoryID:         categoryID,
+				RequiredAttributes: []entities.ToyAttribute{entities.ToyAttributeAttachments},
+			},
+			nil,
+		).
+		Times(1)
+
+	_, err := useCases.AddToy(
+		ctx,
+		entities.RawAddToyDTO{
+			UserID:      userID,
+			CategoryID:  categoryID,
+			Name:        "Toy",
+			Description: "Мразь а не игрушка",
+			Price:       1_000_000_000,
+			Quantity:    0,
+		},
+	)
+	require.Error(t, err)
+	require.Equal(
+		t,
+		&customerrors.ValidationError{
+			Violations: []customerrors.FieldViolation{
+				{
+					Field:   "name",
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: "invalid toy name",
+				},
+				{
+					Field:   "description",
+					Code:    customerrors.ViolationCodeForbiddenWords,
+					Message: "toy description contains forbidden words",
+				},
+				{
+					Field:   "price",
+					Code:    customerrors.ViolationCodeOutOfRange,
+					Message: "invalid toy price",
+				},
+				{
+					Field:   "quantity",
+					Code:    customerrors.ViolationCodeOutOfRange,
+					Message: "invalid toy quantity",
+				},
+				{
+					Field:   "attachments",
+					Code:    customerrors.ViolationCodeRequired,
+					Message: "toy attachments are required for category",
+				},
+			},
+		},
+		err,
+	)
+}
+
+func TestUseCases_UpdateMasterReturnsAllViolations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	err := useCases.UpdateMaster(
+		ctx,
+		entities.UpdateMasterDTO{
+			ID:          masterID,
+			Slug:        pointers.New[string]("Invalid Slug"),
+			SocialLinks: []string{"https://vk.com/toys", "not a link"},
+		},
+	)
+	require.Error(t, err)
+	require.Equal(
+		t,
+		&customerrors.ValidationError{
+			Violations: []customerrors.FieldViolation{
+				{
+					Field:   "slug",
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: "invalid master slug",
+				},
+				{
+					Field:   "socialLinks[1]",
+					Code:    customerrors.ViolationCodeInvalidFormat,
+					Message: "invalid master social link: not a link",
+				},
+			},
+		},
+		err,
+	)
+}
+
+func TestUseCases_CreateTagsReturnsAllViolations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := newTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	_, err := useCases.CreateTags(
+		ctx,
+		[]entities.CreateTagDTO{
+			{Name: "мразь"},
+			{Name: "игрушки"},
+			{Name: "сука"},
+		},
+	)
+	require.Error(t, err)
+	require.Equal(
+		t,
+		&customerrors.ValidationError{
+			Violations: []customerrors.FieldViolation{
+				{
+					Field:   "tags[0].name",
+					Code:    customerrors.ViolationCodeForbiddenWords,
+					Message: "tag name contains forbidden words",
+				},
+				{
+					Field:   "tags[2].name",
+					Code:    customerrors.ViolationCodeForbiddenWords,
+					Message: "tag name contains forbidden words",
+				},
+			},
+		},
+		err,
+	)
+}
+
+func TestUseCases_AddToyWithinTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tagsService := mockservices.NewMockTagsService(ctrl)
+	categoriesService := mockservices.NewMockCategoriesService(ctrl)
+	mastersService := mockservices.NewMockMastersService(ctrl)
+	toysService := mockservices.NewMockToysService(ctrl)
+	ssoService := mockservices.NewMockSsoService(ctrl)
+	reviewsService := mockservices.NewMockReviewsService(ctrl)
+	forbiddenWordsService := newForbiddenWordsService(ctrl)
+	transactionManager := mocktransactions.NewMockTransactionManager(ctrl)
+	useCases := New(
+		tagsService,
+		categoriesService,
+		mastersService,
+		toysService,
+		ssoService,
+		reviewsService,
+		forbiddenWordsService,
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	// Services are not called, if transaction can't be started:
+	expectedErr := errors.New("failed to begin transaction")
+	transactionManager.
+		EXPECT().
+		WithinTransaction(gomock.Any(), gomock.Any()).
+		Return(expectedErr).
+		Times(1)
+
+	toyID, err := useCases.AddToy(
+		context.Background(),
+		entities.RawAddToyDTO{
+			UserID:      userID,
+			CategoryID:  categoryID,
+			Name:        "Игрушка",
+			Description: "Игрушка для детей",
+			Price:       100,
+			Quantity:    1,
+		},
+	)
+	require.ErrorIs(t, err, expectedErr)
+	require.Zero(t, toyID)
+}
+
+func TestUseCases_ProcessUserEvent(t *testing.T) {
+	vacationUntil := time.Now().Add(time.Hour)
+	testCases := []struct {
+		name                  string
+		event                 entities.UserEvent
+		deletedUserToysPolicy string
+		setupMocks            func(
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+		)
+		errorExpected bool
+	}{
+		{
+			name:  "User is not Master",
+			event: entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+		},
+		{
+			name:  "failed to get Master",
+			event: entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:  "User banned",
+			event: entities.UserEvent{ID: "1", Type: entities.UserEventTypeBanned, UserID: userID},
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusVerified}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: masterID,
+							Status:   entities.MasterStatusBanned,
+							Reason:   bannedUserReason,
+						},
+					).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:  "banned User of already banned Master",
+			event: entities.UserEvent{ID: "1", Type: entities.UserEventTypeBanned, UserID: userID},
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusBanned}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "User deleted with archived Toys",
+			event:                 entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			deletedUserToysPolicy: config.DeletedUserToysPolicyArchive,
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(
+						&entities.Master{
+							ID:            masterID,
+							Status:        entities.MasterStatusVerified,
+							ShopName:      pointers.New("test shop"),
+							VacationUntil: &vacationUntil,
+						},
+						nil,
+					).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: masterID,
+							Status:   entities.MasterStatusBanned,
+							Reason:   deletedUserReason,
+						},
+					).
+					Return(nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					SetVacationMode(gomock.Any(), entities.SetVacationModeDTO{MasterID: masterID}).
+					Return(nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					UpdateMaster(gomock.Any(), forgottenMasterData(masterID)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "User deleted with deleted Toys",
+			event:                 entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			deletedUserToysPolicy: config.DeletedUserToysPolicyDelete,
+			setupMocks: func(mastersService *mockservices.MockMastersService, toysService *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusBanned}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return([]entities.MasterStatusChange{{MasterID: masterID, Reason: bannedUserReason}}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: masterID,
+							Status:   entities.MasterStatusBanned,
+							Reason:   deletedUserReason,
+						},
+					).
+					Return(nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					DeleteMasterToys(gomock.Any(), masterID).
+					Return([]uint64{toyID}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					UpdateMaster(gomock.Any(), forgottenMasterData(masterID)).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:                  "failed to delete Toys of deleted User",
+			event:                 entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			deletedUserToysPolicy: config.DeletedUserToysPolicyDelete,
+			setupMocks: func(mastersService *mockservices.MockMastersService, toysService *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusBanned}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return([]entities.MasterStatusChange{{MasterID: masterID, Reason: bannedUserReason}}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: masterID,
+							Status:   entities.MasterStatusBanned,
+							Reason:   deletedUserReason,
+						},
+					).
+					Return(nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					DeleteMasterToys(gomock.Any(), masterID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:                  "deleted User of already forgotten Master",
+			event:                 entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			deletedUserToysPolicy: config.DeletedUserToysPolicyDelete,
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusBanned}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return([]entities.MasterStatusChange{{MasterID: masterID, Reason: deletedUserReason}}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:  "failed to get status history of deleted User's Master",
+			event: entities.UserEvent{ID: "1", Type: entities.UserEventTypeDeleted, UserID: userID},
+			setupMocks: func(mastersService *mockservices.MockMastersService, _ *mockservices.MockToysService) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusBanned}, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mastersService := mockservices.NewMockMastersService(ctrl)
+			toysService := mockservices.NewMockToysService(ctrl)
+			useCases := New(
+				mockservices.NewMockTagsService(ctrl),
+				mockservices.NewMockCategoriesService(ctrl),
+				mastersService,
+				toysService,
+				mockservices.NewMockSsoService(ctrl),
+				mockservices.NewMockReviewsService(ctrl),
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				config.UserEventsConfig{DeletedUserToysPolicy: tc.deletedUserToysPolicy},
+			)
+
+			tc.setupMocks(mastersService, toysService)
+
+			err := useCases.ProcessUserEvent(ctx, tc.event)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUseCases_ReconcileMasters(t *testing.T) {
+	existingMaster := entities.Master{ID: 1, UserID: 1, Status: entities.MasterStatusVerified}
+	orphanedMaster := entities.Master{ID: 2, UserID: 2, Status: entities.MasterStatusVerified}
+	lastPageMaster := entities.Master{ID: 3, UserID: 3, Status: entities.MasterStatusBanned}
+
+	testCases := []struct {
+		name       string
+		dryRun     bool
+		setupMocks func(
+			mastersService *mockservices.MockMastersService,
+			ssoService *mockservices.MockSsoService,
+		)
+		expected      []uint64
+		errorExpected bool
+	}{
+		{
+			name:   "dry run",
+			dryRun: true,
+			setupMocks: func(mastersService *mockservices.MockMastersService, ssoService *mockservices.MockSsoService) {
+				gomock.InOrder(
+					mastersService.
+						EXPECT().
+						GetMasters(
+							gomock.Any(),
+							&entities.Pagination{Limit: pointers.New[uint64](2)},
+							&entities.MastersFilters{
+								IDAfter:                pointers.New[uint64](0),
+								ExcludeBannedForReason: pointers.New(deletedUserReason),
+							},
+						).
+						Return([]entities.Master{existingMaster, orphanedMaster}, nil).
+						Times(1),
+					mastersService.
+						EXPECT().
+						GetMasters(
+							gomock.Any(),
+							&entities.Pagination{Limit: pointers.New[uint64](2)},
+							&entities.MastersFilters{
+								IDAfter:                pointers.New(orphanedMaster.ID),
+								ExcludeBannedForReason: pointers.New(deletedUserReason),
+							},
+						).
+						Return([]entities.Master{lastPageMaster}, nil).
+						Times(1),
+				)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), existingMaster.UserID).
+					Return(&entities.User{ID: existingMaster.UserID}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), orphanedMaster.UserID).
+					Return(nil, &customerrors.UserNotFoundError{}).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), lastPageMaster.UserID).
+					Return(nil, &customerrors.UserNotFoundError{}).
+					Times(1)
+			},
+			expected: []uint64{orphanedMaster.ID, lastPageMaster.ID},
+		},
+		{
+			name: "orphaned Masters processed",
+			setupMocks: func(mastersService *mockservices.MockMastersService, ssoService *mockservices.MockSsoService) {
+				mastersService.
+					EXPECT().
+					GetMasters(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]entities.Master{orphanedMaster}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), orphanedMaster.UserID).
+					Return(nil, &customerrors.UserNotFoundError{}).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					ChangeMasterStatus(
+						gomock.Any(),
+						entities.ChangeMasterStatusDTO{
+							MasterID: orphanedMaster.ID,
+							Status:   entities.MasterStatusBanned,
+							Reason:   deletedUserReason,
+						},
+					).
+					Return(nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					UpdateMaster(gomock.Any(), forgottenMasterData(orphanedMaster.ID)).
+					Return(nil).
+					Times(1)
+			},
+			expected: []uint64{orphanedMaster.ID},
+		},
+		{
+			name: "SSO is unavailable",
+			setupMocks: func(mastersService *mockservices.MockMastersService, ssoService *mockservices.MockSsoService) {
+				mastersService.
+					EXPECT().
+					GetMasters(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]entities.Master{existingMaster}, nil).
+					Times(1)
+
+				ssoService.
+					EXPECT().
+					GetUserByID(gomock.Any(), existingMaster.UserID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mastersService := mockservices.NewMockMastersService(ctrl)
+			ssoService := mockservices.NewMockSsoService(ctrl)
+			useCases := New(
+				mockservices.NewMockTagsService(ctrl),
+				mockservices.NewMockCategoriesService(ctrl),
+				mastersService,
+				mockservices.NewMockToysService(ctrl),
+				ssoService,
+				mockservices.NewMockReviewsService(ctrl),
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				config.UserEventsConfig{
+					DeletedUserToysPolicy:   config.DeletedUserToysPolicyArchive,
+					ReconciliationBatchSize: 2,
+				},
+			)
+
+			tc.setupMocks(mastersService, ssoService)
+
+			actual, err := useCases.ReconcileMasters(ctx, tc.dryRun)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_ExportUserData(t *testing.T) {
+	master := &entities.Master{ID: masterID, UserID: userID, Status: entities.MasterStatusBanned}
+	statusHistory := []entities.MasterStatusChange{{ID: 1, MasterID: masterID, Status: entities.MasterStatusBanned}}
+	masterToys := []entities.Toy{{ID: toyID, MasterID: masterID, ModerationStatus: entities.ToyModerationStatusRejected}}
+	priceHistory := []entities.ToyPriceChange{{ID: 1, ToyID: toyID, PreviousPrice: 100, Price: 110.5}}
+	favourites := []entities.Favourite{{ID: 1, UserID: userID, ToyID: toyID}}
+	reviews := []entities.Review{{ID: 1, UserID: userID, ToyID: toyID, Rating: 5}}
+
+	testCases := []struct {
+		name       string
+		setupMocks func(
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+			reviewsService *mockservices.MockReviewsService,
+		)
+		expected      *entities.UserDataExport
+		errorExpected bool
+	}{
+		{
+			name: "Master's data exported",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				reviewsService *mockservices.MockReviewsService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(master, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(statusHistory, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllMasterToys(gomock.Any(), masterID).
+					Return(masterToys, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterToysPriceHistory(gomock.Any(), masterID).
+					Return(priceHistory, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetUserFavouritesRecords(gomock.Any(), userID).
+					Return(favourites, nil).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					GetUserReviews(gomock.Any(), userID).
+					Return(reviews, nil).
+					Times(1)
+			},
+			expected: &entities.UserDataExport{
+				Version:             entities.UserDataExportVersion,
+				UserID:              userID,
+				Master:              master,
+				MasterStatusHistory: statusHistory,
+				Toys:                masterToys,
+				ToysPriceHistory:    priceHistory,
+				Favourites:          favourites,
+				Reviews:             reviews,
+			},
+		},
+		{
+			name: "User is not Master",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				reviewsService *mockservices.MockReviewsService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetUserFavouritesRecords(gomock.Any(), userID).
+					Return(favourites, nil).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					GetUserReviews(gomock.Any(), userID).
+					Return(nil, nil).
+					Times(1)
+			},
+			expected: &entities.UserDataExport{
+				Version:    entities.UserDataExportVersion,
+				UserID:     userID,
+				Favourites: favourites,
+			},
+		},
+		{
+			name: "failed to get Master",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+				_ *mockservices.MockReviewsService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "failed to get Master's Toys",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockReviewsService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(master, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(statusHistory, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllMasterToys(gomock.Any(), masterID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "failed to get price history of Master's Toys",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				_ *mockservices.MockReviewsService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(master, nil).
+					Times(1)
+
+				mastersService.
+					EXPECT().
+					GetMasterStatusHistory(gomock.Any(), masterID).
+					Return(statusHistory, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetAllMasterToys(gomock.Any(), masterID).
+					Return(masterToys, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetMasterToysPriceHistory(gomock.Any(), masterID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "failed to get Reviews",
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+				reviewsService *mockservices.MockReviewsService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetUserFavouritesRecords(gomock.Any(), userID).
+					Return(nil, nil).
+					Times(1)
+
+				reviewsService.
+					EXPECT().
+					GetUserReviews(gomock.Any(), userID).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mastersService := mockservices.NewMockMastersService(ctrl)
+			toysService := mockservices.NewMockToysService(ctrl)
+			reviewsService := mockservices.NewMockReviewsService(ctrl)
+			useCases := New(
+				mockservices.NewMockTagsService(ctrl),
+				mockservices.NewMockCategoriesService(ctrl),
+				mastersService,
+				toysService,
+				mockservices.NewMockSsoService(ctrl),
+				reviewsService,
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				userEventsConfig,
+			)
+
+			tc.setupMocks(mastersService, toysService, reviewsService)
+
+			actual, err := useCases.ExportUserData(ctx, userID)
+			if tc.errorExpected {
+				require.Error(t, err)
+				require.Nil(t, actual)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.WithinDuration(t, time.Now(), actual.ExportedAt, time.Minute)
+
+			actual.ExportedAt = time.Time{}
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_ImportToys(t *testing.T) {
+	validRow := entities.ImportToyRow{
+		Line:         2,
+		Name:         "Игрушка",
+		Description:  "Тестовая игрушка",
+		Price:        110.5,
+		Quantity:     1,
+		CategoryName: " Test Category ",
+	}
+	expectedToy := entities.AddToyDTO{
+		MasterID:         masterID,
+		CategoryID:       categoryID,
+		Name:             "Игрушка",
+		Description:      "Тестовая игрушка",
+		Price:            110.5,
+		Quantity:         1,
+		ModerationStatus: entities.ToyModerationStatusApproved,
+	}
+
+	testCases := []struct {
+		name       string
+		rows       []entities.ImportToyRow
+		dryRun     bool
+		setupMocks func(
+			tagsService *mockservices.MockTagsService,
+			categoriesService *mockservices.MockCategoriesService,
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+		)
+		expected      []entities.ImportToyResult
+		errorExpected bool
+	}{
+		{
+			name: "dry run",
+			rows: []entities.ImportToyRow{
+				{
+					Line:         2,
+					Name:         "Игрушка",
+					Description:  "Тестовая игрушка",
+					Price:        110.5,
+					Quantity:     1,
+					CategoryName: "test category",
+					TagNames:     []string{"Шерсть"},
+				},
+				{
+					Line:         3,
+					Name:         "Игрушка",
+					Description:  "Тестовая игрушка",
+					Price:        110.5,
+					Quantity:     1,
+					CategoryName: "unknown",
+				},
+				{
+					Line:         4,
+					Name:         "Игрушка",
+					Description:  "Тестовая игрушка",
+					Price:        priceCeil + 1,
+					Quantity:     1,
+					CategoryName: "test category",
+				},
+			},
+			dryRun: true,
+			setupMocks: func(
+				tagsService *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(3) // import + 2 valid by category rows
+
+				categoriesService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: categoryID, Name: "Test Category"}}, nil).
+					Times(1)
+
+				tagsService.
+					EXPECT().
+					GetAllTags(gomock.Any()).
+					Return([]entities.Tag{{ID: tagID, Name: "шерсть"}}, nil).
+					Times(1)
+
+				tagsService.
+					EXPECT().
+					CreateTags(gomock.Any(), nil).
+					Return(nil, nil).
+					Times(1)
+
+				tagsService.
+					EXPECT().
+					GetTagByID(gomock.Any(), tagID).
+					Return(&entities.Tag{ID: tagID, Name: "шерсть"}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(2)
+
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toyWithTag := expectedToy
+				toyWithTag.TagIDs = []uint32{tagID}
+
+				toysService.
+					EXPECT().
+					AddToy(gomock.Any(), toyWithTag).
+					Return(toyID, nil).
+					Times(1)
+			},
+			expected: []entities.ImportToyResult{
+				{Line: 2},
+				{
+					Line: 3,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "category",
+							Code:    customerrors.ViolationCodeNotFound,
+							Message: `category "unknown" not found`,
+						},
+					},
+				},
+				{
+					Line: 4,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "price",
+							Code:    customerrors.ViolationCodeOutOfRange,
+							Message: "invalid toy price",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "import",
+			rows: []entities.ImportToyRow{validRow},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(2)
+
+				categoriesService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: categoryID, Name: "Test Category"}}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					AddToy(gomock.Any(), expectedToy).
+					Return(toyID, nil).
+					Times(1)
+			},
+			expected: []entities.ImportToyResult{{Line: 2, ToyID: pointers.New(toyID)}},
+		},
+		{
+			name: "Toy already exists",
+			rows: []entities.ImportToyRow{validRow},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(2)
+
+				categoriesService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: categoryID, Name: "Test Category"}}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					AddToy(gomock.Any(), expectedToy).
+					Return(uint64(0), &customerrors.ToyAlreadyExistsError{}).
+					Times(1)
+			},
+			expected: []entities.ImportToyResult{
+				{
+					Line: 2,
+					Violations: []customerrors.FieldViolation{
+						{
+							Field:   "name",
+							Code:    customerrors.ViolationCodeAlreadyExists,
+							Message: "the same toy already exists",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Master is blocked",
+			rows: []entities.ImportToyRow{validRow},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				_ *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, Status: entities.MasterStatusSuspended}, nil).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name: "failed to add Toy",
+			rows: []entities.ImportToyRow{validRow, {
+				Line:         3,
+				Name:         validRow.Name,
+				Description:  validRow.Description,
+				Price:        validRow.Price,
+				Quantity:     validRow.Quantity,
+				CategoryName: validRow.CategoryName,
+			}},
+			setupMocks: func(
+				_ *mockservices.MockTagsService,
+				categoriesService *mockservices.MockCategoriesService,
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByUserID(gomock.Any(), userID).
+					Return(&entities.Master{ID: masterID, UserID: userID}, nil).
+					Times(3)
+
+				categoriesService.
+					EXPECT().
+					GetAllCategories(gomock.Any()).
+					Return([]entities.Category{{ID: categoryID, Name: "Test Category"}}, nil).
+					Times(1)
+
+				categoriesService.
+					EXPECT().
+					GetCategoryRules(gomock.Any(), categoryID).
+					Return(&entities.CategoryRules{CategoryID: categoryID}, nil).
+					Times(2)
+
+				toysService.
+					EXPECT().
+					GetCategoryPriceStats(gomock.Any(), categoryID).
+					Return(&entities.CategoryPriceStats{CategoryID: categoryID}, nil).
+					Times(2)
+
+				// Failure of one line doesn't stop import of next ones:
+				toysService.
+					EXPECT().
+					AddToy(gomock.Any(), expectedToy).
+					Return(uint64(0), errors.New("test")).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					AddToy(gomock.Any(), expectedToy).
+					Return(toyID, nil).
+					Times(1)
+			},
+			expected: []entities.ImportToyResult{
+				{
+					Line: 2,
+					Violations: []customerrors.FieldViolation{
+						{
+							Code:    customerrors.ViolationCodeInternal,
+							Message: "toy was not imported because of internal error",
+						},
+					},
+					Err: fmt.Errorf("failed to import Toy from line %d: %w", 2, errors.New("test")),
+				},
+				{Line: 3, ToyID: pointers.New(toyID)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tagsService := mockservices.NewMockTagsService(ctrl)
+			categoriesService := mockservices.NewMockCategoriesService(ctrl)
+			mastersService := mockservices.NewMockMastersService(ctrl)
+			toysService := mockservices.NewMockToysService(ctrl)
+			useCases := New(
+				tagsService,
+				categoriesService,
+				mastersService,
+				toysService,
+				mockservices.NewMockSsoService(ctrl),
+				mockservices.NewMockReviewsService(ctrl),
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				userEventsConfig,
+			)
+
+			tc.setupMocks(tagsService, categoriesService, mastersService, toysService)
+
+			actual, err := useCases.ImportToys(ctx, userID, tc.rows, tc.dryRun)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUseCases_ExportToys(t *testing.T) {
+	fullBatch := make([]entities.Toy, exportToysBatchSize)
+	for i := range fullBatch {
+		fullBatch[i] = entities.Toy{ID: uint64(i + 1)}
+	}
+
+	lastBatch := []entities.Toy{{ID: exportToysBatchSize + 1}}
+	filters := &entities.ToysFilters{Search: pointers.New("toy")}
+
+	testCases := []struct {
+		name       string
+		masterID   *uint64
+		fnErr      error
+		setupMocks func(
+			mastersService *mockservices.MockMastersService,
+			toysService *mockservices.MockToysService,
+		)
+		expectedBatches [][]entities.Toy
+		errorExpected   bool
+	}{
+		{
+			name: "all Toys by batches",
+			setupMocks: func(
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetToysBatch(gomock.Any(), nil, filters, uint64(0), uint64(exportToysBatchSize)).
+					Return(fullBatch, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToysBatch(
+						gomock.Any(),
+						nil,
+						filters,
+						uint64(exportToysBatchSize),
+						uint64(exportToysBatchSize),
+					).
+					Return(lastBatch, nil).
+					Times(1)
+			},
+			expectedBatches: [][]entities.Toy{fullBatch, lastBatch},
+		},
+		{
+			name:     "Toys of Master",
+			masterID: pointers.New(masterID),
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(&entities.Master{ID: masterID}, nil).
+					Times(1)
+
+				toysService.
+					EXPECT().
+					GetToysBatch(gomock.Any(), pointers.New(masterID), filters, uint64(0), uint64(exportToysBatchSize)).
+					Return(lastBatch, nil).
+					Times(1)
+			},
+			expectedBatches: [][]entities.Toy{lastBatch},
+		},
+		{
+			name: "no Toys",
+			setupMocks: func(
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetToysBatch(gomock.Any(), nil, filters, uint64(0), uint64(exportToysBatchSize)).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name:     "Master not found",
+			masterID: pointers.New(masterID),
+			setupMocks: func(
+				mastersService *mockservices.MockMastersService,
+				_ *mockservices.MockToysService,
+			) {
+				mastersService.
+					EXPECT().
+					GetMasterByID(gomock.Any(), masterID).
+					Return(nil, &customerrors.MasterNotFoundError{}).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+		{
+			name:  "failed to write batch",
+			fnErr: errors.New("test"),
+			setupMocks: func(
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetToysBatch(gomock.Any(), nil, filters, uint64(0), uint64(exportToysBatchSize)).
+					Return(fullBatch, nil).
+					Times(1)
+
+				// Next batch may be read ahead before reading is stopped:
+				toysService.
+					EXPECT().
+					GetToysBatch(
+						gomock.Any(),
+						nil,
+						filters,
+						uint64(exportToysBatchSize),
+						uint64(exportToysBatchSize),
+					).
+					Return(lastBatch, nil).
+					MaxTimes(1)
+			},
+			expectedBatches: [][]entities.Toy{fullBatch},
+			errorExpected:   true,
+		},
+		{
+			name: "failed to get batch",
+			setupMocks: func(
+				_ *mockservices.MockMastersService,
+				toysService *mockservices.MockToysService,
+			) {
+				toysService.
+					EXPECT().
+					GetToysBatch(gomock.Any(), nil, filters, uint64(0), uint64(exportToysBatchSize)).
+					Return(nil, errors.New("test")).
+					Times(1)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mastersService := mockservices.NewMockMastersService(ctrl)
+			toysService := mockservices.NewMockToysService(ctrl)
+			useCases := New(
+				mockservices.NewMockTagsService(ctrl),
+				mockservices.NewMockCategoriesService(ctrl),
+				mastersService,
+				toysService,
+				mockservices.NewMockSsoService(ctrl),
+				mockservices.NewMockReviewsService(ctrl),
+				newForbiddenWordsService(ctrl),
+				newTransactionManager(ctrl),
+				validationConfig,
+				userEventsConfig,
+			)
+
+			tc.setupMocks(mastersService, toysService)
+
+			var batches [][]entities.Toy
+
+			err := useCases.ExportToys(
+				ctx,
+				tc.masterID,
+				filters,
+				func(toys []entities.Toy) error {
+					batches = append(batches, toys)
+
+					return tc.fnErr
+				},
+			)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedBatches, batches)
+		})
+	}
+}
+
+func TestUseCases_ExportToysReleasesSnapshotBeforeWriting(t *testing.T) {
+	fullBatch := make([]entities.Toy, exportToysBatchSize)
+	for i := range fullBatch {
+		fullBatch[i] = entities.Toy{ID: uint64(i + 1)}
+	}
+
+	ctrl := gomock.NewController(t)
+	toysService := mockservices.NewMockToysService(ctrl)
+	snapshotReleased := make(chan struct{})
+
+	transactionManager := mocktransactions.NewMockTransactionManager(ctrl)
+	transactionManager.
+		EXPECT().
+		WithinSnapshot(gomock.Any(), gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(ctx context.Context) error) error {
+				defer close(snapshotReleased)
+
+				return fn(ctx)
+			},
+		).
+		Times(1)
+
+	toysService.
+		EXPECT().
+		GetToysBatch(gomock.Any(), nil, nil, uint64(0), uint64(exportToysBatchSize)).
+		Return(fullBatch, nil).
+		Times(1)
+
+	toysService.
+		EXPECT().
+		GetToysBatch(gomock.Any(), nil, nil, uint64(exportToysBatchSize), uint64(exportToysBatchSize)).
+		Return([]entities.Toy{{ID: exportToysBatchSize + 1}}, nil).
+		Times(1)
+
+	useCases := New(
+		mockservices.NewMockTagsService(ctrl),
+		mockservices.NewMockCategoriesService(ctrl),
+		mockservices.NewMockMastersService(ctrl),
+		toysService,
+		mockservices.NewMockSsoService(ctrl),
+		mockservices.NewMockReviewsService(ctrl),
+		newForbiddenWordsService(ctrl),
+		transactionManager,
+		validationConfig,
+		userEventsConfig,
+	)
+
+	// Slow client doesn't take batches, until all of them are read and snapshot is released:
+	err := useCases.ExportToys(
+		ctx,
+		nil,
+		nil,
+		func(_ []entities.Toy) error {
+			select {
+			case <-snapshotReleased:
+				return nil
+			case <-time.After(time.Second):
+				return errors.New("snapshot is held, while batch is written")
+			}
+		},
+	)
+	require.NoError(t, err)
+}
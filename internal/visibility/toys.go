@@ -0,0 +1,40 @@
+package visibility
+
+import (
+	"context"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+)
+
+// toyModerationStatusesKey is used to pass moderation statuses of Toys, which can be read by ID, through context.
+type toyModerationStatusesKey struct{}
+
+// AllToyModerationStatuses returns all moderation statuses of Toys, which can be read by owners and moderators.
+func AllToyModerationStatuses() []entities.ToyModerationStatus {
+	return []entities.ToyModerationStatus{
+		entities.ToyModerationStatusApproved,
+		entities.ToyModerationStatusPending,
+		entities.ToyModerationStatusRejected,
+	}
+}
+
+// WithToyModerationStatuses returns context, in which Toys with provided moderation statuses can be read by ID.
+func WithToyModerationStatuses(ctx context.Context, statuses ...entities.ToyModerationStatus) context.Context {
+	return context.WithValue(ctx, toyModerationStatusesKey{}, statuses)
+}
+
+// WithAllToyModerationStatuses returns context, in which Toys can be read by ID regardless of moderation status.
+// It is used by use cases of owners and moderators, which read Toy before changing it.
+func WithAllToyModerationStatuses(ctx context.Context) context.Context {
+	return WithToyModerationStatuses(ctx, AllToyModerationStatuses()...)
+}
+
+// ToyModerationStatusesFromContext returns moderation statuses of Toys, which can be read by ID. Only approved
+// Toys are read, if context doesn't contain statuses, the same as in lists of Toys.
+func ToyModerationStatusesFromContext(ctx context.Context) []entities.ToyModerationStatus {
+	if statuses, ok := ctx.Value(toyModerationStatusesKey{}).([]entities.ToyModerationStatus); ok && len(statuses) > 0 {
+		return statuses
+	}
+
+	return []entities.ToyModerationStatus{entities.ToyModerationStatusApproved}
+}
@@ -0,0 +1,34 @@
+package visibility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DKhorkov/hmtm-toys/internal/entities"
+	"github.com/DKhorkov/hmtm-toys/internal/visibility"
+)
+
+func TestToyModerationStatusesFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(
+		t,
+		[]entities.ToyModerationStatus{entities.ToyModerationStatusApproved},
+		visibility.ToyModerationStatusesFromContext(ctx),
+	)
+
+	require.Equal(
+		t,
+		visibility.AllToyModerationStatuses(),
+		visibility.ToyModerationStatusesFromContext(visibility.WithAllToyModerationStatuses(ctx)),
+	)
+
+	require.Equal(
+		t,
+		[]entities.ToyModerationStatus{entities.ToyModerationStatusRejected},
+		visibility.ToyModerationStatusesFromContext(
+			visibility.WithToyModerationStatuses(ctx, entities.ToyModerationStatusRejected),
+		),
+	)
+}
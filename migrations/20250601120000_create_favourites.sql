@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE toys
+    ADD COLUMN favourites_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS favourites
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER   NOT NULL,
+    toy_id     INTEGER   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (toy_id) REFERENCES toys (id) ON DELETE CASCADE,
+    UNIQUE (user_id, toy_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS favourites;
+
+ALTER TABLE toys
+    DROP COLUMN favourites_count;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE toys
+    ADD COLUMN average_rating FLOAT NOT NULL DEFAULT 0;
+
+ALTER TABLE toys
+    ADD COLUMN reviews_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE masters
+    ADD COLUMN average_rating FLOAT NOT NULL DEFAULT 0;
+
+ALTER TABLE masters
+    ADD COLUMN reviews_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS reviews
+(
+    id         SERIAL PRIMARY KEY,
+    toy_id     INTEGER   NOT NULL,
+    user_id    INTEGER   NOT NULL,
+    rating     INTEGER   NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text       TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (toy_id) REFERENCES toys (id) ON DELETE CASCADE,
+    UNIQUE (toy_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS reviews_photos
+(
+    id         SERIAL PRIMARY KEY,
+    review_id  INTEGER   NOT NULL,
+    link       VARCHAR   NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (review_id) REFERENCES reviews (id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reviews_photos;
+DROP TABLE IF EXISTS reviews;
+
+ALTER TABLE masters
+    DROP COLUMN reviews_count;
+
+ALTER TABLE masters
+    DROP COLUMN average_rating;
+
+ALTER TABLE toys
+    DROP COLUMN reviews_count;
+
+ALTER TABLE toys
+    DROP COLUMN average_rating;
+-- +goose StatementEnd
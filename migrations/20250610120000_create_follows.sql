@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE masters
+    ADD COLUMN followers_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS follows
+(
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER   NOT NULL,
+    master_id  INTEGER   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (master_id) REFERENCES masters (id) ON DELETE CASCADE,
+    UNIQUE (user_id, master_id)
+);
+
+-- Feed reads newest Toys of each followed Master, so index is sorted the same way as the feed:
+CREATE INDEX IF NOT EXISTS toys_master_id_created_at_id_idx ON toys (master_id, created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS toys_master_id_created_at_id_idx;
+
+DROP TABLE IF EXISTS follows;
+
+ALTER TABLE masters
+    DROP COLUMN followers_count;
+-- +goose StatementEnd
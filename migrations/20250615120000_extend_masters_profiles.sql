@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE masters
+    ADD COLUMN shop_name TEXT;
+
+ALTER TABLE masters
+    ADD COLUMN slug TEXT;
+
+ALTER TABLE masters
+    ADD COLUMN avatar TEXT;
+
+ALTER TABLE masters
+    ADD COLUMN banner TEXT;
+
+ALTER TABLE masters
+    ADD COLUMN city TEXT;
+
+ALTER TABLE masters
+    ADD COLUMN region TEXT;
+
+ALTER TABLE masters
+    ADD COLUMN policies TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS masters_slug_idx ON masters (slug);
+
+CREATE TABLE IF NOT EXISTS masters_social_links
+(
+    id         SERIAL PRIMARY KEY,
+    master_id  INTEGER   NOT NULL,
+    link       TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (master_id) REFERENCES masters (id) ON DELETE CASCADE,
+    UNIQUE (master_id, link)
+);
+
+CREATE TABLE IF NOT EXISTS masters_ships_to
+(
+    id         SERIAL PRIMARY KEY,
+    master_id  INTEGER   NOT NULL,
+    region     TEXT      NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (master_id) REFERENCES masters (id) ON DELETE CASCADE,
+    UNIQUE (master_id, region)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS masters_ships_to;
+
+DROP TABLE IF EXISTS masters_social_links;
+
+DROP INDEX IF EXISTS masters_slug_idx;
+
+ALTER TABLE masters
+    DROP COLUMN policies;
+
+ALTER TABLE masters
+    DROP COLUMN region;
+
+ALTER TABLE masters
+    DROP COLUMN city;
+
+ALTER TABLE masters
+    DROP COLUMN banner;
+
+ALTER TABLE masters
+    DROP COLUMN avatar;
+
+ALTER TABLE masters
+    DROP COLUMN slug;
+
+ALTER TABLE masters
+    DROP COLUMN shop_name;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE masters
+    ADD COLUMN vacation_until TIMESTAMP;
+
+ALTER TABLE masters
+    ADD COLUMN vacation_message TEXT;
+
+-- Denormalized availability flag to filter Toys of Masters on vacation without joins:
+ALTER TABLE toys
+    ADD COLUMN available BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE INDEX IF NOT EXISTS masters_vacation_until_idx ON masters (vacation_until);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS masters_vacation_until_idx;
+
+ALTER TABLE toys
+    DROP COLUMN available;
+
+ALTER TABLE masters
+    DROP COLUMN vacation_message;
+
+ALTER TABLE masters
+    DROP COLUMN vacation_until;
+-- +goose StatementEnd
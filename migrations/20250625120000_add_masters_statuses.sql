@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE masters
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pending';
+
+CREATE TABLE IF NOT EXISTS masters_status_history
+(
+    id              SERIAL PRIMARY KEY,
+    master_id       INTEGER     NOT NULL,
+    previous_status VARCHAR(20) NOT NULL,
+    status          VARCHAR(20) NOT NULL,
+    reason          TEXT        NOT NULL,
+    created_at      TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (master_id) REFERENCES masters (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS masters_status_idx ON masters (status);
+CREATE INDEX IF NOT EXISTS masters_status_history_master_id_idx ON masters_status_history (master_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS masters_status_history_master_id_idx;
+DROP INDEX IF EXISTS masters_status_idx;
+
+DROP TABLE IF EXISTS masters_status_history;
+
+ALTER TABLE masters
+    DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE toys
+    ADD COLUMN moderation_status VARCHAR(20) NOT NULL DEFAULT 'approved';
+
+ALTER TABLE toys
+    ADD COLUMN moderation_flags TEXT;
+
+ALTER TABLE toys
+    ADD COLUMN rejection_reason TEXT;
+
+ALTER TABLE toys
+    ADD COLUMN appeal TEXT;
+
+-- Moderation queue is read from the oldest pending Toys, while most of Toys are approved:
+CREATE INDEX IF NOT EXISTS toys_pending_created_at_idx ON toys (created_at) WHERE moderation_status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS toys_pending_created_at_idx;
+
+ALTER TABLE toys
+    DROP COLUMN appeal;
+
+ALTER TABLE toys
+    DROP COLUMN rejection_reason;
+
+ALTER TABLE toys
+    DROP COLUMN moderation_flags;
+
+ALTER TABLE toys
+    DROP COLUMN moderation_status;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Not provided rules fall back to default ones:
+CREATE TABLE IF NOT EXISTS categories_rules
+(
+    id                     SERIAL PRIMARY KEY,
+    category_id            INTEGER   NOT NULL UNIQUE,
+    price_floor            REAL,
+    price_ceil             REAL,
+    quantity_floor         INTEGER,
+    quantity_ceil          INTEGER,
+    description_min_length INTEGER,
+    created_at             TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at             TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS categories_required_attributes
+(
+    id          SERIAL PRIMARY KEY,
+    category_id INTEGER     NOT NULL,
+    attribute   VARCHAR(50) NOT NULL,
+    created_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE,
+    UNIQUE (category_id, attribute)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS categories_required_attributes;
+
+DROP TABLE IF EXISTS categories_rules;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS toys_price_history
+(
+    id             SERIAL PRIMARY KEY,
+    toy_id         INTEGER   NOT NULL,
+    previous_price FLOAT     NOT NULL,
+    price          FLOAT     NOT NULL,
+    created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (toy_id) REFERENCES toys (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS toys_price_history_toy_id_idx ON toys_price_history (toy_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS toys_price_history_toy_id_idx;
+
+DROP TABLE IF EXISTS toys_price_history;
+-- +goose StatementEnd
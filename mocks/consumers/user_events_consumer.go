@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: consumers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=consumers.go -destination=../../mocks/consumers/user_events_consumer.go -package=mockconsumers -exclude_interfaces=
+//
+
+// Package mockconsumers is a generated GoMock package.
+package mockconsumers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	interfaces "github.com/DKhorkov/hmtm-toys/internal/interfaces"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserEventsConsumer is a mock of UserEventsConsumer interface.
+type MockUserEventsConsumer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserEventsConsumerMockRecorder
+	isgomock struct{}
+}
+
+// MockUserEventsConsumerMockRecorder is the mock recorder for MockUserEventsConsumer.
+type MockUserEventsConsumerMockRecorder struct {
+	mock *MockUserEventsConsumer
+}
+
+// NewMockUserEventsConsumer creates a new mock instance.
+func NewMockUserEventsConsumer(ctrl *gomock.Controller) *MockUserEventsConsumer {
+	mock := &MockUserEventsConsumer{ctrl: ctrl}
+	mock.recorder = &MockUserEventsConsumerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserEventsConsumer) EXPECT() *MockUserEventsConsumerMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockUserEventsConsumer) Consume(ctx context.Context, handler interfaces.UserEventHandler) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockUserEventsConsumerMockRecorder) Consume(ctx, handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockUserEventsConsumer)(nil).Consume), ctx, handler)
+}
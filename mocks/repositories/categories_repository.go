@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/categories_repository.go -exclude_interfaces=MastersRepository,TagsRepository,ToysRepository,SsoRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/categories_repository.go -exclude_interfaces=MastersRepository,TagsRepository,ToysRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
@@ -70,3 +70,18 @@ func (mr *MockCategoriesRepositoryMockRecorder) GetCategoryByID(ctx, id any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockCategoriesRepository)(nil).GetCategoryByID), ctx, id)
 }
+
+// GetCategoryRules mocks base method.
+func (m *MockCategoriesRepository) GetCategoryRules(ctx context.Context, categoryID uint32) (*entities.CategoryRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRules", ctx, categoryID)
+	ret0, _ := ret[0].(*entities.CategoryRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRules indicates an expected call of GetCategoryRules.
+func (mr *MockCategoriesRepositoryMockRecorder) GetCategoryRules(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRules", reflect.TypeOf((*MockCategoriesRepository)(nil).GetCategoryRules), ctx, categoryID)
+}
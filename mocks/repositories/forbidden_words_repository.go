@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/forbidden_words_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository,SsoRepository,ReviewsRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForbiddenWordsRepository is a mock of ForbiddenWordsRepository interface.
+type MockForbiddenWordsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockForbiddenWordsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockForbiddenWordsRepositoryMockRecorder is the mock recorder for MockForbiddenWordsRepository.
+type MockForbiddenWordsRepositoryMockRecorder struct {
+	mock *MockForbiddenWordsRepository
+}
+
+// NewMockForbiddenWordsRepository creates a new mock instance.
+func NewMockForbiddenWordsRepository(ctrl *gomock.Controller) *MockForbiddenWordsRepository {
+	mock := &MockForbiddenWordsRepository{ctrl: ctrl}
+	mock.recorder = &MockForbiddenWordsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForbiddenWordsRepository) EXPECT() *MockForbiddenWordsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetForbiddenWords mocks base method.
+func (m *MockForbiddenWordsRepository) GetForbiddenWords(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForbiddenWords", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForbiddenWords indicates an expected call of GetForbiddenWords.
+func (mr *MockForbiddenWordsRepositoryMockRecorder) GetForbiddenWords(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForbiddenWords", reflect.TypeOf((*MockForbiddenWordsRepository)(nil).GetForbiddenWords), ctx)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repositories.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/reviews_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository,SsoRepository,ForbiddenWordsRepository -package=mockrepositories
+//
+
+// Package mockrepositories is a generated GoMock package.
+package mockrepositories
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-toys/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewsRepository is a mock of ReviewsRepository interface.
+type MockReviewsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewsRepositoryMockRecorder is the mock recorder for MockReviewsRepository.
+type MockReviewsRepositoryMockRecorder struct {
+	mock *MockReviewsRepository
+}
+
+// NewMockReviewsRepository creates a new mock instance.
+func NewMockReviewsRepository(ctrl *gomock.Controller) *MockReviewsRepository {
+	mock := &MockReviewsRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewsRepository) EXPECT() *MockReviewsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReview mocks base method.
+func (m *MockReviewsRepository) AddReview(ctx context.Context, reviewData entities.AddReviewDTO) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", ctx, reviewData)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockReviewsRepositoryMockRecorder) AddReview(ctx, reviewData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReviewsRepository)(nil).AddReview), ctx, reviewData)
+}
+
+// CountToyReviews mocks base method.
+func (m *MockReviewsRepository) CountToyReviews(ctx context.Context, toyID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountToyReviews", ctx, toyID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountToyReviews indicates an expected call of CountToyReviews.
+func (mr *MockReviewsRepositoryMockRecorder) CountToyReviews(ctx, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountToyReviews", reflect.TypeOf((*MockReviewsRepository)(nil).CountToyReviews), ctx, toyID)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewsRepository) DeleteReview(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewsRepositoryMockRecorder) DeleteReview(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewsRepository)(nil).DeleteReview), ctx, id)
+}
+
+// GetReviewByID mocks base method.
+func (m *MockReviewsRepository) GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewByID indicates an expected call of GetReviewByID.
+func (mr *MockReviewsRepositoryMockRecorder) GetReviewByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByID", reflect.TypeOf((*MockReviewsRepository)(nil).GetReviewByID), ctx, id)
+}
+
+// GetToyReviews mocks base method.
+func (m *MockReviewsRepository) GetToyReviews(ctx context.Context, toyID uint64, pagination *entities.Pagination) ([]entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToyReviews", ctx, toyID, pagination)
+	ret0, _ := ret[0].([]entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToyReviews indicates an expected call of GetToyReviews.
+func (mr *MockReviewsRepositoryMockRecorder) GetToyReviews(ctx, toyID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToyReviews", reflect.TypeOf((*MockReviewsRepository)(nil).GetToyReviews), ctx, toyID, pagination)
+}
+
+// GetUserReviews mocks base method.
+func (m *MockReviewsRepository) GetUserReviews(ctx context.Context, userID uint64) ([]entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReviews", ctx, userID)
+	ret0, _ := ret[0].([]entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReviews indicates an expected call of GetUserReviews.
+func (mr *MockReviewsRepositoryMockRecorder) GetUserReviews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockReviewsRepository)(nil).GetUserReviews), ctx, userID)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewsRepository) UpdateReview(ctx context.Context, reviewData entities.UpdateReviewDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, reviewData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewsRepositoryMockRecorder) UpdateReview(ctx, reviewData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewsRepository)(nil).UpdateReview), ctx, reviewData)
+}
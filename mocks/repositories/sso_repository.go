@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/sso_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,TagsRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
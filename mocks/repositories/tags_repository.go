@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/tags_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,SsoRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/tags_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,ToysRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
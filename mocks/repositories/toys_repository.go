@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,TagsRepository,SsoRepository -package=mockrepositories
+//	mockgen -source=repositories.go -destination=../../mocks/repositories/toys_repository.go -exclude_interfaces=MastersRepository,CategoriesRepository,TagsRepository,SsoRepository,ReviewsRepository,ForbiddenWordsRepository -package=mockrepositories
 //
 
 // Package mockrepositories is a generated GoMock package.
@@ -41,6 +41,21 @@ func (m *MockToysRepository) EXPECT() *MockToysRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddFavourite mocks base method.
+func (m *MockToysRepository) AddFavourite(ctx context.Context, userID, toyID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFavourite indicates an expected call of AddFavourite.
+func (mr *MockToysRepositoryMockRecorder) AddFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockToysRepository)(nil).AddFavourite), ctx, userID, toyID)
+}
+
 // AddToy mocks base method.
 func (m *MockToysRepository) AddToy(ctx context.Context, toyData entities.AddToyDTO) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +101,36 @@ func (mr *MockToysRepositoryMockRecorder) CountToys(ctx, filters any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountToys", reflect.TypeOf((*MockToysRepository)(nil).CountToys), ctx, filters)
 }
 
+// CountUserFavourites mocks base method.
+func (m *MockToysRepository) CountUserFavourites(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserFavourites", ctx, userID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserFavourites indicates an expected call of CountUserFavourites.
+func (mr *MockToysRepositoryMockRecorder) CountUserFavourites(ctx, userID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserFavourites", reflect.TypeOf((*MockToysRepository)(nil).CountUserFavourites), ctx, userID, filters)
+}
+
+// DeleteMasterToys mocks base method.
+func (m *MockToysRepository) DeleteMasterToys(ctx context.Context, masterID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMasterToys", ctx, masterID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMasterToys indicates an expected call of DeleteMasterToys.
+func (mr *MockToysRepositoryMockRecorder) DeleteMasterToys(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMasterToys", reflect.TypeOf((*MockToysRepository)(nil).DeleteMasterToys), ctx, masterID)
+}
+
 // DeleteToy mocks base method.
 func (m *MockToysRepository) DeleteToy(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -100,6 +145,66 @@ func (mr *MockToysRepositoryMockRecorder) DeleteToy(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToy", reflect.TypeOf((*MockToysRepository)(nil).DeleteToy), ctx, id)
 }
 
+// GetAllMasterToys mocks base method.
+func (m *MockToysRepository) GetAllMasterToys(ctx context.Context, masterID uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMasterToys", ctx, masterID)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMasterToys indicates an expected call of GetAllMasterToys.
+func (mr *MockToysRepositoryMockRecorder) GetAllMasterToys(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMasterToys", reflect.TypeOf((*MockToysRepository)(nil).GetAllMasterToys), ctx, masterID)
+}
+
+// GetCategoryPriceStats mocks base method.
+func (m *MockToysRepository) GetCategoryPriceStats(ctx context.Context, categoryID uint32) (*entities.CategoryPriceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryPriceStats", ctx, categoryID)
+	ret0, _ := ret[0].(*entities.CategoryPriceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryPriceStats indicates an expected call of GetCategoryPriceStats.
+func (mr *MockToysRepositoryMockRecorder) GetCategoryPriceStats(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryPriceStats", reflect.TypeOf((*MockToysRepository)(nil).GetCategoryPriceStats), ctx, categoryID)
+}
+
+// GetFavourite mocks base method.
+func (m *MockToysRepository) GetFavourite(ctx context.Context, userID, toyID uint64) (*entities.Favourite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(*entities.Favourite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavourite indicates an expected call of GetFavourite.
+func (mr *MockToysRepositoryMockRecorder) GetFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourite", reflect.TypeOf((*MockToysRepository)(nil).GetFavourite), ctx, userID, toyID)
+}
+
+// GetFeed mocks base method.
+func (m *MockToysRepository) GetFeed(ctx context.Context, userID uint64, cursor *entities.FeedCursor, limit uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, userID, cursor, limit)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockToysRepositoryMockRecorder) GetFeed(ctx, userID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockToysRepository)(nil).GetFeed), ctx, userID, cursor, limit)
+}
+
 // GetMasterToys mocks base method.
 func (m *MockToysRepository) GetMasterToys(ctx context.Context, masterID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +220,36 @@ func (mr *MockToysRepositoryMockRecorder) GetMasterToys(ctx, masterID, paginatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterToys", reflect.TypeOf((*MockToysRepository)(nil).GetMasterToys), ctx, masterID, pagination, filters)
 }
 
+// GetMasterToysPriceHistory mocks base method.
+func (m *MockToysRepository) GetMasterToysPriceHistory(ctx context.Context, masterID uint64) ([]entities.ToyPriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterToysPriceHistory", ctx, masterID)
+	ret0, _ := ret[0].([]entities.ToyPriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterToysPriceHistory indicates an expected call of GetMasterToysPriceHistory.
+func (mr *MockToysRepositoryMockRecorder) GetMasterToysPriceHistory(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterToysPriceHistory", reflect.TypeOf((*MockToysRepository)(nil).GetMasterToysPriceHistory), ctx, masterID)
+}
+
+// GetMasterToysStats mocks base method.
+func (m *MockToysRepository) GetMasterToysStats(ctx context.Context, masterID uint64, period entities.StatsPeriod) (*entities.MasterStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterToysStats", ctx, masterID, period)
+	ret0, _ := ret[0].(*entities.MasterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterToysStats indicates an expected call of GetMasterToysStats.
+func (mr *MockToysRepositoryMockRecorder) GetMasterToysStats(ctx, masterID, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterToysStats", reflect.TypeOf((*MockToysRepository)(nil).GetMasterToysStats), ctx, masterID, period)
+}
+
 // GetToyByID mocks base method.
 func (m *MockToysRepository) GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +280,80 @@ func (mr *MockToysRepositoryMockRecorder) GetToys(ctx, pagination, filters any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToys", reflect.TypeOf((*MockToysRepository)(nil).GetToys), ctx, pagination, filters)
 }
 
+// GetToysBatch mocks base method.
+func (m *MockToysRepository) GetToysBatch(ctx context.Context, masterID *uint64, filters *entities.ToysFilters, afterID, limit uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToysBatch", ctx, masterID, filters, afterID, limit)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToysBatch indicates an expected call of GetToysBatch.
+func (mr *MockToysRepositoryMockRecorder) GetToysBatch(ctx, masterID, filters, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToysBatch", reflect.TypeOf((*MockToysRepository)(nil).GetToysBatch), ctx, masterID, filters, afterID, limit)
+}
+
+// GetToysByIDs mocks base method.
+func (m *MockToysRepository) GetToysByIDs(ctx context.Context, ids []uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToysByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToysByIDs indicates an expected call of GetToysByIDs.
+func (mr *MockToysRepositoryMockRecorder) GetToysByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToysByIDs", reflect.TypeOf((*MockToysRepository)(nil).GetToysByIDs), ctx, ids)
+}
+
+// GetUserFavourites mocks base method.
+func (m *MockToysRepository) GetUserFavourites(ctx context.Context, userID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFavourites", ctx, userID, pagination, filters)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFavourites indicates an expected call of GetUserFavourites.
+func (mr *MockToysRepositoryMockRecorder) GetUserFavourites(ctx, userID, pagination, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFavourites", reflect.TypeOf((*MockToysRepository)(nil).GetUserFavourites), ctx, userID, pagination, filters)
+}
+
+// GetUserFavouritesRecords mocks base method.
+func (m *MockToysRepository) GetUserFavouritesRecords(ctx context.Context, userID uint64) ([]entities.Favourite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFavouritesRecords", ctx, userID)
+	ret0, _ := ret[0].([]entities.Favourite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFavouritesRecords indicates an expected call of GetUserFavouritesRecords.
+func (mr *MockToysRepositoryMockRecorder) GetUserFavouritesRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFavouritesRecords", reflect.TypeOf((*MockToysRepository)(nil).GetUserFavouritesRecords), ctx, userID)
+}
+
+// RemoveFavourite mocks base method.
+func (m *MockToysRepository) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavourite indicates an expected call of RemoveFavourite.
+func (mr *MockToysRepositoryMockRecorder) RemoveFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockToysRepository)(nil).RemoveFavourite), ctx, userID, toyID)
+}
+
 // UpdateToy mocks base method.
 func (m *MockToysRepository) UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error {
 	m.ctrl.T.Helper()
@@ -158,3 +367,17 @@ func (mr *MockToysRepositoryMockRecorder) UpdateToy(ctx, toyData any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToy", reflect.TypeOf((*MockToysRepository)(nil).UpdateToy), ctx, toyData)
 }
+
+// UpdateToyModeration mocks base method.
+func (m *MockToysRepository) UpdateToyModeration(ctx context.Context, moderationData entities.ToyModerationDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateToyModeration", ctx, moderationData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateToyModeration indicates an expected call of UpdateToyModeration.
+func (mr *MockToysRepositoryMockRecorder) UpdateToyModeration(ctx, moderationData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToyModeration", reflect.TypeOf((*MockToysRepository)(nil).UpdateToyModeration), ctx, moderationData)
+}
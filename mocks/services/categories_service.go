@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/categories_service.go -exclude_interfaces=TagsService,MastersService,ToysService,SsoService -package=mockservices
+//	mockgen -source=services.go -destination=../../mocks/services/categories_service.go -exclude_interfaces=TagsService,MastersService,ToysService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 //
 
 // Package mockservices is a generated GoMock package.
@@ -70,3 +70,18 @@ func (mr *MockCategoriesServiceMockRecorder) GetCategoryByID(ctx, id any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockCategoriesService)(nil).GetCategoryByID), ctx, id)
 }
+
+// GetCategoryRules mocks base method.
+func (m *MockCategoriesService) GetCategoryRules(ctx context.Context, categoryID uint32) (*entities.CategoryRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRules", ctx, categoryID)
+	ret0, _ := ret[0].(*entities.CategoryRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRules indicates an expected call of GetCategoryRules.
+func (mr *MockCategoriesServiceMockRecorder) GetCategoryRules(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRules", reflect.TypeOf((*MockCategoriesService)(nil).GetCategoryRules), ctx, categoryID)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services.go
+//
+// Generated by this command:
+//
+//	mockgen -source=services.go -destination=../../mocks/services/forbidden_words_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService,SsoService,ReviewsService -package=mockservices
+//
+
+// Package mockservices is a generated GoMock package.
+package mockservices
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockForbiddenWordsService is a mock of ForbiddenWordsService interface.
+type MockForbiddenWordsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockForbiddenWordsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockForbiddenWordsServiceMockRecorder is the mock recorder for MockForbiddenWordsService.
+type MockForbiddenWordsServiceMockRecorder struct {
+	mock *MockForbiddenWordsService
+}
+
+// NewMockForbiddenWordsService creates a new mock instance.
+func NewMockForbiddenWordsService(ctrl *gomock.Controller) *MockForbiddenWordsService {
+	mock := &MockForbiddenWordsService{ctrl: ctrl}
+	mock.recorder = &MockForbiddenWordsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockForbiddenWordsService) EXPECT() *MockForbiddenWordsServiceMockRecorder {
+	return m.recorder
+}
+
+// ContainsForbiddenWords mocks base method.
+func (m *MockForbiddenWordsService) ContainsForbiddenWords(value string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsForbiddenWords", value)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ContainsForbiddenWords indicates an expected call of ContainsForbiddenWords.
+func (mr *MockForbiddenWordsServiceMockRecorder) ContainsForbiddenWords(value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsForbiddenWords", reflect.TypeOf((*MockForbiddenWordsService)(nil).ContainsForbiddenWords), value)
+}
+
+// GetForbiddenWords mocks base method.
+func (m *MockForbiddenWordsService) GetForbiddenWords(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForbiddenWords", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForbiddenWords indicates an expected call of GetForbiddenWords.
+func (mr *MockForbiddenWordsServiceMockRecorder) GetForbiddenWords(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForbiddenWords", reflect.TypeOf((*MockForbiddenWordsService)(nil).GetForbiddenWords), ctx)
+}
+
+// ReloadForbiddenWords mocks base method.
+func (m *MockForbiddenWordsService) ReloadForbiddenWords(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadForbiddenWords", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReloadForbiddenWords indicates an expected call of ReloadForbiddenWords.
+func (mr *MockForbiddenWordsServiceMockRecorder) ReloadForbiddenWords(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadForbiddenWords", reflect.TypeOf((*MockForbiddenWordsService)(nil).ReloadForbiddenWords), ctx)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/masters_service.go -exclude_interfaces=TagsService,CategoriesService,ToysService,SsoService -package=mockservices
+//	mockgen -source=services.go -destination=../../mocks/services/masters_service.go -exclude_interfaces=TagsService,CategoriesService,ToysService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 //
 
 // Package mockservices is a generated GoMock package.
@@ -12,6 +12,7 @@ package mockservices
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/DKhorkov/hmtm-toys/internal/entities"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,35 @@ func (m *MockMastersService) EXPECT() *MockMastersServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeMasterStatus mocks base method.
+func (m *MockMastersService) ChangeMasterStatus(ctx context.Context, statusData entities.ChangeMasterStatusDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMasterStatus", ctx, statusData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMasterStatus indicates an expected call of ChangeMasterStatus.
+func (mr *MockMastersServiceMockRecorder) ChangeMasterStatus(ctx, statusData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMasterStatus", reflect.TypeOf((*MockMastersService)(nil).ChangeMasterStatus), ctx, statusData)
+}
+
+// CountFollowedMasters mocks base method.
+func (m *MockMastersService) CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFollowedMasters", ctx, userID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFollowedMasters indicates an expected call of CountFollowedMasters.
+func (mr *MockMastersServiceMockRecorder) CountFollowedMasters(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFollowedMasters", reflect.TypeOf((*MockMastersService)(nil).CountFollowedMasters), ctx, userID)
+}
+
 // CountMasters mocks base method.
 func (m *MockMastersService) CountMasters(ctx context.Context, filters *entities.MastersFilters) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +86,66 @@ func (mr *MockMastersServiceMockRecorder) CountMasters(ctx, filters any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMasters", reflect.TypeOf((*MockMastersService)(nil).CountMasters), ctx, filters)
 }
 
+// EndExpiredVacations mocks base method.
+func (m *MockMastersService) EndExpiredVacations(ctx context.Context, now time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndExpiredVacations", ctx, now)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndExpiredVacations indicates an expected call of EndExpiredVacations.
+func (mr *MockMastersServiceMockRecorder) EndExpiredVacations(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndExpiredVacations", reflect.TypeOf((*MockMastersService)(nil).EndExpiredVacations), ctx, now)
+}
+
+// FollowMaster mocks base method.
+func (m *MockMastersService) FollowMaster(ctx context.Context, userID, masterID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowMaster", ctx, userID, masterID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowMaster indicates an expected call of FollowMaster.
+func (mr *MockMastersServiceMockRecorder) FollowMaster(ctx, userID, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowMaster", reflect.TypeOf((*MockMastersService)(nil).FollowMaster), ctx, userID, masterID)
+}
+
+// GetFollow mocks base method.
+func (m *MockMastersService) GetFollow(ctx context.Context, userID, masterID uint64) (*entities.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollow", ctx, userID, masterID)
+	ret0, _ := ret[0].(*entities.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollow indicates an expected call of GetFollow.
+func (mr *MockMastersServiceMockRecorder) GetFollow(ctx, userID, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollow", reflect.TypeOf((*MockMastersService)(nil).GetFollow), ctx, userID, masterID)
+}
+
+// GetFollowedMasters mocks base method.
+func (m *MockMastersService) GetFollowedMasters(ctx context.Context, userID uint64, pagination *entities.Pagination) ([]entities.Master, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowedMasters", ctx, userID, pagination)
+	ret0, _ := ret[0].([]entities.Master)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowedMasters indicates an expected call of GetFollowedMasters.
+func (mr *MockMastersServiceMockRecorder) GetFollowedMasters(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowedMasters", reflect.TypeOf((*MockMastersService)(nil).GetFollowedMasters), ctx, userID, pagination)
+}
+
 // GetMasterByID mocks base method.
 func (m *MockMastersService) GetMasterByID(ctx context.Context, id uint64) (*entities.Master, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +161,21 @@ func (mr *MockMastersServiceMockRecorder) GetMasterByID(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterByID", reflect.TypeOf((*MockMastersService)(nil).GetMasterByID), ctx, id)
 }
 
+// GetMasterBySlug mocks base method.
+func (m *MockMastersService) GetMasterBySlug(ctx context.Context, slug string) (*entities.Master, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entities.Master)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterBySlug indicates an expected call of GetMasterBySlug.
+func (mr *MockMastersServiceMockRecorder) GetMasterBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterBySlug", reflect.TypeOf((*MockMastersService)(nil).GetMasterBySlug), ctx, slug)
+}
+
 // GetMasterByUserID mocks base method.
 func (m *MockMastersService) GetMasterByUserID(ctx context.Context, userID uint64) (*entities.Master, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +191,21 @@ func (mr *MockMastersServiceMockRecorder) GetMasterByUserID(ctx, userID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterByUserID", reflect.TypeOf((*MockMastersService)(nil).GetMasterByUserID), ctx, userID)
 }
 
+// GetMasterStatusHistory mocks base method.
+func (m *MockMastersService) GetMasterStatusHistory(ctx context.Context, masterID uint64) ([]entities.MasterStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterStatusHistory", ctx, masterID)
+	ret0, _ := ret[0].([]entities.MasterStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterStatusHistory indicates an expected call of GetMasterStatusHistory.
+func (mr *MockMastersServiceMockRecorder) GetMasterStatusHistory(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterStatusHistory", reflect.TypeOf((*MockMastersService)(nil).GetMasterStatusHistory), ctx, masterID)
+}
+
 // GetMasters mocks base method.
 func (m *MockMastersService) GetMasters(ctx context.Context, pagination *entities.Pagination, filters *entities.MastersFilters) ([]entities.Master, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +236,34 @@ func (mr *MockMastersServiceMockRecorder) RegisterMaster(ctx, masterData any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMaster", reflect.TypeOf((*MockMastersService)(nil).RegisterMaster), ctx, masterData)
 }
 
+// SetVacationMode mocks base method.
+func (m *MockMastersService) SetVacationMode(ctx context.Context, vacationData entities.SetVacationModeDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVacationMode", ctx, vacationData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVacationMode indicates an expected call of SetVacationMode.
+func (mr *MockMastersServiceMockRecorder) SetVacationMode(ctx, vacationData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVacationMode", reflect.TypeOf((*MockMastersService)(nil).SetVacationMode), ctx, vacationData)
+}
+
+// UnfollowMaster mocks base method.
+func (m *MockMastersService) UnfollowMaster(ctx context.Context, userID, masterID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowMaster", ctx, userID, masterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowMaster indicates an expected call of UnfollowMaster.
+func (mr *MockMastersServiceMockRecorder) UnfollowMaster(ctx, userID, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowMaster", reflect.TypeOf((*MockMastersService)(nil).UnfollowMaster), ctx, userID, masterID)
+}
+
 // UpdateMaster mocks base method.
 func (m *MockMastersService) UpdateMaster(ctx context.Context, masterData entities.UpdateMasterDTO) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services.go
+//
+// Generated by this command:
+//
+//	mockgen -source=services.go -destination=../../mocks/services/reviews_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService,SsoService,ForbiddenWordsService -package=mockservices
+//
+
+// Package mockservices is a generated GoMock package.
+package mockservices
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/DKhorkov/hmtm-toys/internal/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewsService is a mock of ReviewsService interface.
+type MockReviewsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewsServiceMockRecorder is the mock recorder for MockReviewsService.
+type MockReviewsServiceMockRecorder struct {
+	mock *MockReviewsService
+}
+
+// NewMockReviewsService creates a new mock instance.
+func NewMockReviewsService(ctrl *gomock.Controller) *MockReviewsService {
+	mock := &MockReviewsService{ctrl: ctrl}
+	mock.recorder = &MockReviewsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewsService) EXPECT() *MockReviewsServiceMockRecorder {
+	return m.recorder
+}
+
+// AddReview mocks base method.
+func (m *MockReviewsService) AddReview(ctx context.Context, reviewData entities.AddReviewDTO) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", ctx, reviewData)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockReviewsServiceMockRecorder) AddReview(ctx, reviewData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReviewsService)(nil).AddReview), ctx, reviewData)
+}
+
+// CountToyReviews mocks base method.
+func (m *MockReviewsService) CountToyReviews(ctx context.Context, toyID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountToyReviews", ctx, toyID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountToyReviews indicates an expected call of CountToyReviews.
+func (mr *MockReviewsServiceMockRecorder) CountToyReviews(ctx, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountToyReviews", reflect.TypeOf((*MockReviewsService)(nil).CountToyReviews), ctx, toyID)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewsService) DeleteReview(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewsServiceMockRecorder) DeleteReview(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewsService)(nil).DeleteReview), ctx, id)
+}
+
+// GetReviewByID mocks base method.
+func (m *MockReviewsService) GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewByID indicates an expected call of GetReviewByID.
+func (mr *MockReviewsServiceMockRecorder) GetReviewByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByID", reflect.TypeOf((*MockReviewsService)(nil).GetReviewByID), ctx, id)
+}
+
+// GetToyReviews mocks base method.
+func (m *MockReviewsService) GetToyReviews(ctx context.Context, toyID uint64, pagination *entities.Pagination) ([]entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToyReviews", ctx, toyID, pagination)
+	ret0, _ := ret[0].([]entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToyReviews indicates an expected call of GetToyReviews.
+func (mr *MockReviewsServiceMockRecorder) GetToyReviews(ctx, toyID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToyReviews", reflect.TypeOf((*MockReviewsService)(nil).GetToyReviews), ctx, toyID, pagination)
+}
+
+// GetUserReviews mocks base method.
+func (m *MockReviewsService) GetUserReviews(ctx context.Context, userID uint64) ([]entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserReviews", ctx, userID)
+	ret0, _ := ret[0].([]entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReviews indicates an expected call of GetUserReviews.
+func (mr *MockReviewsServiceMockRecorder) GetUserReviews(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockReviewsService)(nil).GetUserReviews), ctx, userID)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewsService) UpdateReview(ctx context.Context, reviewData entities.UpdateReviewDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, reviewData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewsServiceMockRecorder) UpdateReview(ctx, reviewData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewsService)(nil).UpdateReview), ctx, reviewData)
+}
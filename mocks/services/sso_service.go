@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/sso_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService -package=mockservices
+//	mockgen -source=services.go -destination=../../mocks/services/sso_service.go -exclude_interfaces=TagsService,MastersService,ToysService,CategoriesService,ReviewsService,ForbiddenWordsService -package=mockservices
 //
 
 // Package mockservices is a generated GoMock package.
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/tags_service.go -exclude_interfaces=MastersService,CategoriesService,ToysService,SsoService -package=mockservices
+//	mockgen -source=services.go -destination=../../mocks/services/tags_service.go -exclude_interfaces=MastersService,CategoriesService,ToysService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 //
 
 // Package mockservices is a generated GoMock package.
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=services.go -destination=../../mocks/services/toys_service.go -exclude_interfaces=MastersService,CategoriesService,TagsService,SsoService -package=mockservices
+//	mockgen -source=services.go -destination=../../mocks/services/toys_service.go -exclude_interfaces=MastersService,CategoriesService,TagsService,SsoService,ReviewsService,ForbiddenWordsService -package=mockservices
 //
 
 // Package mockservices is a generated GoMock package.
@@ -41,6 +41,21 @@ func (m *MockToysService) EXPECT() *MockToysServiceMockRecorder {
 	return m.recorder
 }
 
+// AddFavourite mocks base method.
+func (m *MockToysService) AddFavourite(ctx context.Context, userID, toyID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFavourite indicates an expected call of AddFavourite.
+func (mr *MockToysServiceMockRecorder) AddFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockToysService)(nil).AddFavourite), ctx, userID, toyID)
+}
+
 // AddToy mocks base method.
 func (m *MockToysService) AddToy(ctx context.Context, toyData entities.AddToyDTO) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +101,36 @@ func (mr *MockToysServiceMockRecorder) CountToys(ctx, filters any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountToys", reflect.TypeOf((*MockToysService)(nil).CountToys), ctx, filters)
 }
 
+// CountUserFavourites mocks base method.
+func (m *MockToysService) CountUserFavourites(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserFavourites", ctx, userID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserFavourites indicates an expected call of CountUserFavourites.
+func (mr *MockToysServiceMockRecorder) CountUserFavourites(ctx, userID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserFavourites", reflect.TypeOf((*MockToysService)(nil).CountUserFavourites), ctx, userID, filters)
+}
+
+// DeleteMasterToys mocks base method.
+func (m *MockToysService) DeleteMasterToys(ctx context.Context, masterID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMasterToys", ctx, masterID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMasterToys indicates an expected call of DeleteMasterToys.
+func (mr *MockToysServiceMockRecorder) DeleteMasterToys(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMasterToys", reflect.TypeOf((*MockToysService)(nil).DeleteMasterToys), ctx, masterID)
+}
+
 // DeleteToy mocks base method.
 func (m *MockToysService) DeleteToy(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -100,6 +145,66 @@ func (mr *MockToysServiceMockRecorder) DeleteToy(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToy", reflect.TypeOf((*MockToysService)(nil).DeleteToy), ctx, id)
 }
 
+// GetAllMasterToys mocks base method.
+func (m *MockToysService) GetAllMasterToys(ctx context.Context, masterID uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMasterToys", ctx, masterID)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMasterToys indicates an expected call of GetAllMasterToys.
+func (mr *MockToysServiceMockRecorder) GetAllMasterToys(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMasterToys", reflect.TypeOf((*MockToysService)(nil).GetAllMasterToys), ctx, masterID)
+}
+
+// GetCategoryPriceStats mocks base method.
+func (m *MockToysService) GetCategoryPriceStats(ctx context.Context, categoryID uint32) (*entities.CategoryPriceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryPriceStats", ctx, categoryID)
+	ret0, _ := ret[0].(*entities.CategoryPriceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryPriceStats indicates an expected call of GetCategoryPriceStats.
+func (mr *MockToysServiceMockRecorder) GetCategoryPriceStats(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryPriceStats", reflect.TypeOf((*MockToysService)(nil).GetCategoryPriceStats), ctx, categoryID)
+}
+
+// GetFavourite mocks base method.
+func (m *MockToysService) GetFavourite(ctx context.Context, userID, toyID uint64) (*entities.Favourite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(*entities.Favourite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavourite indicates an expected call of GetFavourite.
+func (mr *MockToysServiceMockRecorder) GetFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourite", reflect.TypeOf((*MockToysService)(nil).GetFavourite), ctx, userID, toyID)
+}
+
+// GetFeed mocks base method.
+func (m *MockToysService) GetFeed(ctx context.Context, userID uint64, cursor *entities.FeedCursor, limit uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, userID, cursor, limit)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockToysServiceMockRecorder) GetFeed(ctx, userID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockToysService)(nil).GetFeed), ctx, userID, cursor, limit)
+}
+
 // GetMasterToys mocks base method.
 func (m *MockToysService) GetMasterToys(ctx context.Context, masterID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +220,36 @@ func (mr *MockToysServiceMockRecorder) GetMasterToys(ctx, masterID, pagination,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterToys", reflect.TypeOf((*MockToysService)(nil).GetMasterToys), ctx, masterID, pagination, filters)
 }
 
+// GetMasterToysPriceHistory mocks base method.
+func (m *MockToysService) GetMasterToysPriceHistory(ctx context.Context, masterID uint64) ([]entities.ToyPriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterToysPriceHistory", ctx, masterID)
+	ret0, _ := ret[0].([]entities.ToyPriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterToysPriceHistory indicates an expected call of GetMasterToysPriceHistory.
+func (mr *MockToysServiceMockRecorder) GetMasterToysPriceHistory(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterToysPriceHistory", reflect.TypeOf((*MockToysService)(nil).GetMasterToysPriceHistory), ctx, masterID)
+}
+
+// GetMasterToysStats mocks base method.
+func (m *MockToysService) GetMasterToysStats(ctx context.Context, masterID uint64, period entities.StatsPeriod) (*entities.MasterStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterToysStats", ctx, masterID, period)
+	ret0, _ := ret[0].(*entities.MasterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterToysStats indicates an expected call of GetMasterToysStats.
+func (mr *MockToysServiceMockRecorder) GetMasterToysStats(ctx, masterID, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterToysStats", reflect.TypeOf((*MockToysService)(nil).GetMasterToysStats), ctx, masterID, period)
+}
+
 // GetToyByID mocks base method.
 func (m *MockToysService) GetToyByID(ctx context.Context, id uint64) (*entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +280,80 @@ func (mr *MockToysServiceMockRecorder) GetToys(ctx, pagination, filters any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToys", reflect.TypeOf((*MockToysService)(nil).GetToys), ctx, pagination, filters)
 }
 
+// GetToysBatch mocks base method.
+func (m *MockToysService) GetToysBatch(ctx context.Context, masterID *uint64, filters *entities.ToysFilters, afterID, limit uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToysBatch", ctx, masterID, filters, afterID, limit)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToysBatch indicates an expected call of GetToysBatch.
+func (mr *MockToysServiceMockRecorder) GetToysBatch(ctx, masterID, filters, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToysBatch", reflect.TypeOf((*MockToysService)(nil).GetToysBatch), ctx, masterID, filters, afterID, limit)
+}
+
+// GetToysByIDs mocks base method.
+func (m *MockToysService) GetToysByIDs(ctx context.Context, ids []uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToysByIDs", ctx, ids)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToysByIDs indicates an expected call of GetToysByIDs.
+func (mr *MockToysServiceMockRecorder) GetToysByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToysByIDs", reflect.TypeOf((*MockToysService)(nil).GetToysByIDs), ctx, ids)
+}
+
+// GetUserFavourites mocks base method.
+func (m *MockToysService) GetUserFavourites(ctx context.Context, userID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFavourites", ctx, userID, pagination, filters)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFavourites indicates an expected call of GetUserFavourites.
+func (mr *MockToysServiceMockRecorder) GetUserFavourites(ctx, userID, pagination, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFavourites", reflect.TypeOf((*MockToysService)(nil).GetUserFavourites), ctx, userID, pagination, filters)
+}
+
+// GetUserFavouritesRecords mocks base method.
+func (m *MockToysService) GetUserFavouritesRecords(ctx context.Context, userID uint64) ([]entities.Favourite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFavouritesRecords", ctx, userID)
+	ret0, _ := ret[0].([]entities.Favourite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFavouritesRecords indicates an expected call of GetUserFavouritesRecords.
+func (mr *MockToysServiceMockRecorder) GetUserFavouritesRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFavouritesRecords", reflect.TypeOf((*MockToysService)(nil).GetUserFavouritesRecords), ctx, userID)
+}
+
+// RemoveFavourite mocks base method.
+func (m *MockToysService) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavourite indicates an expected call of RemoveFavourite.
+func (mr *MockToysServiceMockRecorder) RemoveFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockToysService)(nil).RemoveFavourite), ctx, userID, toyID)
+}
+
 // UpdateToy mocks base method.
 func (m *MockToysService) UpdateToy(ctx context.Context, toyData entities.UpdateToyDTO) error {
 	m.ctrl.T.Helper()
@@ -158,3 +367,17 @@ func (mr *MockToysServiceMockRecorder) UpdateToy(ctx, toyData any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToy", reflect.TypeOf((*MockToysService)(nil).UpdateToy), ctx, toyData)
 }
+
+// UpdateToyModeration mocks base method.
+func (m *MockToysService) UpdateToyModeration(ctx context.Context, moderationData entities.ToyModerationDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateToyModeration", ctx, moderationData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateToyModeration indicates an expected call of UpdateToyModeration.
+func (mr *MockToysServiceMockRecorder) UpdateToyModeration(ctx, moderationData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToyModeration", reflect.TypeOf((*MockToysService)(nil).UpdateToyModeration), ctx, moderationData)
+}
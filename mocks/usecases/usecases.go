@@ -41,6 +41,36 @@ func (m *MockUseCases) EXPECT() *MockUseCasesMockRecorder {
 	return m.recorder
 }
 
+// AddFavourite mocks base method.
+func (m *MockUseCases) AddFavourite(ctx context.Context, userID, toyID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFavourite indicates an expected call of AddFavourite.
+func (mr *MockUseCasesMockRecorder) AddFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockUseCases)(nil).AddFavourite), ctx, userID, toyID)
+}
+
+// AddReview mocks base method.
+func (m *MockUseCases) AddReview(ctx context.Context, reviewData entities.AddReviewDTO) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReview", ctx, reviewData)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReview indicates an expected call of AddReview.
+func (mr *MockUseCasesMockRecorder) AddReview(ctx, reviewData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockUseCases)(nil).AddReview), ctx, reviewData)
+}
+
 // AddToy mocks base method.
 func (m *MockUseCases) AddToy(ctx context.Context, rawToyData entities.RawAddToyDTO) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +86,80 @@ func (mr *MockUseCasesMockRecorder) AddToy(ctx, rawToyData any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToy", reflect.TypeOf((*MockUseCases)(nil).AddToy), ctx, rawToyData)
 }
 
+// ApproveToy mocks base method.
+func (m *MockUseCases) ApproveToy(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveToy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveToy indicates an expected call of ApproveToy.
+func (mr *MockUseCasesMockRecorder) ApproveToy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveToy", reflect.TypeOf((*MockUseCases)(nil).ApproveToy), ctx, id)
+}
+
+// BatchGetToys mocks base method.
+func (m *MockUseCases) BatchGetToys(ctx context.Context, ids []uint64) ([]entities.Toy, []uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetToys", ctx, ids)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].([]uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BatchGetToys indicates an expected call of BatchGetToys.
+func (mr *MockUseCasesMockRecorder) BatchGetToys(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetToys", reflect.TypeOf((*MockUseCases)(nil).BatchGetToys), ctx, ids)
+}
+
+// BatchUpdateToys mocks base method.
+func (m *MockUseCases) BatchUpdateToys(ctx context.Context, userID uint64, items []entities.BatchUpdateToyDTO) ([]entities.BatchUpdateToyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdateToys", ctx, userID, items)
+	ret0, _ := ret[0].([]entities.BatchUpdateToyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchUpdateToys indicates an expected call of BatchUpdateToys.
+func (mr *MockUseCasesMockRecorder) BatchUpdateToys(ctx, userID, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateToys", reflect.TypeOf((*MockUseCases)(nil).BatchUpdateToys), ctx, userID, items)
+}
+
+// ChangeMasterStatus mocks base method.
+func (m *MockUseCases) ChangeMasterStatus(ctx context.Context, statusData entities.ChangeMasterStatusDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMasterStatus", ctx, statusData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMasterStatus indicates an expected call of ChangeMasterStatus.
+func (mr *MockUseCasesMockRecorder) ChangeMasterStatus(ctx, statusData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMasterStatus", reflect.TypeOf((*MockUseCases)(nil).ChangeMasterStatus), ctx, statusData)
+}
+
+// CountFollowedMasters mocks base method.
+func (m *MockUseCases) CountFollowedMasters(ctx context.Context, userID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFollowedMasters", ctx, userID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFollowedMasters indicates an expected call of CountFollowedMasters.
+func (mr *MockUseCasesMockRecorder) CountFollowedMasters(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFollowedMasters", reflect.TypeOf((*MockUseCases)(nil).CountFollowedMasters), ctx, userID)
+}
+
 // CountMasterToys mocks base method.
 func (m *MockUseCases) CountMasterToys(ctx context.Context, masterID uint64, filters *entities.ToysFilters) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +190,21 @@ func (mr *MockUseCasesMockRecorder) CountMasters(ctx, filters any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMasters", reflect.TypeOf((*MockUseCases)(nil).CountMasters), ctx, filters)
 }
 
+// CountToyReviews mocks base method.
+func (m *MockUseCases) CountToyReviews(ctx context.Context, toyID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountToyReviews", ctx, toyID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountToyReviews indicates an expected call of CountToyReviews.
+func (mr *MockUseCasesMockRecorder) CountToyReviews(ctx, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountToyReviews", reflect.TypeOf((*MockUseCases)(nil).CountToyReviews), ctx, toyID)
+}
+
 // CountToys mocks base method.
 func (m *MockUseCases) CountToys(ctx context.Context, filters *entities.ToysFilters) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +220,21 @@ func (mr *MockUseCasesMockRecorder) CountToys(ctx, filters any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountToys", reflect.TypeOf((*MockUseCases)(nil).CountToys), ctx, filters)
 }
 
+// CountUserFavourites mocks base method.
+func (m *MockUseCases) CountUserFavourites(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserFavourites", ctx, userID, filters)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserFavourites indicates an expected call of CountUserFavourites.
+func (mr *MockUseCasesMockRecorder) CountUserFavourites(ctx, userID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserFavourites", reflect.TypeOf((*MockUseCases)(nil).CountUserFavourites), ctx, userID, filters)
+}
+
 // CountUserToys mocks base method.
 func (m *MockUseCases) CountUserToys(ctx context.Context, userID uint64, filters *entities.ToysFilters) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +265,20 @@ func (mr *MockUseCasesMockRecorder) CreateTags(ctx, tagsData any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockUseCases)(nil).CreateTags), ctx, tagsData)
 }
 
+// DeleteReview mocks base method.
+func (m *MockUseCases) DeleteReview(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockUseCasesMockRecorder) DeleteReview(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockUseCases)(nil).DeleteReview), ctx, id)
+}
+
 // DeleteToy mocks base method.
 func (m *MockUseCases) DeleteToy(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -145,6 +293,65 @@ func (mr *MockUseCasesMockRecorder) DeleteToy(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToy", reflect.TypeOf((*MockUseCases)(nil).DeleteToy), ctx, id)
 }
 
+// EndExpiredVacations mocks base method.
+func (m *MockUseCases) EndExpiredVacations(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndExpiredVacations", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndExpiredVacations indicates an expected call of EndExpiredVacations.
+func (mr *MockUseCasesMockRecorder) EndExpiredVacations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndExpiredVacations", reflect.TypeOf((*MockUseCases)(nil).EndExpiredVacations), ctx)
+}
+
+// ExportToys mocks base method.
+func (m *MockUseCases) ExportToys(ctx context.Context, masterID *uint64, filters *entities.ToysFilters, fn func([]entities.Toy) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportToys", ctx, masterID, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportToys indicates an expected call of ExportToys.
+func (mr *MockUseCasesMockRecorder) ExportToys(ctx, masterID, filters, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportToys", reflect.TypeOf((*MockUseCases)(nil).ExportToys), ctx, masterID, filters, fn)
+}
+
+// ExportUserData mocks base method.
+func (m *MockUseCases) ExportUserData(ctx context.Context, userID uint64) (*entities.UserDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userID)
+	ret0, _ := ret[0].(*entities.UserDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockUseCasesMockRecorder) ExportUserData(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockUseCases)(nil).ExportUserData), ctx, userID)
+}
+
+// FollowMaster mocks base method.
+func (m *MockUseCases) FollowMaster(ctx context.Context, userID, masterID uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowMaster", ctx, userID, masterID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowMaster indicates an expected call of FollowMaster.
+func (mr *MockUseCasesMockRecorder) FollowMaster(ctx, userID, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowMaster", reflect.TypeOf((*MockUseCases)(nil).FollowMaster), ctx, userID, masterID)
+}
+
 // GetAllCategories mocks base method.
 func (m *MockUseCases) GetAllCategories(ctx context.Context) ([]entities.Category, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +397,51 @@ func (mr *MockUseCasesMockRecorder) GetCategoryByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockUseCases)(nil).GetCategoryByID), ctx, id)
 }
 
+// GetCategoryRules mocks base method.
+func (m *MockUseCases) GetCategoryRules(ctx context.Context, categoryID uint32) (*entities.CategoryRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryRules", ctx, categoryID)
+	ret0, _ := ret[0].(*entities.CategoryRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryRules indicates an expected call of GetCategoryRules.
+func (mr *MockUseCasesMockRecorder) GetCategoryRules(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryRules", reflect.TypeOf((*MockUseCases)(nil).GetCategoryRules), ctx, categoryID)
+}
+
+// GetFeed mocks base method.
+func (m *MockUseCases) GetFeed(ctx context.Context, userID uint64, cursor *entities.FeedCursor, limit *uint64) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, userID, cursor, limit)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockUseCasesMockRecorder) GetFeed(ctx, userID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUseCases)(nil).GetFeed), ctx, userID, cursor, limit)
+}
+
+// GetFollowedMasters mocks base method.
+func (m *MockUseCases) GetFollowedMasters(ctx context.Context, userID uint64, pagination *entities.Pagination) ([]entities.Master, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowedMasters", ctx, userID, pagination)
+	ret0, _ := ret[0].([]entities.Master)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowedMasters indicates an expected call of GetFollowedMasters.
+func (mr *MockUseCasesMockRecorder) GetFollowedMasters(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowedMasters", reflect.TypeOf((*MockUseCases)(nil).GetFollowedMasters), ctx, userID, pagination)
+}
+
 // GetMasterByID mocks base method.
 func (m *MockUseCases) GetMasterByID(ctx context.Context, id uint64) (*entities.Master, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +457,21 @@ func (mr *MockUseCasesMockRecorder) GetMasterByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterByID", reflect.TypeOf((*MockUseCases)(nil).GetMasterByID), ctx, id)
 }
 
+// GetMasterBySlug mocks base method.
+func (m *MockUseCases) GetMasterBySlug(ctx context.Context, slug string) (*entities.Master, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterBySlug", ctx, slug)
+	ret0, _ := ret[0].(*entities.Master)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterBySlug indicates an expected call of GetMasterBySlug.
+func (mr *MockUseCasesMockRecorder) GetMasterBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterBySlug", reflect.TypeOf((*MockUseCases)(nil).GetMasterBySlug), ctx, slug)
+}
+
 // GetMasterByUserID mocks base method.
 func (m *MockUseCases) GetMasterByUserID(ctx context.Context, userID uint64) (*entities.Master, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +487,36 @@ func (mr *MockUseCasesMockRecorder) GetMasterByUserID(ctx, userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterByUserID", reflect.TypeOf((*MockUseCases)(nil).GetMasterByUserID), ctx, userID)
 }
 
+// GetMasterStats mocks base method.
+func (m *MockUseCases) GetMasterStats(ctx context.Context, masterID uint64, period *entities.StatsPeriod) (*entities.MasterStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterStats", ctx, masterID, period)
+	ret0, _ := ret[0].(*entities.MasterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterStats indicates an expected call of GetMasterStats.
+func (mr *MockUseCasesMockRecorder) GetMasterStats(ctx, masterID, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterStats", reflect.TypeOf((*MockUseCases)(nil).GetMasterStats), ctx, masterID, period)
+}
+
+// GetMasterStatusHistory mocks base method.
+func (m *MockUseCases) GetMasterStatusHistory(ctx context.Context, masterID uint64) ([]entities.MasterStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterStatusHistory", ctx, masterID)
+	ret0, _ := ret[0].([]entities.MasterStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterStatusHistory indicates an expected call of GetMasterStatusHistory.
+func (mr *MockUseCasesMockRecorder) GetMasterStatusHistory(ctx, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterStatusHistory", reflect.TypeOf((*MockUseCases)(nil).GetMasterStatusHistory), ctx, masterID)
+}
+
 // GetMasterToys mocks base method.
 func (m *MockUseCases) GetMasterToys(ctx context.Context, masterID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +547,21 @@ func (mr *MockUseCasesMockRecorder) GetMasters(ctx, pagination, filters any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasters", reflect.TypeOf((*MockUseCases)(nil).GetMasters), ctx, pagination, filters)
 }
 
+// GetReviewByID mocks base method.
+func (m *MockUseCases) GetReviewByID(ctx context.Context, id uint64) (*entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewByID indicates an expected call of GetReviewByID.
+func (mr *MockUseCasesMockRecorder) GetReviewByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByID", reflect.TypeOf((*MockUseCases)(nil).GetReviewByID), ctx, id)
+}
+
 // GetTagByID mocks base method.
 func (m *MockUseCases) GetTagByID(ctx context.Context, id uint32) (*entities.Tag, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +592,21 @@ func (mr *MockUseCasesMockRecorder) GetToyByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToyByID", reflect.TypeOf((*MockUseCases)(nil).GetToyByID), ctx, id)
 }
 
+// GetToyReviews mocks base method.
+func (m *MockUseCases) GetToyReviews(ctx context.Context, toyID uint64, pagination *entities.Pagination) ([]entities.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToyReviews", ctx, toyID, pagination)
+	ret0, _ := ret[0].([]entities.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetToyReviews indicates an expected call of GetToyReviews.
+func (mr *MockUseCasesMockRecorder) GetToyReviews(ctx, toyID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToyReviews", reflect.TypeOf((*MockUseCases)(nil).GetToyReviews), ctx, toyID, pagination)
+}
+
 // GetToys mocks base method.
 func (m *MockUseCases) GetToys(ctx context.Context, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +622,21 @@ func (mr *MockUseCasesMockRecorder) GetToys(ctx, pagination, filters any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToys", reflect.TypeOf((*MockUseCases)(nil).GetToys), ctx, pagination, filters)
 }
 
+// GetUserFavourites mocks base method.
+func (m *MockUseCases) GetUserFavourites(ctx context.Context, userID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFavourites", ctx, userID, pagination, filters)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFavourites indicates an expected call of GetUserFavourites.
+func (mr *MockUseCasesMockRecorder) GetUserFavourites(ctx, userID, pagination, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFavourites", reflect.TypeOf((*MockUseCases)(nil).GetUserFavourites), ctx, userID, pagination, filters)
+}
+
 // GetUserToys mocks base method.
 func (m *MockUseCases) GetUserToys(ctx context.Context, userID uint64, pagination *entities.Pagination, filters *entities.ToysFilters) ([]entities.Toy, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +652,80 @@ func (mr *MockUseCasesMockRecorder) GetUserToys(ctx, userID, pagination, filters
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserToys", reflect.TypeOf((*MockUseCases)(nil).GetUserToys), ctx, userID, pagination, filters)
 }
 
+// GetUsersByIDs mocks base method.
+func (m *MockUseCases) GetUsersByIDs(ctx context.Context, ids []uint64) (map[uint64]entities.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uint64]entities.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *MockUseCasesMockRecorder) GetUsersByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockUseCases)(nil).GetUsersByIDs), ctx, ids)
+}
+
+// ImportToys mocks base method.
+func (m *MockUseCases) ImportToys(ctx context.Context, userID uint64, rows []entities.ImportToyRow, dryRun bool) ([]entities.ImportToyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportToys", ctx, userID, rows, dryRun)
+	ret0, _ := ret[0].([]entities.ImportToyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportToys indicates an expected call of ImportToys.
+func (mr *MockUseCasesMockRecorder) ImportToys(ctx, userID, rows, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportToys", reflect.TypeOf((*MockUseCases)(nil).ImportToys), ctx, userID, rows, dryRun)
+}
+
+// ListModerationQueue mocks base method.
+func (m *MockUseCases) ListModerationQueue(ctx context.Context, pagination *entities.Pagination) ([]entities.Toy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModerationQueue", ctx, pagination)
+	ret0, _ := ret[0].([]entities.Toy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModerationQueue indicates an expected call of ListModerationQueue.
+func (mr *MockUseCasesMockRecorder) ListModerationQueue(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModerationQueue", reflect.TypeOf((*MockUseCases)(nil).ListModerationQueue), ctx, pagination)
+}
+
+// ProcessUserEvent mocks base method.
+func (m *MockUseCases) ProcessUserEvent(ctx context.Context, event entities.UserEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessUserEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessUserEvent indicates an expected call of ProcessUserEvent.
+func (mr *MockUseCasesMockRecorder) ProcessUserEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessUserEvent", reflect.TypeOf((*MockUseCases)(nil).ProcessUserEvent), ctx, event)
+}
+
+// ReconcileMasters mocks base method.
+func (m *MockUseCases) ReconcileMasters(ctx context.Context, dryRun bool) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileMasters", ctx, dryRun)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileMasters indicates an expected call of ReconcileMasters.
+func (mr *MockUseCasesMockRecorder) ReconcileMasters(ctx, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileMasters", reflect.TypeOf((*MockUseCases)(nil).ReconcileMasters), ctx, dryRun)
+}
+
 // RegisterMaster mocks base method.
 func (m *MockUseCases) RegisterMaster(ctx context.Context, rawMasterData entities.RegisterMasterDTO) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +741,91 @@ func (mr *MockUseCasesMockRecorder) RegisterMaster(ctx, rawMasterData any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMaster", reflect.TypeOf((*MockUseCases)(nil).RegisterMaster), ctx, rawMasterData)
 }
 
+// RejectToy mocks base method.
+func (m *MockUseCases) RejectToy(ctx context.Context, id uint64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectToy", ctx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectToy indicates an expected call of RejectToy.
+func (mr *MockUseCasesMockRecorder) RejectToy(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectToy", reflect.TypeOf((*MockUseCases)(nil).RejectToy), ctx, id, reason)
+}
+
+// ReloadForbiddenWords mocks base method.
+func (m *MockUseCases) ReloadForbiddenWords(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadForbiddenWords", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReloadForbiddenWords indicates an expected call of ReloadForbiddenWords.
+func (mr *MockUseCasesMockRecorder) ReloadForbiddenWords(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadForbiddenWords", reflect.TypeOf((*MockUseCases)(nil).ReloadForbiddenWords), ctx)
+}
+
+// RemoveFavourite mocks base method.
+func (m *MockUseCases) RemoveFavourite(ctx context.Context, userID, toyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavourite", ctx, userID, toyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavourite indicates an expected call of RemoveFavourite.
+func (mr *MockUseCasesMockRecorder) RemoveFavourite(ctx, userID, toyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockUseCases)(nil).RemoveFavourite), ctx, userID, toyID)
+}
+
+// ResubmitToy mocks base method.
+func (m *MockUseCases) ResubmitToy(ctx context.Context, id uint64, appeal *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResubmitToy", ctx, id, appeal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResubmitToy indicates an expected call of ResubmitToy.
+func (mr *MockUseCasesMockRecorder) ResubmitToy(ctx, id, appeal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResubmitToy", reflect.TypeOf((*MockUseCases)(nil).ResubmitToy), ctx, id, appeal)
+}
+
+// SetVacationMode mocks base method.
+func (m *MockUseCases) SetVacationMode(ctx context.Context, vacationData entities.SetVacationModeDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVacationMode", ctx, vacationData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVacationMode indicates an expected call of SetVacationMode.
+func (mr *MockUseCasesMockRecorder) SetVacationMode(ctx, vacationData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVacationMode", reflect.TypeOf((*MockUseCases)(nil).SetVacationMode), ctx, vacationData)
+}
+
+// UnfollowMaster mocks base method.
+func (m *MockUseCases) UnfollowMaster(ctx context.Context, userID, masterID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowMaster", ctx, userID, masterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowMaster indicates an expected call of UnfollowMaster.
+func (mr *MockUseCasesMockRecorder) UnfollowMaster(ctx, userID, masterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowMaster", reflect.TypeOf((*MockUseCases)(nil).UnfollowMaster), ctx, userID, masterID)
+}
+
 // UpdateMaster mocks base method.
 func (m *MockUseCases) UpdateMaster(ctx context.Context, masterData entities.UpdateMasterDTO) error {
 	m.ctrl.T.Helper()
@@ -339,6 +840,20 @@ func (mr *MockUseCasesMockRecorder) UpdateMaster(ctx, masterData any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaster", reflect.TypeOf((*MockUseCases)(nil).UpdateMaster), ctx, masterData)
 }
 
+// UpdateReview mocks base method.
+func (m *MockUseCases) UpdateReview(ctx context.Context, rawReviewData entities.RawUpdateReviewDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, rawReviewData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockUseCasesMockRecorder) UpdateReview(ctx, rawReviewData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockUseCases)(nil).UpdateReview), ctx, rawReviewData)
+}
+
 // UpdateToy mocks base method.
 func (m *MockUseCases) UpdateToy(ctx context.Context, rawToyData entities.RawUpdateToyDTO) error {
 	m.ctrl.T.Helper()